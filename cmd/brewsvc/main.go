@@ -7,9 +7,31 @@ import (
 	"connectrpc.com/connect"
 	"connectrpc.com/validate"
 	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/gen/proto/account/accountconnect"
 	"github.com/jany/my-coffee/gen/proto/brew/brewconnect"
+	"github.com/jany/my-coffee/gen/proto/businessday/businessdayconnect"
+	"github.com/jany/my-coffee/gen/proto/cash/cashconnect"
+	"github.com/jany/my-coffee/gen/proto/giftcard/giftcardconnect"
+	"github.com/jany/my-coffee/gen/proto/inventory/inventoryconnect"
+	"github.com/jany/my-coffee/gen/proto/loyalty/loyaltyconnect"
+	"github.com/jany/my-coffee/gen/proto/promo/promoconnect"
+	"github.com/jany/my-coffee/gen/proto/report/reportconnect"
+	"github.com/jany/my-coffee/internal/accounts"
+	"github.com/jany/my-coffee/internal/apikeys"
+	"github.com/jany/my-coffee/internal/auth"
 	"github.com/jany/my-coffee/internal/brews"
+	"github.com/jany/my-coffee/internal/businessdays"
+	"github.com/jany/my-coffee/internal/cash"
 	database "github.com/jany/my-coffee/internal/datbase"
+	"github.com/jany/my-coffee/internal/giftcards"
+	"github.com/jany/my-coffee/internal/inventory"
+	"github.com/jany/my-coffee/internal/loyalty"
+	"github.com/jany/my-coffee/internal/payments"
+	"github.com/jany/my-coffee/internal/printer"
+	"github.com/jany/my-coffee/internal/promos"
+	"github.com/jany/my-coffee/internal/receipts"
+	"github.com/jany/my-coffee/internal/reports"
+	"github.com/jany/my-coffee/internal/repository"
 )
 
 // cors middleware to allow requests from the Vite dev server
@@ -17,7 +39,7 @@ func cors(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version, Authorization, X-API-Key")
 
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusNoContent)
@@ -36,11 +58,94 @@ func main() {
 	db := database.Connect()
 	defer database.Close()
 
-	// Create Connect RPC server with protovalidate interceptor
+	provider, err := payments.NewProvider(config.AppConfig.PaymentProvider)
+	if err != nil {
+		log.Fatalf("failed to set up payments: %v", err)
+	}
+
+	renderer, err := receipts.New(config.AppConfig.ReceiptTextTemplate, config.AppConfig.ReceiptHTMLTemplate)
+	if err != nil {
+		log.Fatalf("failed to load receipt templates: %v", err)
+	}
+
+	// Cup tickets are only printed when a printer is configured
+	var printQueue *printer.Queue
+	if config.AppConfig.PrinterSink != "" {
+		sink, err := printer.NewSink(config.AppConfig.PrinterSink)
+		if err != nil {
+			log.Fatalf("failed to set up printer: %v", err)
+		}
+		printQueue = printer.NewQueue(sink, 100)
+		defer printQueue.Close()
+	}
+
+	// Every RPC needs a bearer token signed with JWT_SECRET, or an API key
+	// from cmd/apikeys, whose roles auth.BrewPolicy allows; customers get
+	// their tokens from AccountService
+	verifier := auth.NewVerifier(config.AppConfig.JWT_SECRET).
+		WithAPIKeys(apikeys.NewChecker(repository.NewAPIKeyRepository(db)))
+	authInterceptor := auth.NewInterceptor(verifier, auth.BrewPolicy.Public()...)
+	authorizer := auth.NewAuthorizer(auth.BrewPolicy)
+
+	// Create Connect RPC server with auth and protovalidate interceptors
 	mux := http.NewServeMux()
+	brewServer := brews.New(db, provider, renderer, printQueue)
 	path, handler := brewconnect.NewBrewServiceHandler(
-		brews.New(db),
-		connect.WithInterceptors(validate.NewInterceptor()),
+		brewServer,
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	// QR codes for receipts and the tracking page, served next to the RPCs
+	mux.Handle("GET /orders/{id}/qr.png", verifier.Require(http.HandlerFunc(brewServer.ServeQRCode)))
+	mux.Handle("GET /orders/{id}/qr.svg", verifier.Require(http.HandlerFunc(brewServer.ServeQRCode)))
+	mux.Handle("GET /orders/export", verifier.Require(http.HandlerFunc(brewServer.ServeExport), auth.RoleManager, auth.RoleAdmin))
+
+	path, handler = accountconnect.NewAccountServiceHandler(
+		accounts.New(db, verifier),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = inventoryconnect.NewInventoryServiceHandler(
+		inventory.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = promoconnect.NewPromoServiceHandler(
+		promos.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = loyaltyconnect.NewLoyaltyServiceHandler(
+		loyalty.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = giftcardconnect.NewGiftCardServiceHandler(
+		giftcards.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = cashconnect.NewCashServiceHandler(
+		cash.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = businessdayconnect.NewBusinessDayServiceHandler(
+		businessdays.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
+	)
+	mux.Handle(path, handler)
+
+	path, handler = reportconnect.NewReportServiceHandler(
+		reports.New(db),
+		connect.WithInterceptors(authInterceptor, authorizer, validate.NewInterceptor()),
 	)
 	mux.Handle(path, handler)
 
@@ -9,8 +9,10 @@ import (
 
 	brewpb "github.com/jany/my-coffee/gen/proto/brew"
 	menupb "github.com/jany/my-coffee/gen/proto/menu"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 // func main() {
@@ -51,9 +53,11 @@ func main() {
 	defer menuConn.Close()
 
 	// Connect to Brew Service (port 50051)
+	// brewsvc needs a bearer token, e.g. from `go run ./cmd/token`
 	brewConn, err := grpc.NewClient(
 		"localhost:50051",
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(bearerToken(os.Getenv("COFFEE_TOKEN"))),
 	)
 
 	if err != nil {
@@ -70,6 +74,21 @@ func main() {
 	runMenuLoop(menuClient, brewClient)
 }
 
+// bearerToken sends a JWT with every call. The services run without TLS
+// in development, so it does not insist on a secure transport.
+type bearerToken string
+
+func (t bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	if t == "" {
+		return nil, nil
+	}
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
 func runMenuLoop(menuClient menupb.MenuServiceClient, brewClient brewpb.BrewServiceClient) {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -121,6 +140,10 @@ func viewMenu(client menupb.MenuServiceClient) {
 
 	for index, item := range resp.Items {
 		fmt.Printf("%d_ %s _ $%.2f\n", index+1, item.Name, item.Price )
+		fmt.Printf("   %d kcal, %d mg caffeine\n", item.GetNutrition().GetCalories(), item.GetNutrition().GetCaffeineMg())
+		if len(item.Allergens) > 0 {
+			fmt.Printf("   Contains: %v\n", item.Allergens)
+		}
 		fmt.Println()
 		fmt.Println("===============================")
 	}
@@ -156,14 +179,103 @@ func createOrder(client brewpb.BrewServiceClient) {
 	input, _ := reader.ReadString('\n')
 	input = strings.TrimSpace(input)
 
-	resp, err := client.OrderDrink(ctx, &brewpb.OrderRequest{MenuItemName: input})
+	fmt.Printf("Enter promo code (optional): ")
+	promoCode, _ := reader.ReadString('\n')
+	promoCode = strings.TrimSpace(promoCode)
+
+	fmt.Printf("Name for the cup (optional): ")
+	customerName, _ := reader.ReadString('\n')
+	customerName = strings.TrimSpace(customerName)
+
+	resp, err := client.OrderDrink(ctx, &brewpb.OrderRequest{MenuItemName: input, PromoCode: promoCode, CustomerName: customerName})
 
 	if err != nil {
 		fmt.Printf("Order Drink error: %v\n", err)
+		printSuggestions(err)
+		return
+	}
+
+	fmt.Printf("Order drink %v, pickup code %s\n", resp.OrderId, resp.PickupCode)
+	printPrice(resp.Price)
+	// Printed last, so it shows how the order was paid.
+	defer printReceipt(ctx, client, resp.OrderId)
+
+	fmt.Printf("Enter gift card code (optional): ")
+	giftCard, _ := reader.ReadString('\n')
+	giftCard = strings.TrimSpace(giftCard)
+
+	fmt.Printf("Enter card token (leave empty to pay at the counter): ")
+	token, _ := reader.ReadString('\n')
+	token = strings.TrimSpace(token)
+	if token == "" && giftCard == "" {
+		return
+	}
+
+	payResp, err := client.PayOrder(ctx, &brewpb.PayOrderRequest{OrderId: resp.OrderId, PaymentToken: token, GiftCardCode: giftCard})
+	if err != nil {
+		fmt.Printf("Payment error: %v\n", err)
+		return
+	}
+
+	for _, payment := range payResp.Payments {
+		fmt.Printf("Payment %s %s %s %s\n", payment.PaymentId, payment.Method, formatCents(payment.AmountCents, payment.Currency), payment.Status)
+	}
+	if payResp.AmountDueCents > 0 {
+		fmt.Printf("Still due: %s\n", formatCents(payResp.AmountDueCents, payResp.Order.GetPrice().GetCurrency()))
+	}
+	fmt.Printf("Order is %s\n", payResp.Order.Status)
+}
+
+func printReceipt(ctx context.Context, client brewpb.BrewServiceClient, orderID string) {
+	resp, err := client.GetReceipt(ctx, &brewpb.GetReceiptRequest{OrderId: orderID})
+	if err != nil {
+		fmt.Printf("Receipt error: %v\n", err)
 		return
 	}
+	fmt.Println()
+	fmt.Println(resp.Text)
+}
 
-	fmt.Printf("Order drink %v \n", resp)
+func printPrice(price *brewpb.PriceBreakdown) {
+	for _, line := range price.GetLines() {
+		fmt.Printf("  %-20s x%d %10s\n", line.Description, line.Quantity, formatCents(line.TotalCents, price.Currency))
+	}
+	for _, discount := range price.GetDiscounts() {
+		fmt.Printf("  %-23s %10s\n", discount.Description, formatCents(-discount.AmountCents, price.Currency))
+	}
+	taxLabel := fmt.Sprintf("Tax (%.2f%%)", price.GetTaxRate()*100)
+	if price.GetTaxInclusive() {
+		taxLabel += " incl."
+	}
+	fmt.Printf("  %-23s %10s\n", taxLabel, formatCents(price.GetTaxCents(), price.GetCurrency()))
+	fmt.Printf("  %-23s %10s\n", "Total", formatCents(price.GetTotalCents(), price.GetCurrency()))
+}
+
+func formatCents(cents int64, currency string) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d %s", sign, cents/100, cents%100, currency)
+}
+
+// printSuggestions shows the "did you mean" hints brewsvc attaches when an
+// unknown drink is ordered.
+func printSuggestions(err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return
+	}
+
+	for _, detail := range st.Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.FieldViolations {
+			fmt.Printf("  - %s\n", violation.Description)
+		}
+	}
 }
 
 func showMenu() {
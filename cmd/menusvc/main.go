@@ -4,8 +4,14 @@ import (
 	"log"
 	"net/http"
 
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
 	"github.com/jany/my-coffee/gen/proto/menu/menuconnect"
+	"github.com/jany/my-coffee/internal/apikeys"
+	"github.com/jany/my-coffee/internal/auth"
+	database "github.com/jany/my-coffee/internal/datbase"
 	"github.com/jany/my-coffee/internal/menus"
+	"github.com/jany/my-coffee/internal/repository"
 )
 
 // cors middleware to allow requests from the Vite dev server
@@ -13,7 +19,8 @@ func cors(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol-Version, Authorization, X-API-Key")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Language")
 
 		if r.Method == http.MethodOptions {
 			w.WriteHeader(http.StatusNoContent)
@@ -25,9 +32,25 @@ func cors(next http.Handler) http.Handler {
 }
 
 func main() {
+	// Load config
+	config.Load()
+
+	// Connect to database, used to mark sold-out items
+	db := database.Connect()
+	defer database.Close()
+
+	// Browsing the menu is public; see auth.MenuPolicy. Other services
+	// call with an API key
+	verifier := auth.NewVerifier(config.AppConfig.JWT_SECRET).
+		WithAPIKeys(apikeys.NewChecker(repository.NewAPIKeyRepository(db)))
+	authInterceptor := auth.NewInterceptor(verifier, auth.MenuPolicy.Public()...)
+
 	mux := http.NewServeMux()
-	path, handler := menuconnect.NewMenuServiceHandler(menus.New())
-	mux.Handle(path, handler)
+	path, handler := menuconnect.NewMenuServiceHandler(
+		menus.New(db),
+		connect.WithInterceptors(authInterceptor, auth.NewAuthorizer(auth.MenuPolicy)),
+	)
+	mux.Handle(path, menus.ConditionalGET(handler))
 
 	// Use h2c so we can serve HTTP/2 without TLS (needed for gRPC compatibility)
 	p := new(http.Protocols)
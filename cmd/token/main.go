@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/auth"
+)
+
+// token issues a bearer token signed with JWT_SECRET, for staff devices
+// and for trying the services out:
+//
+//	go run ./cmd/token -sub barista-1 -roles barista -ttl 12h
+func main() {
+	subject := flag.String("sub", "", "who the token is for (required)")
+	roles := flag.String("roles", "", "comma separated roles: customer, barista, manager, admin")
+	ttl := flag.Duration("ttl", 12*time.Hour, "how long the token is valid")
+	flag.Parse()
+
+	if *subject == "" {
+		log.Fatal("Usage: go run ./cmd/token -sub <subject> [-roles a,b] [-ttl 12h]")
+	}
+
+	config.Load()
+
+	var roleList []string
+	if *roles != "" {
+		roleList = strings.Split(*roles, ",")
+	}
+	for _, role := range roleList {
+		if !slices.Contains(auth.Roles, auth.Role(role)) {
+			log.Fatalf("unknown role %q, use one of %v", role, auth.Roles)
+		}
+	}
+	token, err := auth.NewVerifier(config.AppConfig.JWT_SECRET).Sign(*subject, roleList, *ttl)
+	if err != nil {
+		log.Fatalf("failed to sign token: %v", err)
+	}
+	fmt.Println(token)
+}
@@ -3,6 +3,8 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +18,44 @@ type Config struct {
 	DBSSLMode  string
 	PORT       string
 	JWT_SECRET string
+
+	// Customer accounts. Access tokens are short lived; refresh tokens
+	// get a new one without the password.
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+
+	// Location is the shop's time zone, used for time-of-day discounts.
+	Location *time.Location
+
+	// Pricing
+	Currency     string
+	TaxRate      float64
+	TaxInclusive bool
+
+	// Payments
+	PaymentProvider string
+	// RequirePayment holds new orders in PENDING_PAYMENT until paid.
+	// Pay-at-counter shops turn it off.
+	RequirePayment bool
+
+	// Loyalty
+	LoyaltyStampsPerReward int
+
+	// Receipts. The templates are file paths that replace the built-in
+	// text/template and html/template receipts when set.
+	StoreName           string
+	StoreAddress        string
+	StorePhone          string
+	ReceiptFooter       string
+	ReceiptTextTemplate string
+	ReceiptHTMLTemplate string
+	// TrackingURL is the web page a receipt's QR code opens; the order ID
+	// and pickup code are added as query parameters.
+	TrackingURL string
+
+	// PrinterSink is where cup tickets are printed: "stdout",
+	// "file:/dev/usb/lp0" or "tcp:host:9100". Empty turns printing off.
+	PrinterSink string
 }
 
 var AppConfig *Config
@@ -34,6 +74,34 @@ func Load() {
 		DBSSLMode:  getEnv("DB_SSL_MODE", "disable"),
 		PORT:       getEnv("PORT", "8080"),
 		JWT_SECRET: getEnv("JWT_SECRET", "your_jwt_secret"),
+
+		AccessTokenTTL:  getEnvDuration("ACCESS_TOKEN_TTL", 15*time.Minute),
+		RefreshTokenTTL: getEnvDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour),
+
+		Location: getEnvLocation("TIMEZONE", "Local"),
+
+		Currency:     getEnv("CURRENCY", "USD"),
+		TaxRate:      getEnvFloat("TAX_RATE", 0),
+		TaxInclusive: getEnvBool("TAX_INCLUSIVE", false),
+
+		PaymentProvider: getEnv("PAYMENT_PROVIDER", "fake"),
+		RequirePayment:  getEnvBool("REQUIRE_PAYMENT", true),
+
+		LoyaltyStampsPerReward: getEnvInt("LOYALTY_STAMPS_PER_REWARD", 10),
+
+		StoreName:           getEnv("STORE_NAME", "My Coffee Shop"),
+		StoreAddress:        getEnv("STORE_ADDRESS", ""),
+		StorePhone:          getEnv("STORE_PHONE", ""),
+		ReceiptFooter:       getEnv("RECEIPT_FOOTER", "Thank you!"),
+		ReceiptTextTemplate: getEnv("RECEIPT_TEXT_TEMPLATE", ""),
+		ReceiptHTMLTemplate: getEnv("RECEIPT_HTML_TEMPLATE", ""),
+		TrackingURL:         getEnv("TRACKING_URL", "http://localhost:5173/track"),
+
+		PrinterSink: getEnv("PRINTER_SINK", ""),
+	}
+
+	if AppConfig.LoyaltyStampsPerReward < 1 {
+		log.Fatal("LOYALTY_STAMPS_PER_REWARD must be at least 1")
 	}
 
 	if AppConfig.JWT_SECRET == "" || AppConfig.JWT_SECRET == "your_jwt_secret" {
@@ -48,4 +116,60 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvInt(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		log.Fatalf("%s must be a whole number: %v", key, err)
+	}
+	return i
+}
+
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Fatalf("%s must be a number: %v", key, err)
+	}
+	return f
+}
+
+func getEnvBool(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("%s must be true or false: %v", key, err)
+	}
+	return b
+}
+
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("%s must be a duration such as 15m or 720h: %v", key, err)
+	}
+	return d
+}
+
+func getEnvLocation(key, defaultValue string) *time.Location {
+	loc, err := time.LoadLocation(getEnv(key, defaultValue))
+	if err != nil {
+		log.Fatalf("%s must be an IANA time zone such as Europe/Berlin: %v", key, err)
+	}
+	return loc
+}
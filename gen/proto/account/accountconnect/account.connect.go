@@ -0,0 +1,200 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: account/account.proto
+
+package accountconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	account "github.com/jany/my-coffee/gen/proto/account"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AccountServiceName is the fully-qualified name of the AccountService service.
+	AccountServiceName = "account.AccountService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AccountServiceRegisterProcedure is the fully-qualified name of the AccountService's Register RPC.
+	AccountServiceRegisterProcedure = "/account.AccountService/Register"
+	// AccountServiceLoginProcedure is the fully-qualified name of the AccountService's Login RPC.
+	AccountServiceLoginProcedure = "/account.AccountService/Login"
+	// AccountServiceRefreshTokenProcedure is the fully-qualified name of the AccountService's
+	// RefreshToken RPC.
+	AccountServiceRefreshTokenProcedure = "/account.AccountService/RefreshToken"
+	// AccountServiceChangePasswordProcedure is the fully-qualified name of the AccountService's
+	// ChangePassword RPC.
+	AccountServiceChangePasswordProcedure = "/account.AccountService/ChangePassword"
+)
+
+// AccountServiceClient is a client for the account.AccountService service.
+type AccountServiceClient interface {
+	Register(context.Context, *connect.Request[account.RegisterRequest]) (*connect.Response[account.RegisterResponse], error)
+	Login(context.Context, *connect.Request[account.LoginRequest]) (*connect.Response[account.LoginResponse], error)
+	// Trades a refresh token for new tokens. Each refresh token works once.
+	RefreshToken(context.Context, *connect.Request[account.RefreshTokenRequest]) (*connect.Response[account.RefreshTokenResponse], error)
+	// Changes the caller's password and signs out their other devices once
+	// their access tokens expire.
+	ChangePassword(context.Context, *connect.Request[account.ChangePasswordRequest]) (*connect.Response[account.ChangePasswordResponse], error)
+}
+
+// NewAccountServiceClient constructs a client for the account.AccountService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AccountServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	accountServiceMethods := account.File_account_account_proto.Services().ByName("AccountService").Methods()
+	return &accountServiceClient{
+		register: connect.NewClient[account.RegisterRequest, account.RegisterResponse](
+			httpClient,
+			baseURL+AccountServiceRegisterProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("Register")),
+			connect.WithClientOptions(opts...),
+		),
+		login: connect.NewClient[account.LoginRequest, account.LoginResponse](
+			httpClient,
+			baseURL+AccountServiceLoginProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("Login")),
+			connect.WithClientOptions(opts...),
+		),
+		refreshToken: connect.NewClient[account.RefreshTokenRequest, account.RefreshTokenResponse](
+			httpClient,
+			baseURL+AccountServiceRefreshTokenProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("RefreshToken")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[account.ChangePasswordRequest, account.ChangePasswordResponse](
+			httpClient,
+			baseURL+AccountServiceChangePasswordProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// accountServiceClient implements AccountServiceClient.
+type accountServiceClient struct {
+	register       *connect.Client[account.RegisterRequest, account.RegisterResponse]
+	login          *connect.Client[account.LoginRequest, account.LoginResponse]
+	refreshToken   *connect.Client[account.RefreshTokenRequest, account.RefreshTokenResponse]
+	changePassword *connect.Client[account.ChangePasswordRequest, account.ChangePasswordResponse]
+}
+
+// Register calls account.AccountService.Register.
+func (c *accountServiceClient) Register(ctx context.Context, req *connect.Request[account.RegisterRequest]) (*connect.Response[account.RegisterResponse], error) {
+	return c.register.CallUnary(ctx, req)
+}
+
+// Login calls account.AccountService.Login.
+func (c *accountServiceClient) Login(ctx context.Context, req *connect.Request[account.LoginRequest]) (*connect.Response[account.LoginResponse], error) {
+	return c.login.CallUnary(ctx, req)
+}
+
+// RefreshToken calls account.AccountService.RefreshToken.
+func (c *accountServiceClient) RefreshToken(ctx context.Context, req *connect.Request[account.RefreshTokenRequest]) (*connect.Response[account.RefreshTokenResponse], error) {
+	return c.refreshToken.CallUnary(ctx, req)
+}
+
+// ChangePassword calls account.AccountService.ChangePassword.
+func (c *accountServiceClient) ChangePassword(ctx context.Context, req *connect.Request[account.ChangePasswordRequest]) (*connect.Response[account.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// AccountServiceHandler is an implementation of the account.AccountService service.
+type AccountServiceHandler interface {
+	Register(context.Context, *connect.Request[account.RegisterRequest]) (*connect.Response[account.RegisterResponse], error)
+	Login(context.Context, *connect.Request[account.LoginRequest]) (*connect.Response[account.LoginResponse], error)
+	// Trades a refresh token for new tokens. Each refresh token works once.
+	RefreshToken(context.Context, *connect.Request[account.RefreshTokenRequest]) (*connect.Response[account.RefreshTokenResponse], error)
+	// Changes the caller's password and signs out their other devices once
+	// their access tokens expire.
+	ChangePassword(context.Context, *connect.Request[account.ChangePasswordRequest]) (*connect.Response[account.ChangePasswordResponse], error)
+}
+
+// NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	accountServiceMethods := account.File_account_account_proto.Services().ByName("AccountService").Methods()
+	accountServiceRegisterHandler := connect.NewUnaryHandler(
+		AccountServiceRegisterProcedure,
+		svc.Register,
+		connect.WithSchema(accountServiceMethods.ByName("Register")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceLoginHandler := connect.NewUnaryHandler(
+		AccountServiceLoginProcedure,
+		svc.Login,
+		connect.WithSchema(accountServiceMethods.ByName("Login")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceRefreshTokenHandler := connect.NewUnaryHandler(
+		AccountServiceRefreshTokenProcedure,
+		svc.RefreshToken,
+		connect.WithSchema(accountServiceMethods.ByName("RefreshToken")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceChangePasswordHandler := connect.NewUnaryHandler(
+		AccountServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(accountServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/account.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AccountServiceRegisterProcedure:
+			accountServiceRegisterHandler.ServeHTTP(w, r)
+		case AccountServiceLoginProcedure:
+			accountServiceLoginHandler.ServeHTTP(w, r)
+		case AccountServiceRefreshTokenProcedure:
+			accountServiceRefreshTokenHandler.ServeHTTP(w, r)
+		case AccountServiceChangePasswordProcedure:
+			accountServiceChangePasswordHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAccountServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAccountServiceHandler struct{}
+
+func (UnimplementedAccountServiceHandler) Register(context.Context, *connect.Request[account.RegisterRequest]) (*connect.Response[account.RegisterResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.AccountService.Register is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) Login(context.Context, *connect.Request[account.LoginRequest]) (*connect.Response[account.LoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.AccountService.Login is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) RefreshToken(context.Context, *connect.Request[account.RefreshTokenRequest]) (*connect.Response[account.RefreshTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.AccountService.RefreshToken is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ChangePassword(context.Context, *connect.Request[account.ChangePasswordRequest]) (*connect.Response[account.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("account.AccountService.ChangePassword is not implemented"))
+}
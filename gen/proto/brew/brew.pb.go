@@ -10,6 +10,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -31,6 +32,9 @@ const (
 	DrinkStatus_BREWING                  DrinkStatus = 3
 	DrinkStatus_FROTHING                 DrinkStatus = 4
 	DrinkStatus_READY                    DrinkStatus = 5
+	// Waiting for PayOrder before it is QUEUED.
+	DrinkStatus_PENDING_PAYMENT DrinkStatus = 6
+	DrinkStatus_CANCELLED       DrinkStatus = 7
 )
 
 // Enum value maps for DrinkStatus.
@@ -42,6 +46,8 @@ var (
 		3: "BREWING",
 		4: "FROTHING",
 		5: "READY",
+		6: "PENDING_PAYMENT",
+		7: "CANCELLED",
 	}
 	DrinkStatus_value = map[string]int32{
 		"DRINK_STATUS_UNSPECIFIED": 0,
@@ -50,6 +56,8 @@ var (
 		"BREWING":                  3,
 		"FROTHING":                 4,
 		"READY":                    5,
+		"PENDING_PAYMENT":          6,
+		"CANCELLED":                7,
 	}
 )
 
@@ -80,28 +88,1800 @@ func (DrinkStatus) EnumDescriptor() ([]byte, []int) {
 	return file_brew_brew_proto_rawDescGZIP(), []int{0}
 }
 
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_CSV                       ExportFormat = 1
+	// One JSON object per line.
+	ExportFormat_NDJSON ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "CSV",
+		2: "NDJSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"CSV":                       1,
+		"NDJSON":                    2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_brew_brew_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_brew_brew_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{1}
+}
+
 type OrderRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	MenuItemName string                 `protobuf:"bytes,1,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	// IDs of menu.Modifier entries to apply, e.g. "oat-milk".
+	ModifierIds []string `protobuf:"bytes,2,rep,name=modifier_ids,json=modifierIds,proto3" json:"modifier_ids,omitempty"`
+	// Number of drinks, 1 when zero.
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Optional promo code, case-insensitive. Discount rules such as happy
+	// hour apply automatically.
+	PromoCode string `protobuf:"bytes,4,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// Identifies the customer for per-customer promo limits. Only staff set
+	// it; customers always order as themselves, and orders placed with an
+	// API key belong to no customer.
+	CustomerId string `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Spend loyalty stamps to get one of the drinks free. Needs a customer,
+	// so API keys cannot.
+	RedeemLoyalty bool `protobuf:"varint,6,opt,name=redeem_loyalty,json=redeemLoyalty,proto3" json:"redeem_loyalty,omitempty"`
+	// Name written on the cup.
+	CustomerName  string `protobuf:"bytes,7,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderRequest) Reset() {
+	*x = OrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderRequest) ProtoMessage() {}
+
+func (x *OrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderRequest.ProtoReflect.Descriptor instead.
+func (*OrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrderRequest) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *OrderRequest) GetModifierIds() []string {
+	if x != nil {
+		return x.ModifierIds
+	}
+	return nil
+}
+
+func (x *OrderRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *OrderRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *OrderRequest) GetRedeemLoyalty() bool {
+	if x != nil {
+		return x.RedeemLoyalty
+	}
+	return false
+}
+
+func (x *OrderRequest) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+type OrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Price         *PriceBreakdown        `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,3,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderResponse) Reset() {
+	*x = OrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderResponse) ProtoMessage() {}
+
+func (x *OrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
+func (*OrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderResponse) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderResponse) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+// PriceLine is one row of the bill: the drink or a modifier surcharge.
+type PriceLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Description    string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Quantity       int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceCents int64                  `protobuf:"varint,3,opt,name=unit_price_cents,json=unitPriceCents,proto3" json:"unit_price_cents,omitempty"`
+	TotalCents     int64                  `protobuf:"varint,4,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_brew_brew_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PriceLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLine) GetUnitPriceCents() int64 {
+	if x != nil {
+		return x.UnitPriceCents
+	}
+	return 0
+}
+
+func (x *PriceLine) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+// PriceBreakdown is computed by brewsvc when the order is placed. Amounts
+// are in cents of currency. With tax_inclusive the tax is already part of
+// the line prices and total_cents equals subtotal minus discount.
+type PriceBreakdown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MenuItemName  string                 `protobuf:"bytes,1,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	Lines         []*PriceLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	SubtotalCents int64                  `protobuf:"varint,2,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"`
+	DiscountCents int64                  `protobuf:"varint,3,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`
+	TaxCents      int64                  `protobuf:"varint,4,opt,name=tax_cents,json=taxCents,proto3" json:"tax_cents,omitempty"`
+	TotalCents    int64                  `protobuf:"varint,5,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	TaxRate       float64                `protobuf:"fixed64,6,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxInclusive  bool                   `protobuf:"varint,7,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Discounts that make up discount_cents.
+	Discounts     []*DiscountLine `protobuf:"bytes,9,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_brew_brew_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PriceBreakdown) GetLines() []*PriceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PriceBreakdown) GetSubtotalCents() int64 {
+	if x != nil {
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTaxCents() int64 {
+	if x != nil {
+		return x.TaxCents
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTaxRate() float64 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTaxInclusive() bool {
+	if x != nil {
+		return x.TaxInclusive
+	}
+	return false
+}
+
+func (x *PriceBreakdown) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetDiscounts() []*DiscountLine {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type DiscountLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountLine) Reset() {
+	*x = DiscountLine{}
+	mi := &file_brew_brew_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountLine) ProtoMessage() {}
+
+func (x *DiscountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountLine.ProtoReflect.Descriptor instead.
+func (*DiscountLine) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiscountLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DiscountLine) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+type ListOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersRequest) Reset() {
+	*x = ListOrdersRequest{}
+	mi := &file_brew_brew_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRequest) ProtoMessage() {}
+
+func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{5}
+}
+
+type Order struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MenuItemName  string                 `protobuf:"bytes,2,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ModifierIds   []string               `protobuf:"bytes,4,rep,name=modifier_ids,json=modifierIds,proto3" json:"modifier_ids,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         *PriceBreakdown        `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Refunds       []*Refund              `protobuf:"bytes,7,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	RefundedCents int64                  `protobuf:"varint,8,opt,name=refunded_cents,json=refundedCents,proto3" json:"refunded_cents,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,9,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	// Short code shown to the customer to collect the drink. Only sent to
+	// the customer who placed the order.
+	PickupCode   string `protobuf:"bytes,11,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	CustomerName string `protobuf:"bytes,12,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	// Set once the drink has been handed over.
+	PickedUp      bool                   `protobuf:"varint,13,opt,name=picked_up,json=pickedUp,proto3" json:"picked_up,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_brew_brew_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Order) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Order) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetModifierIds() []string {
+	if x != nil {
+		return x.ModifierIds
+	}
+	return nil
+}
+
+func (x *Order) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Order) GetPrice() *PriceBreakdown {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Order) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *Order) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+func (x *Order) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Order) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Order) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+func (x *Order) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *Order) GetPickedUp() bool {
+	if x != nil {
+		return x.PickedUp
+	}
+	return false
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrdersResponse) Reset() {
+	*x = ListOrdersResponse{}
+	mi := &file_brew_brew_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersResponse) ProtoMessage() {}
+
+func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderRequest) ProtoMessage() {}
+
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderResponse) Reset() {
+	*x = GetOrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderResponse) ProtoMessage() {}
+
+func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type UpdateOrderStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        DrinkStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=brew.DrinkStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusRequest) Reset() {
+	*x = UpdateOrderStatusRequest{}
+	mi := &file_brew_brew_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusRequest) ProtoMessage() {}
+
+func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateOrderStatusRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetStatus() DrinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DrinkStatus_DRINK_STATUS_UNSPECIFIED
+}
+
+type UpdateOrderStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateOrderStatusResponse) Reset() {
+	*x = UpdateOrderStatusResponse{}
+	mi := &file_brew_brew_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOrderStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOrderStatusResponse) ProtoMessage() {}
+
+func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateOrderStatusResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type DeleteOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderRequest) Reset() {
+	*x = DeleteOrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderRequest) ProtoMessage() {}
+
+func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type DeleteOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOrderResponse) Reset() {
+	*x = DeleteOrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOrderResponse) ProtoMessage() {}
+
+func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetBaristaTicketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBaristaTicketRequest) Reset() {
+	*x = GetBaristaTicketRequest{}
+	mi := &file_brew_brew_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBaristaTicketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBaristaTicketRequest) ProtoMessage() {}
+
+func (x *GetBaristaTicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBaristaTicketRequest.ProtoReflect.Descriptor instead.
+func (*GetBaristaTicketRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBaristaTicketRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+// BaristaTicket lists the recipe stages of an order so the barista knows
+// what to do next and with which settings.
+type BaristaTicket struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	OrderId      string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MenuItemName string                 `protobuf:"bytes,2,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	Status       string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Status UpdateOrderStatus accepts next, unspecified once READY.
+	NextStatus    DrinkStatus   `protobuf:"varint,4,opt,name=next_status,json=nextStatus,proto3,enum=brew.DrinkStatus" json:"next_status,omitempty"`
+	Steps         []*TicketStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaristaTicket) Reset() {
+	*x = BaristaTicket{}
+	mi := &file_brew_brew_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaristaTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaristaTicket) ProtoMessage() {}
+
+func (x *BaristaTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaristaTicket.ProtoReflect.Descriptor instead.
+func (*BaristaTicket) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BaristaTicket) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *BaristaTicket) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *BaristaTicket) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BaristaTicket) GetNextStatus() DrinkStatus {
+	if x != nil {
+		return x.NextStatus
+	}
+	return DrinkStatus_DRINK_STATUS_UNSPECIFIED
+}
+
+func (x *BaristaTicket) GetSteps() []*TicketStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type TicketStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        DrinkStatus            `protobuf:"varint,1,opt,name=status,proto3,enum=brew.DrinkStatus" json:"status,omitempty"`
+	TargetSeconds int32                  `protobuf:"varint,2,opt,name=target_seconds,json=targetSeconds,proto3" json:"target_seconds,omitempty"`
+	Parameters    map[string]string      `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Done          bool                   `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketStep) Reset() {
+	*x = TicketStep{}
+	mi := &file_brew_brew_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketStep) ProtoMessage() {}
+
+func (x *TicketStep) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketStep.ProtoReflect.Descriptor instead.
+func (*TicketStep) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TicketStep) GetStatus() DrinkStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DrinkStatus_DRINK_STATUS_UNSPECIFIED
+}
+
+func (x *TicketStep) GetTargetSeconds() int32 {
+	if x != nil {
+		return x.TargetSeconds
+	}
+	return 0
+}
+
+func (x *TicketStep) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *TicketStep) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type GetBaristaTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        *BaristaTicket         `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBaristaTicketResponse) Reset() {
+	*x = GetBaristaTicketResponse{}
+	mi := &file_brew_brew_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBaristaTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBaristaTicketResponse) ProtoMessage() {}
+
+func (x *GetBaristaTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBaristaTicketResponse.ProtoReflect.Descriptor instead.
+func (*GetBaristaTicketResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBaristaTicketResponse) GetTicket() *BaristaTicket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+// PayOrderRequest pays what is still due on an order. A gift card is used
+// first, then cash; the card token, if given, pays the rest. Without a
+// token, tenders that do not cover the order pay part of it and the order
+// keeps waiting for the remainder.
+type PayOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Card token from the payment provider's client SDK.
+	PaymentToken string `protobuf:"bytes,2,opt,name=payment_token,json=paymentToken,proto3" json:"payment_token,omitempty"`
+	GiftCardCode string `protobuf:"bytes,3,opt,name=gift_card_code,json=giftCardCode,proto3" json:"gift_card_code,omitempty"`
+	// Cash handed over at a register, applied after the gift card and
+	// before the card.
+	Cash          *CashTender `protobuf:"bytes,4,opt,name=cash,proto3" json:"cash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayOrderRequest) Reset() {
+	*x = PayOrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderRequest) ProtoMessage() {}
+
+func (x *PayOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderRequest.ProtoReflect.Descriptor instead.
+func (*PayOrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PayOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetPaymentToken() string {
+	if x != nil {
+		return x.PaymentToken
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetGiftCardCode() string {
+	if x != nil {
+		return x.GiftCardCode
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetCash() *CashTender {
+	if x != nil {
+		return x.Cash
+	}
+	return nil
+}
+
+type CashTender struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Register         string                 `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	AmountGivenCents int64                  `protobuf:"varint,2,opt,name=amount_given_cents,json=amountGivenCents,proto3" json:"amount_given_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CashTender) Reset() {
+	*x = CashTender{}
+	mi := &file_brew_brew_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashTender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashTender) ProtoMessage() {}
+
+func (x *CashTender) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashTender.ProtoReflect.Descriptor instead.
+func (*CashTender) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CashTender) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *CashTender) GetAmountGivenCents() int64 {
+	if x != nil {
+		return x.AmountGivenCents
+	}
+	return 0
+}
+
+type Payment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId   string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// AUTHORIZED, CAPTURED, DECLINED or REFUNDED
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// CARD, GIFT_CARD or CASH
+	Method        string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	AmountCents   int64  `protobuf:"varint,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	CapturedCents int64  `protobuf:"varint,6,opt,name=captured_cents,json=capturedCents,proto3" json:"captured_cents,omitempty"`
+	Currency      string `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Provider      string `protobuf:"bytes,8,opt,name=provider,proto3" json:"provider,omitempty"`
+	RefundedCents int64  `protobuf:"varint,9,opt,name=refunded_cents,json=refundedCents,proto3" json:"refunded_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_brew_brew_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Payment) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Payment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Payment) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Payment) GetCapturedCents() int64 {
+	if x != nil {
+		return x.CapturedCents
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Payment) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+type PayOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The last payment made by this call: the card when one was charged.
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Order   *Order   `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
+	// Every payment made by this call.
+	Payments []*Payment `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+	// Still to be paid, zero once the order is covered.
+	AmountDueCents int64 `protobuf:"varint,4,opt,name=amount_due_cents,json=amountDueCents,proto3" json:"amount_due_cents,omitempty"`
+	// Cash to hand back to the customer.
+	ChangeDueCents int64 `protobuf:"varint,5,opt,name=change_due_cents,json=changeDueCents,proto3" json:"change_due_cents,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PayOrderResponse) Reset() {
+	*x = PayOrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayOrderResponse) ProtoMessage() {}
+
+func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
+func (*PayOrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PayOrderResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+func (x *PayOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *PayOrderResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *PayOrderResponse) GetAmountDueCents() int64 {
+	if x != nil {
+		return x.AmountDueCents
+	}
+	return 0
+}
+
+func (x *PayOrderResponse) GetChangeDueCents() int64 {
+	if x != nil {
+		return x.ChangeDueCents
+	}
+	return 0
+}
+
+type GetPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentRequest) Reset() {
+	*x = GetPaymentRequest{}
+	mi := &file_brew_brew_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentRequest) ProtoMessage() {}
+
+func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type GetPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentResponse) Reset() {
+	*x = GetPaymentResponse{}
+	mi := &file_brew_brew_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentResponse) ProtoMessage() {}
+
+func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type Refund struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      string                 `protobuf:"bytes,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_brew_brew_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Refund) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *Refund) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *Refund) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Order *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	// One per payment that was refunded, empty when nothing was paid.
+	Refunds       []*Refund `protobuf:"bytes,2,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CancelOrderResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+type RefundOrderRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Amount to refund, everything still refundable when zero.
+	AmountCents   int64  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderRequest) Reset() {
-	*x = OrderRequest{}
-	mi := &file_brew_brew_proto_msgTypes[0]
+func (x *RefundOrderRequest) Reset() {
+	*x = RefundOrderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderRequest) String() string {
+func (x *RefundOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderRequest) ProtoMessage() {}
+func (*RefundOrderRequest) ProtoMessage() {}
 
-func (x *OrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[0]
+func (x *RefundOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,40 +1892,56 @@ func (x *OrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderRequest.ProtoReflect.Descriptor instead.
-func (*OrderRequest) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use RefundOrderRequest.ProtoReflect.Descriptor instead.
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *OrderRequest) GetMenuItemName() string {
+func (x *RefundOrderRequest) GetOrderId() string {
 	if x != nil {
-		return x.MenuItemName
+		return x.OrderId
 	}
 	return ""
 }
 
-type OrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+func (x *RefundOrderRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *RefundOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RefundOrderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One per payment the amount was taken from, newest payment first.
+	Refunds       []*Refund `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Order         *Order    `protobuf:"bytes,2,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderResponse) Reset() {
-	*x = OrderResponse{}
-	mi := &file_brew_brew_proto_msgTypes[1]
+func (x *RefundOrderResponse) Reset() {
+	*x = RefundOrderResponse{}
+	mi := &file_brew_brew_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderResponse) String() string {
+func (x *RefundOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderResponse) ProtoMessage() {}
+func (*RefundOrderResponse) ProtoMessage() {}
 
-func (x *OrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[1]
+func (x *RefundOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -156,39 +1952,47 @@ func (x *OrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderResponse.ProtoReflect.Descriptor instead.
-func (*OrderResponse) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RefundOrderResponse.ProtoReflect.Descriptor instead.
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *OrderResponse) GetOrderId() string {
+func (x *RefundOrderResponse) GetRefunds() []*Refund {
 	if x != nil {
-		return x.OrderId
+		return x.Refunds
 	}
-	return ""
+	return nil
 }
 
-type ListOrdersRequest struct {
+func (x *RefundOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetReceiptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersRequest) Reset() {
-	*x = ListOrdersRequest{}
-	mi := &file_brew_brew_proto_msgTypes[2]
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_brew_brew_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersRequest) String() string {
+func (x *GetReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersRequest) ProtoMessage() {}
+func (*GetReceiptRequest) ProtoMessage() {}
 
-func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[2]
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,35 +2003,41 @@ func (x *ListOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{29}
 }
 
-type Order struct {
+func (x *GetReceiptRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetReceiptResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	MenuItemName  string                 `protobuf:"bytes,2,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Html          string                 `protobuf:"bytes,2,opt,name=html,proto3" json:"html,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
-	mi := &file_brew_brew_proto_msgTypes[3]
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_brew_brew_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *GetReceiptResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*GetReceiptResponse) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[3]
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,54 +2048,48 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Order) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Order) GetMenuItemName() string {
+func (x *GetReceiptResponse) GetText() string {
 	if x != nil {
-		return x.MenuItemName
+		return x.Text
 	}
 	return ""
 }
 
-func (x *Order) GetStatus() string {
+func (x *GetReceiptResponse) GetHtml() string {
 	if x != nil {
-		return x.Status
+		return x.Html
 	}
 	return ""
 }
 
-type ListOrdersResponse struct {
+type VerifyPickupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,2,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOrdersResponse) Reset() {
-	*x = ListOrdersResponse{}
-	mi := &file_brew_brew_proto_msgTypes[4]
+func (x *VerifyPickupRequest) Reset() {
+	*x = VerifyPickupRequest{}
+	mi := &file_brew_brew_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOrdersResponse) String() string {
+func (x *VerifyPickupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOrdersResponse) ProtoMessage() {}
+func (*VerifyPickupRequest) ProtoMessage() {}
 
-func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[4]
+func (x *VerifyPickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -296,40 +2100,47 @@ func (x *ListOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use VerifyPickupRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPickupRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListOrdersResponse) GetOrders() []*Order {
+func (x *VerifyPickupRequest) GetOrderId() string {
 	if x != nil {
-		return x.Orders
+		return x.OrderId
 	}
-	return nil
+	return ""
 }
 
-type GetOrderRequest struct {
+func (x *VerifyPickupRequest) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+type VerifyPickupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
-	mi := &file_brew_brew_proto_msgTypes[5]
+func (x *VerifyPickupResponse) Reset() {
+	*x = VerifyPickupResponse{}
+	mi := &file_brew_brew_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *VerifyPickupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*VerifyPickupResponse) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[5]
+func (x *VerifyPickupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,40 +2151,42 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use VerifyPickupResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPickupResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetOrderRequest) GetOrderId() string {
+func (x *VerifyPickupResponse) GetOrder() *Order {
 	if x != nil {
-		return x.OrderId
+		return x.Order
 	}
-	return ""
+	return nil
 }
 
-type GetOrderResponse struct {
+type ExportOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=brew.ExportFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderResponse) Reset() {
-	*x = GetOrderResponse{}
-	mi := &file_brew_brew_proto_msgTypes[6]
+func (x *ExportOrdersRequest) Reset() {
+	*x = ExportOrdersRequest{}
+	mi := &file_brew_brew_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderResponse) String() string {
+func (x *ExportOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderResponse) ProtoMessage() {}
+func (*ExportOrdersRequest) ProtoMessage() {}
 
-func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[6]
+func (x *ExportOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,41 +2197,55 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ExportOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetOrderResponse) GetOrder() *Order {
+func (x *ExportOrdersRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Order
+		return x.Start
 	}
 	return nil
 }
 
-type UpdateOrderStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Status        DrinkStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=brew.DrinkStatus" json:"status,omitempty"`
+func (x *ExportOrdersRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ExportOrdersRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportOrdersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The next part of the file; the chunks concatenated form the export.
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusRequest) Reset() {
-	*x = UpdateOrderStatusRequest{}
-	mi := &file_brew_brew_proto_msgTypes[7]
+func (x *ExportOrdersResponse) Reset() {
+	*x = ExportOrdersResponse{}
+	mi := &file_brew_brew_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusRequest) String() string {
+func (x *ExportOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusRequest) ProtoMessage() {}
+func (*ExportOrdersResponse) ProtoMessage() {}
 
-func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[7]
+func (x *ExportOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -429,47 +2256,41 @@ func (x *UpdateOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *UpdateOrderStatusRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
+// Deprecated: Use ExportOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ExportOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateOrderStatusRequest) GetStatus() DrinkStatus {
+func (x *ExportOrdersResponse) GetData() []byte {
 	if x != nil {
-		return x.Status
+		return x.Data
 	}
-	return DrinkStatus_DRINK_STATUS_UNSPECIFIED
+	return nil
 }
 
-type UpdateOrderStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+type ListMyOrdersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of orders to return, 20 when zero.
+	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOrderStatusResponse) Reset() {
-	*x = UpdateOrderStatusResponse{}
-	mi := &file_brew_brew_proto_msgTypes[8]
+func (x *ListMyOrdersRequest) Reset() {
+	*x = ListMyOrdersRequest{}
+	mi := &file_brew_brew_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOrderStatusResponse) String() string {
+func (x *ListMyOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOrderStatusResponse) ProtoMessage() {}
+func (*ListMyOrdersRequest) ProtoMessage() {}
 
-func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[8]
+func (x *ListMyOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,40 +2301,40 @@ func (x *UpdateOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListMyOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListMyOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *UpdateOrderStatusResponse) GetOrder() *Order {
+func (x *ListMyOrdersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Order
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type DeleteOrderRequest struct {
+type ListMyOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrderRequest) Reset() {
-	*x = DeleteOrderRequest{}
-	mi := &file_brew_brew_proto_msgTypes[9]
+func (x *ListMyOrdersResponse) Reset() {
+	*x = ListMyOrdersResponse{}
+	mi := &file_brew_brew_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrderRequest) String() string {
+func (x *ListMyOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderRequest) ProtoMessage() {}
+func (*ListMyOrdersResponse) ProtoMessage() {}
 
-func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[9]
+func (x *ListMyOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,40 +2345,41 @@ func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListMyOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListMyOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeleteOrderRequest) GetOrderId() string {
+func (x *ListMyOrdersResponse) GetOrders() []*Order {
 	if x != nil {
-		return x.OrderId
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-type DeleteOrderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+type ReorderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// An earlier order of the caller's.
+	OrderId       string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOrderResponse) Reset() {
-	*x = DeleteOrderResponse{}
-	mi := &file_brew_brew_proto_msgTypes[10]
+func (x *ReorderRequest) Reset() {
+	*x = ReorderRequest{}
+	mi := &file_brew_brew_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOrderResponse) String() string {
+func (x *ReorderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOrderResponse) ProtoMessage() {}
+func (*ReorderRequest) ProtoMessage() {}
 
-func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_brew_brew_proto_msgTypes[10]
+func (x *ReorderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brew_brew_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,32 +2390,79 @@ func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
-func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_brew_brew_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReorderRequest.ProtoReflect.Descriptor instead.
+func (*ReorderRequest) Descriptor() ([]byte, []int) {
+	return file_brew_brew_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteOrderResponse) GetSuccess() bool {
+func (x *ReorderRequest) GetOrderId() string {
 	if x != nil {
-		return x.Success
+		return x.OrderId
 	}
-	return false
+	return ""
 }
 
 var File_brew_brew_proto protoreflect.FileDescriptor
 
 const file_brew_brew_proto_rawDesc = "" +
 	"\n" +
-	"\x0fbrew/brew.proto\x12\x04brew\x1a\x1bbuf/validate/validate.proto\"=\n" +
+	"\x0fbrew/brew.proto\x12\x04brew\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaf\x02\n" +
 	"\fOrderRequest\x12-\n" +
-	"\x0emenu_item_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fmenuItemName\"*\n" +
+	"\x0emenu_item_name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\fmenuItemName\x12!\n" +
+	"\fmodifier_ids\x18\x02 \x03(\tR\vmodifierIds\x12%\n" +
+	"\bquantity\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x14(\x00R\bquantity\x12&\n" +
+	"\n" +
+	"promo_code\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x182R\tpromoCode\x12)\n" +
+	"\vcustomer_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"customerId\x12%\n" +
+	"\x0eredeem_loyalty\x18\x06 \x01(\bR\rredeemLoyalty\x12,\n" +
+	"\rcustomer_name\x18\a \x01(\tB\a\xbaH\x04r\x02\x182R\fcustomerName\"w\n" +
 	"\rOrderResponse\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\tR\aorderId\"\x13\n" +
-	"\x11ListOrdersRequest\"`\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12*\n" +
+	"\x05price\x18\x02 \x01(\v2\x14.brew.PriceBreakdownR\x05price\x12\x1f\n" +
+	"\vpickup_code\x18\x03 \x01(\tR\n" +
+	"pickupCode\"\x94\x01\n" +
+	"\tPriceLine\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12(\n" +
+	"\x10unit_price_cents\x18\x03 \x01(\x03R\x0eunitPriceCents\x12\x1f\n" +
+	"\vtotal_cents\x18\x04 \x01(\x03R\n" +
+	"totalCents\"\xd1\x02\n" +
+	"\x0ePriceBreakdown\x12%\n" +
+	"\x05lines\x18\x01 \x03(\v2\x0f.brew.PriceLineR\x05lines\x12%\n" +
+	"\x0esubtotal_cents\x18\x02 \x01(\x03R\rsubtotalCents\x12%\n" +
+	"\x0ediscount_cents\x18\x03 \x01(\x03R\rdiscountCents\x12\x1b\n" +
+	"\ttax_cents\x18\x04 \x01(\x03R\btaxCents\x12\x1f\n" +
+	"\vtotal_cents\x18\x05 \x01(\x03R\n" +
+	"totalCents\x12\x19\n" +
+	"\btax_rate\x18\x06 \x01(\x01R\ataxRate\x12#\n" +
+	"\rtax_inclusive\x18\a \x01(\bR\ftaxInclusive\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x120\n" +
+	"\tdiscounts\x18\t \x03(\v2\x12.brew.DiscountLineR\tdiscounts\"S\n" +
+	"\fDiscountLine\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\"\x13\n" +
+	"\x11ListOrdersRequest\"\xf8\x03\n" +
 	"\x05Order\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\tR\aorderId\x12$\n" +
 	"\x0emenu_item_name\x18\x02 \x01(\tR\fmenuItemName\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"9\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12!\n" +
+	"\fmodifier_ids\x18\x04 \x03(\tR\vmodifierIds\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12*\n" +
+	"\x05price\x18\x06 \x01(\v2\x14.brew.PriceBreakdownR\x05price\x12&\n" +
+	"\arefunds\x18\a \x03(\v2\f.brew.RefundR\arefunds\x12%\n" +
+	"\x0erefunded_cents\x18\b \x01(\x03R\rrefundedCents\x12\x1f\n" +
+	"\vcustomer_id\x18\t \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\n" +
+	" \x01(\tR\tpromoCode\x12\x1f\n" +
+	"\vpickup_code\x18\v \x01(\tR\n" +
+	"pickupCode\x12#\n" +
+	"\rcustomer_name\x18\f \x01(\tR\fcustomerName\x12\x1b\n" +
+	"\tpicked_up\x18\r \x01(\bR\bpickedUp\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"9\n" +
 	"\x12ListOrdersResponse\x12#\n" +
 	"\x06orders\x18\x01 \x03(\v2\v.brew.OrderR\x06orders\"5\n" +
 	"\x0fGetOrderRequest\x12\"\n" +
@@ -608,7 +2477,107 @@ const file_brew_brew_proto_rawDesc = "" +
 	"\x12DeleteOrderRequest\x12\"\n" +
 	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"/\n" +
 	"\x13DeleteOrderResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess*k\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"=\n" +
+	"\x17GetBaristaTicketRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"\xc4\x01\n" +
+	"\rBaristaTicket\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12$\n" +
+	"\x0emenu_item_name\x18\x02 \x01(\tR\fmenuItemName\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x122\n" +
+	"\vnext_status\x18\x04 \x01(\x0e2\x11.brew.DrinkStatusR\n" +
+	"nextStatus\x12&\n" +
+	"\x05steps\x18\x05 \x03(\v2\x10.brew.TicketStepR\x05steps\"\xf3\x01\n" +
+	"\n" +
+	"TicketStep\x12)\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x11.brew.DrinkStatusR\x06status\x12%\n" +
+	"\x0etarget_seconds\x18\x02 \x01(\x05R\rtargetSeconds\x12@\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v2 .brew.TicketStep.ParametersEntryR\n" +
+	"parameters\x12\x12\n" +
+	"\x04done\x18\x04 \x01(\bR\x04done\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
+	"\x18GetBaristaTicketResponse\x12+\n" +
+	"\x06ticket\x18\x01 \x01(\v2\x13.brew.BaristaTicketR\x06ticket\"\xd4\x01\n" +
+	"\x0fPayOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12#\n" +
+	"\rpayment_token\x18\x02 \x01(\tR\fpaymentToken\x12$\n" +
+	"\x0egift_card_code\x18\x03 \x01(\tR\fgiftCardCode\x12$\n" +
+	"\x04cash\x18\x04 \x01(\v2\x10.brew.CashTenderR\x04cash:,\xbaH)\"'\n" +
+	"\rpayment_token\n" +
+	"\x0egift_card_code\n" +
+	"\x04cash\x10\x01\"h\n" +
+	"\n" +
+	"CashTender\x12#\n" +
+	"\bregister\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bregister\x125\n" +
+	"\x12amount_given_cents\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\x10amountGivenCents\"\x9c\x02\n" +
+	"\aPayment\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12!\n" +
+	"\famount_cents\x18\x05 \x01(\x03R\vamountCents\x12%\n" +
+	"\x0ecaptured_cents\x18\x06 \x01(\x03R\rcapturedCents\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bprovider\x18\b \x01(\tR\bprovider\x12%\n" +
+	"\x0erefunded_cents\x18\t \x01(\x03R\rrefundedCents\"\xdd\x01\n" +
+	"\x10PayOrderResponse\x12'\n" +
+	"\apayment\x18\x01 \x01(\v2\r.brew.PaymentR\apayment\x12!\n" +
+	"\x05order\x18\x02 \x01(\v2\v.brew.OrderR\x05order\x12)\n" +
+	"\bpayments\x18\x03 \x03(\v2\r.brew.PaymentR\bpayments\x12(\n" +
+	"\x10amount_due_cents\x18\x04 \x01(\x03R\x0eamountDueCents\x12(\n" +
+	"\x10change_due_cents\x18\x05 \x01(\x03R\x0echangeDueCents\";\n" +
+	"\x11GetPaymentRequest\x12&\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tpaymentId\"=\n" +
+	"\x12GetPaymentResponse\x12'\n" +
+	"\apayment\x18\x01 \x01(\v2\r.brew.PaymentR\apayment\"\x9b\x01\n" +
+	"\x06Refund\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\tR\brefundId\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x02 \x01(\tR\tpaymentId\x12!\n" +
+	"\famount_cents\x18\x03 \x01(\x03R\vamountCents\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\"i\n" +
+	"\x12CancelOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12\x1f\n" +
+	"\x06reason\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06reasonJ\x04\b\x03\x10\x04R\boperator\"`\n" +
+	"\x13CancelOrderResponse\x12!\n" +
+	"\x05order\x18\x01 \x01(\v2\v.brew.OrderR\x05order\x12&\n" +
+	"\arefunds\x18\x02 \x03(\v2\f.brew.RefundR\arefunds\"\x95\x01\n" +
+	"\x12RefundOrderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12*\n" +
+	"\famount_cents\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vamountCents\x12\x1f\n" +
+	"\x06reason\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06reasonJ\x04\b\x04\x10\x05R\boperator\"`\n" +
+	"\x13RefundOrderResponse\x12&\n" +
+	"\arefunds\x18\x01 \x03(\v2\f.brew.RefundR\arefunds\x12!\n" +
+	"\x05order\x18\x02 \x01(\v2\v.brew.OrderR\x05order\"7\n" +
+	"\x11GetReceiptRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\"<\n" +
+	"\x12GetReceiptResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04html\x18\x02 \x01(\tR\x04html\"c\n" +
+	"\x13VerifyPickupRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId\x12(\n" +
+	"\vpickup_code\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\n" +
+	"pickupCode\"9\n" +
+	"\x14VerifyPickupResponse\x12!\n" +
+	"\x05order\x18\x01 \x01(\v2\v.brew.OrderR\x05order\"\xbd\x01\n" +
+	"\x13ExportOrdersRequest\x128\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x05start\x124\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x03end\x126\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x12.brew.ExportFormatB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06format\"*\n" +
+	"\x14ExportOrdersResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"6\n" +
+	"\x13ListMyOrdersRequest\x12\x1f\n" +
+	"\x05limit\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\";\n" +
+	"\x14ListMyOrdersResponse\x12#\n" +
+	"\x06orders\x18\x01 \x03(\v2\v.brew.OrderR\x06orders\"4\n" +
+	"\x0eReorderRequest\x12\"\n" +
+	"\border_id\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\aorderId*\x8f\x01\n" +
 	"\vDrinkStatus\x12\x1c\n" +
 	"\x18DRINK_STATUS_UNSPECIFIED\x10\x00\x12\n" +
 	"\n" +
@@ -616,7 +2585,14 @@ const file_brew_brew_proto_rawDesc = "" +
 	"\bGRINDING\x10\x02\x12\v\n" +
 	"\aBREWING\x10\x03\x12\f\n" +
 	"\bFROTHING\x10\x04\x12\t\n" +
-	"\x05READY\x10\x052\xda\x02\n" +
+	"\x05READY\x10\x05\x12\x13\n" +
+	"\x0fPENDING_PAYMENT\x10\x06\x12\r\n" +
+	"\tCANCELLED\x10\a*B\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03CSV\x10\x01\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x022\x8e\b\n" +
 	"\vBrewService\x125\n" +
 	"\n" +
 	"OrderDrink\x12\x12.brew.OrderRequest\x1a\x13.brew.OrderResponse\x12?\n" +
@@ -624,7 +2600,19 @@ const file_brew_brew_proto_rawDesc = "" +
 	"ListOrders\x12\x17.brew.ListOrdersRequest\x1a\x18.brew.ListOrdersResponse\x129\n" +
 	"\bGetOrder\x12\x15.brew.GetOrderRequest\x1a\x16.brew.GetOrderResponse\x12T\n" +
 	"\x11UpdateOrderStatus\x12\x1e.brew.UpdateOrderStatusRequest\x1a\x1f.brew.UpdateOrderStatusResponse\x12B\n" +
-	"\vDeleteOrder\x12\x18.brew.DeleteOrderRequest\x1a\x19.brew.DeleteOrderResponseBo\n" +
+	"\vDeleteOrder\x12\x18.brew.DeleteOrderRequest\x1a\x19.brew.DeleteOrderResponse\x12Q\n" +
+	"\x10GetBaristaTicket\x12\x1d.brew.GetBaristaTicketRequest\x1a\x1e.brew.GetBaristaTicketResponse\x129\n" +
+	"\bPayOrder\x12\x15.brew.PayOrderRequest\x1a\x16.brew.PayOrderResponse\x12?\n" +
+	"\n" +
+	"GetPayment\x12\x17.brew.GetPaymentRequest\x1a\x18.brew.GetPaymentResponse\x12B\n" +
+	"\vCancelOrder\x12\x18.brew.CancelOrderRequest\x1a\x19.brew.CancelOrderResponse\x12B\n" +
+	"\vRefundOrder\x12\x18.brew.RefundOrderRequest\x1a\x19.brew.RefundOrderResponse\x12D\n" +
+	"\n" +
+	"GetReceipt\x12\x17.brew.GetReceiptRequest\x1a\x18.brew.GetReceiptResponse\"\x03\x90\x02\x01\x12E\n" +
+	"\fVerifyPickup\x12\x19.brew.VerifyPickupRequest\x1a\x1a.brew.VerifyPickupResponse\x12L\n" +
+	"\fExportOrders\x12\x19.brew.ExportOrdersRequest\x1a\x1a.brew.ExportOrdersResponse\"\x03\x90\x02\x010\x01\x12J\n" +
+	"\fListMyOrders\x12\x19.brew.ListMyOrdersRequest\x1a\x1a.brew.ListMyOrdersResponse\"\x03\x90\x02\x01\x124\n" +
+	"\aReorder\x12\x14.brew.ReorderRequest\x1a\x13.brew.OrderResponseBo\n" +
 	"\bcom.brewB\tBrewProtoP\x01Z(github.com/jany/my-coffee/gen/proto/brew\xa2\x02\x03BXX\xaa\x02\x04Brew\xca\x02\x04Brew\xe2\x02\x10Brew\\GPBMetadata\xea\x02\x04Brewb\x06proto3"
 
 var (
@@ -639,42 +2627,117 @@ func file_brew_brew_proto_rawDescGZIP() []byte {
 	return file_brew_brew_proto_rawDescData
 }
 
-var file_brew_brew_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_brew_brew_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_brew_brew_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_brew_brew_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_brew_brew_proto_goTypes = []any{
 	(DrinkStatus)(0),                  // 0: brew.DrinkStatus
-	(*OrderRequest)(nil),              // 1: brew.OrderRequest
-	(*OrderResponse)(nil),             // 2: brew.OrderResponse
-	(*ListOrdersRequest)(nil),         // 3: brew.ListOrdersRequest
-	(*Order)(nil),                     // 4: brew.Order
-	(*ListOrdersResponse)(nil),        // 5: brew.ListOrdersResponse
-	(*GetOrderRequest)(nil),           // 6: brew.GetOrderRequest
-	(*GetOrderResponse)(nil),          // 7: brew.GetOrderResponse
-	(*UpdateOrderStatusRequest)(nil),  // 8: brew.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 9: brew.UpdateOrderStatusResponse
-	(*DeleteOrderRequest)(nil),        // 10: brew.DeleteOrderRequest
-	(*DeleteOrderResponse)(nil),       // 11: brew.DeleteOrderResponse
+	(ExportFormat)(0),                 // 1: brew.ExportFormat
+	(*OrderRequest)(nil),              // 2: brew.OrderRequest
+	(*OrderResponse)(nil),             // 3: brew.OrderResponse
+	(*PriceLine)(nil),                 // 4: brew.PriceLine
+	(*PriceBreakdown)(nil),            // 5: brew.PriceBreakdown
+	(*DiscountLine)(nil),              // 6: brew.DiscountLine
+	(*ListOrdersRequest)(nil),         // 7: brew.ListOrdersRequest
+	(*Order)(nil),                     // 8: brew.Order
+	(*ListOrdersResponse)(nil),        // 9: brew.ListOrdersResponse
+	(*GetOrderRequest)(nil),           // 10: brew.GetOrderRequest
+	(*GetOrderResponse)(nil),          // 11: brew.GetOrderResponse
+	(*UpdateOrderStatusRequest)(nil),  // 12: brew.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil), // 13: brew.UpdateOrderStatusResponse
+	(*DeleteOrderRequest)(nil),        // 14: brew.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),       // 15: brew.DeleteOrderResponse
+	(*GetBaristaTicketRequest)(nil),   // 16: brew.GetBaristaTicketRequest
+	(*BaristaTicket)(nil),             // 17: brew.BaristaTicket
+	(*TicketStep)(nil),                // 18: brew.TicketStep
+	(*GetBaristaTicketResponse)(nil),  // 19: brew.GetBaristaTicketResponse
+	(*PayOrderRequest)(nil),           // 20: brew.PayOrderRequest
+	(*CashTender)(nil),                // 21: brew.CashTender
+	(*Payment)(nil),                   // 22: brew.Payment
+	(*PayOrderResponse)(nil),          // 23: brew.PayOrderResponse
+	(*GetPaymentRequest)(nil),         // 24: brew.GetPaymentRequest
+	(*GetPaymentResponse)(nil),        // 25: brew.GetPaymentResponse
+	(*Refund)(nil),                    // 26: brew.Refund
+	(*CancelOrderRequest)(nil),        // 27: brew.CancelOrderRequest
+	(*CancelOrderResponse)(nil),       // 28: brew.CancelOrderResponse
+	(*RefundOrderRequest)(nil),        // 29: brew.RefundOrderRequest
+	(*RefundOrderResponse)(nil),       // 30: brew.RefundOrderResponse
+	(*GetReceiptRequest)(nil),         // 31: brew.GetReceiptRequest
+	(*GetReceiptResponse)(nil),        // 32: brew.GetReceiptResponse
+	(*VerifyPickupRequest)(nil),       // 33: brew.VerifyPickupRequest
+	(*VerifyPickupResponse)(nil),      // 34: brew.VerifyPickupResponse
+	(*ExportOrdersRequest)(nil),       // 35: brew.ExportOrdersRequest
+	(*ExportOrdersResponse)(nil),      // 36: brew.ExportOrdersResponse
+	(*ListMyOrdersRequest)(nil),       // 37: brew.ListMyOrdersRequest
+	(*ListMyOrdersResponse)(nil),      // 38: brew.ListMyOrdersResponse
+	(*ReorderRequest)(nil),            // 39: brew.ReorderRequest
+	nil,                               // 40: brew.TicketStep.ParametersEntry
+	(*timestamppb.Timestamp)(nil),     // 41: google.protobuf.Timestamp
 }
 var file_brew_brew_proto_depIdxs = []int32{
-	4,  // 0: brew.ListOrdersResponse.orders:type_name -> brew.Order
-	4,  // 1: brew.GetOrderResponse.order:type_name -> brew.Order
-	0,  // 2: brew.UpdateOrderStatusRequest.status:type_name -> brew.DrinkStatus
-	4,  // 3: brew.UpdateOrderStatusResponse.order:type_name -> brew.Order
-	1,  // 4: brew.BrewService.OrderDrink:input_type -> brew.OrderRequest
-	3,  // 5: brew.BrewService.ListOrders:input_type -> brew.ListOrdersRequest
-	6,  // 6: brew.BrewService.GetOrder:input_type -> brew.GetOrderRequest
-	8,  // 7: brew.BrewService.UpdateOrderStatus:input_type -> brew.UpdateOrderStatusRequest
-	10, // 8: brew.BrewService.DeleteOrder:input_type -> brew.DeleteOrderRequest
-	2,  // 9: brew.BrewService.OrderDrink:output_type -> brew.OrderResponse
-	5,  // 10: brew.BrewService.ListOrders:output_type -> brew.ListOrdersResponse
-	7,  // 11: brew.BrewService.GetOrder:output_type -> brew.GetOrderResponse
-	9,  // 12: brew.BrewService.UpdateOrderStatus:output_type -> brew.UpdateOrderStatusResponse
-	11, // 13: brew.BrewService.DeleteOrder:output_type -> brew.DeleteOrderResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	5,  // 0: brew.OrderResponse.price:type_name -> brew.PriceBreakdown
+	4,  // 1: brew.PriceBreakdown.lines:type_name -> brew.PriceLine
+	6,  // 2: brew.PriceBreakdown.discounts:type_name -> brew.DiscountLine
+	5,  // 3: brew.Order.price:type_name -> brew.PriceBreakdown
+	26, // 4: brew.Order.refunds:type_name -> brew.Refund
+	41, // 5: brew.Order.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 6: brew.ListOrdersResponse.orders:type_name -> brew.Order
+	8,  // 7: brew.GetOrderResponse.order:type_name -> brew.Order
+	0,  // 8: brew.UpdateOrderStatusRequest.status:type_name -> brew.DrinkStatus
+	8,  // 9: brew.UpdateOrderStatusResponse.order:type_name -> brew.Order
+	0,  // 10: brew.BaristaTicket.next_status:type_name -> brew.DrinkStatus
+	18, // 11: brew.BaristaTicket.steps:type_name -> brew.TicketStep
+	0,  // 12: brew.TicketStep.status:type_name -> brew.DrinkStatus
+	40, // 13: brew.TicketStep.parameters:type_name -> brew.TicketStep.ParametersEntry
+	17, // 14: brew.GetBaristaTicketResponse.ticket:type_name -> brew.BaristaTicket
+	21, // 15: brew.PayOrderRequest.cash:type_name -> brew.CashTender
+	22, // 16: brew.PayOrderResponse.payment:type_name -> brew.Payment
+	8,  // 17: brew.PayOrderResponse.order:type_name -> brew.Order
+	22, // 18: brew.PayOrderResponse.payments:type_name -> brew.Payment
+	22, // 19: brew.GetPaymentResponse.payment:type_name -> brew.Payment
+	8,  // 20: brew.CancelOrderResponse.order:type_name -> brew.Order
+	26, // 21: brew.CancelOrderResponse.refunds:type_name -> brew.Refund
+	26, // 22: brew.RefundOrderResponse.refunds:type_name -> brew.Refund
+	8,  // 23: brew.RefundOrderResponse.order:type_name -> brew.Order
+	8,  // 24: brew.VerifyPickupResponse.order:type_name -> brew.Order
+	41, // 25: brew.ExportOrdersRequest.start:type_name -> google.protobuf.Timestamp
+	41, // 26: brew.ExportOrdersRequest.end:type_name -> google.protobuf.Timestamp
+	1,  // 27: brew.ExportOrdersRequest.format:type_name -> brew.ExportFormat
+	8,  // 28: brew.ListMyOrdersResponse.orders:type_name -> brew.Order
+	2,  // 29: brew.BrewService.OrderDrink:input_type -> brew.OrderRequest
+	7,  // 30: brew.BrewService.ListOrders:input_type -> brew.ListOrdersRequest
+	10, // 31: brew.BrewService.GetOrder:input_type -> brew.GetOrderRequest
+	12, // 32: brew.BrewService.UpdateOrderStatus:input_type -> brew.UpdateOrderStatusRequest
+	14, // 33: brew.BrewService.DeleteOrder:input_type -> brew.DeleteOrderRequest
+	16, // 34: brew.BrewService.GetBaristaTicket:input_type -> brew.GetBaristaTicketRequest
+	20, // 35: brew.BrewService.PayOrder:input_type -> brew.PayOrderRequest
+	24, // 36: brew.BrewService.GetPayment:input_type -> brew.GetPaymentRequest
+	27, // 37: brew.BrewService.CancelOrder:input_type -> brew.CancelOrderRequest
+	29, // 38: brew.BrewService.RefundOrder:input_type -> brew.RefundOrderRequest
+	31, // 39: brew.BrewService.GetReceipt:input_type -> brew.GetReceiptRequest
+	33, // 40: brew.BrewService.VerifyPickup:input_type -> brew.VerifyPickupRequest
+	35, // 41: brew.BrewService.ExportOrders:input_type -> brew.ExportOrdersRequest
+	37, // 42: brew.BrewService.ListMyOrders:input_type -> brew.ListMyOrdersRequest
+	39, // 43: brew.BrewService.Reorder:input_type -> brew.ReorderRequest
+	3,  // 44: brew.BrewService.OrderDrink:output_type -> brew.OrderResponse
+	9,  // 45: brew.BrewService.ListOrders:output_type -> brew.ListOrdersResponse
+	11, // 46: brew.BrewService.GetOrder:output_type -> brew.GetOrderResponse
+	13, // 47: brew.BrewService.UpdateOrderStatus:output_type -> brew.UpdateOrderStatusResponse
+	15, // 48: brew.BrewService.DeleteOrder:output_type -> brew.DeleteOrderResponse
+	19, // 49: brew.BrewService.GetBaristaTicket:output_type -> brew.GetBaristaTicketResponse
+	23, // 50: brew.BrewService.PayOrder:output_type -> brew.PayOrderResponse
+	25, // 51: brew.BrewService.GetPayment:output_type -> brew.GetPaymentResponse
+	28, // 52: brew.BrewService.CancelOrder:output_type -> brew.CancelOrderResponse
+	30, // 53: brew.BrewService.RefundOrder:output_type -> brew.RefundOrderResponse
+	32, // 54: brew.BrewService.GetReceipt:output_type -> brew.GetReceiptResponse
+	34, // 55: brew.BrewService.VerifyPickup:output_type -> brew.VerifyPickupResponse
+	36, // 56: brew.BrewService.ExportOrders:output_type -> brew.ExportOrdersResponse
+	38, // 57: brew.BrewService.ListMyOrders:output_type -> brew.ListMyOrdersResponse
+	3,  // 58: brew.BrewService.Reorder:output_type -> brew.OrderResponse
+	44, // [44:59] is the sub-list for method output_type
+	29, // [29:44] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_brew_brew_proto_init() }
@@ -687,8 +2750,8 @@ func file_brew_brew_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_brew_brew_proto_rawDesc), len(file_brew_brew_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,16 @@ const (
 	BrewService_GetOrder_FullMethodName          = "/brew.BrewService/GetOrder"
 	BrewService_UpdateOrderStatus_FullMethodName = "/brew.BrewService/UpdateOrderStatus"
 	BrewService_DeleteOrder_FullMethodName       = "/brew.BrewService/DeleteOrder"
+	BrewService_GetBaristaTicket_FullMethodName  = "/brew.BrewService/GetBaristaTicket"
+	BrewService_PayOrder_FullMethodName          = "/brew.BrewService/PayOrder"
+	BrewService_GetPayment_FullMethodName        = "/brew.BrewService/GetPayment"
+	BrewService_CancelOrder_FullMethodName       = "/brew.BrewService/CancelOrder"
+	BrewService_RefundOrder_FullMethodName       = "/brew.BrewService/RefundOrder"
+	BrewService_GetReceipt_FullMethodName        = "/brew.BrewService/GetReceipt"
+	BrewService_VerifyPickup_FullMethodName      = "/brew.BrewService/VerifyPickup"
+	BrewService_ExportOrders_FullMethodName      = "/brew.BrewService/ExportOrders"
+	BrewService_ListMyOrders_FullMethodName      = "/brew.BrewService/ListMyOrders"
+	BrewService_Reorder_FullMethodName           = "/brew.BrewService/Reorder"
 )
 
 // BrewServiceClient is the client API for BrewService service.
@@ -35,6 +45,29 @@ type BrewServiceClient interface {
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
+	GetBaristaTicket(ctx context.Context, in *GetBaristaTicketRequest, opts ...grpc.CallOption) (*GetBaristaTicketResponse, error)
+	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	// Cancels an order that is not READY yet, refunding it in full if paid.
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	// Refunds all or part of a paid order, e.g. a drink that had to be remade.
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+	// The receipt of an order as plain text and HTML.
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+	// Hands a READY order over to the customer after checking the pickup
+	// code, typically scanned from the QR code on their receipt.
+	VerifyPickup(ctx context.Context, in *VerifyPickupRequest, opts ...grpc.CallOption) (*VerifyPickupResponse, error)
+	// Streams the orders placed in [start, end), with their modifiers and
+	// status history, as a CSV or NDJSON file split into chunks. Also served
+	// as a download from GET /orders/export.
+	ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersResponse], error)
+	// The caller's own orders, newest first: those placed with their token
+	// or for their customer ID.
+	ListMyOrders(ctx context.Context, in *ListMyOrdersRequest, opts ...grpc.CallOption) (*ListMyOrdersResponse, error)
+	// Places a new order for the same drink, modifiers and quantity as an
+	// earlier one, priced with today's menu. Promo codes and loyalty
+	// rewards are not carried over.
+	Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
 }
 
 type brewServiceClient struct {
@@ -95,6 +128,115 @@ func (c *brewServiceClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequ
 	return out, nil
 }
 
+func (c *brewServiceClient) GetBaristaTicket(ctx context.Context, in *GetBaristaTicketRequest, opts ...grpc.CallOption) (*GetBaristaTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBaristaTicketResponse)
+	err := c.cc.Invoke(ctx, BrewService_GetBaristaTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayOrderResponse)
+	err := c.cc.Invoke(ctx, BrewService_PayOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, BrewService_GetPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, BrewService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, BrewService_RefundOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, BrewService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) VerifyPickup(ctx context.Context, in *VerifyPickupRequest, opts ...grpc.CallOption) (*VerifyPickupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyPickupResponse)
+	err := c.cc.Invoke(ctx, BrewService_VerifyPickup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) ExportOrders(ctx context.Context, in *ExportOrdersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportOrdersResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BrewService_ServiceDesc.Streams[0], BrewService_ExportOrders_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportOrdersRequest, ExportOrdersResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrewService_ExportOrdersClient = grpc.ServerStreamingClient[ExportOrdersResponse]
+
+func (c *brewServiceClient) ListMyOrders(ctx context.Context, in *ListMyOrdersRequest, opts ...grpc.CallOption) (*ListMyOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyOrdersResponse)
+	err := c.cc.Invoke(ctx, BrewService_ListMyOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *brewServiceClient) Reorder(ctx context.Context, in *ReorderRequest, opts ...grpc.CallOption) (*OrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderResponse)
+	err := c.cc.Invoke(ctx, BrewService_Reorder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BrewServiceServer is the server API for BrewService service.
 // All implementations must embed UnimplementedBrewServiceServer
 // for forward compatibility.
@@ -104,6 +246,29 @@ type BrewServiceServer interface {
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
+	GetBaristaTicket(context.Context, *GetBaristaTicketRequest) (*GetBaristaTicketResponse, error)
+	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	// Cancels an order that is not READY yet, refunding it in full if paid.
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	// Refunds all or part of a paid order, e.g. a drink that had to be remade.
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	// The receipt of an order as plain text and HTML.
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+	// Hands a READY order over to the customer after checking the pickup
+	// code, typically scanned from the QR code on their receipt.
+	VerifyPickup(context.Context, *VerifyPickupRequest) (*VerifyPickupResponse, error)
+	// Streams the orders placed in [start, end), with their modifiers and
+	// status history, as a CSV or NDJSON file split into chunks. Also served
+	// as a download from GET /orders/export.
+	ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[ExportOrdersResponse]) error
+	// The caller's own orders, newest first: those placed with their token
+	// or for their customer ID.
+	ListMyOrders(context.Context, *ListMyOrdersRequest) (*ListMyOrdersResponse, error)
+	// Places a new order for the same drink, modifiers and quantity as an
+	// earlier one, priced with today's menu. Promo codes and loyalty
+	// rewards are not carried over.
+	Reorder(context.Context, *ReorderRequest) (*OrderResponse, error)
 	mustEmbedUnimplementedBrewServiceServer()
 }
 
@@ -129,6 +294,36 @@ func (UnimplementedBrewServiceServer) UpdateOrderStatus(context.Context, *Update
 func (UnimplementedBrewServiceServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteOrder not implemented")
 }
+func (UnimplementedBrewServiceServer) GetBaristaTicket(context.Context, *GetBaristaTicketRequest) (*GetBaristaTicketResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBaristaTicket not implemented")
+}
+func (UnimplementedBrewServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PayOrder not implemented")
+}
+func (UnimplementedBrewServiceServer) GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (UnimplementedBrewServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedBrewServiceServer) RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RefundOrder not implemented")
+}
+func (UnimplementedBrewServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedBrewServiceServer) VerifyPickup(context.Context, *VerifyPickupRequest) (*VerifyPickupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyPickup not implemented")
+}
+func (UnimplementedBrewServiceServer) ExportOrders(*ExportOrdersRequest, grpc.ServerStreamingServer[ExportOrdersResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExportOrders not implemented")
+}
+func (UnimplementedBrewServiceServer) ListMyOrders(context.Context, *ListMyOrdersRequest) (*ListMyOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMyOrders not implemented")
+}
+func (UnimplementedBrewServiceServer) Reorder(context.Context, *ReorderRequest) (*OrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Reorder not implemented")
+}
 func (UnimplementedBrewServiceServer) mustEmbedUnimplementedBrewServiceServer() {}
 func (UnimplementedBrewServiceServer) testEmbeddedByValue()                     {}
 
@@ -240,6 +435,179 @@ func _BrewService_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BrewService_GetBaristaTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBaristaTicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).GetBaristaTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_GetBaristaTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).GetBaristaTicket(ctx, req.(*GetBaristaTicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).PayOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_PayOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).PayOrder(ctx, req.(*PayOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_GetPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_RefundOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_VerifyPickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPickupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).VerifyPickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_VerifyPickup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).VerifyPickup(ctx, req.(*VerifyPickupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_ExportOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrdersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BrewServiceServer).ExportOrders(m, &grpc.GenericServerStream[ExportOrdersRequest, ExportOrdersResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BrewService_ExportOrdersServer = grpc.ServerStreamingServer[ExportOrdersResponse]
+
+func _BrewService_ListMyOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).ListMyOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_ListMyOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).ListMyOrders(ctx, req.(*ListMyOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BrewService_Reorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BrewServiceServer).Reorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BrewService_Reorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BrewServiceServer).Reorder(ctx, req.(*ReorderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BrewService_ServiceDesc is the grpc.ServiceDesc for BrewService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,7 +635,49 @@ var BrewService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOrder",
 			Handler:    _BrewService_DeleteOrder_Handler,
 		},
+		{
+			MethodName: "GetBaristaTicket",
+			Handler:    _BrewService_GetBaristaTicket_Handler,
+		},
+		{
+			MethodName: "PayOrder",
+			Handler:    _BrewService_PayOrder_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _BrewService_GetPayment_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _BrewService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _BrewService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _BrewService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "VerifyPickup",
+			Handler:    _BrewService_VerifyPickup_Handler,
+		},
+		{
+			MethodName: "ListMyOrders",
+			Handler:    _BrewService_ListMyOrders_Handler,
+		},
+		{
+			MethodName: "Reorder",
+			Handler:    _BrewService_Reorder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOrders",
+			Handler:       _BrewService_ExportOrders_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "brew/brew.proto",
 }
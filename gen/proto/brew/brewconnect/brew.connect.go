@@ -44,6 +44,30 @@ const (
 	BrewServiceUpdateOrderStatusProcedure = "/brew.BrewService/UpdateOrderStatus"
 	// BrewServiceDeleteOrderProcedure is the fully-qualified name of the BrewService's DeleteOrder RPC.
 	BrewServiceDeleteOrderProcedure = "/brew.BrewService/DeleteOrder"
+	// BrewServiceGetBaristaTicketProcedure is the fully-qualified name of the BrewService's
+	// GetBaristaTicket RPC.
+	BrewServiceGetBaristaTicketProcedure = "/brew.BrewService/GetBaristaTicket"
+	// BrewServicePayOrderProcedure is the fully-qualified name of the BrewService's PayOrder RPC.
+	BrewServicePayOrderProcedure = "/brew.BrewService/PayOrder"
+	// BrewServiceGetPaymentProcedure is the fully-qualified name of the BrewService's GetPayment RPC.
+	BrewServiceGetPaymentProcedure = "/brew.BrewService/GetPayment"
+	// BrewServiceCancelOrderProcedure is the fully-qualified name of the BrewService's CancelOrder RPC.
+	BrewServiceCancelOrderProcedure = "/brew.BrewService/CancelOrder"
+	// BrewServiceRefundOrderProcedure is the fully-qualified name of the BrewService's RefundOrder RPC.
+	BrewServiceRefundOrderProcedure = "/brew.BrewService/RefundOrder"
+	// BrewServiceGetReceiptProcedure is the fully-qualified name of the BrewService's GetReceipt RPC.
+	BrewServiceGetReceiptProcedure = "/brew.BrewService/GetReceipt"
+	// BrewServiceVerifyPickupProcedure is the fully-qualified name of the BrewService's VerifyPickup
+	// RPC.
+	BrewServiceVerifyPickupProcedure = "/brew.BrewService/VerifyPickup"
+	// BrewServiceExportOrdersProcedure is the fully-qualified name of the BrewService's ExportOrders
+	// RPC.
+	BrewServiceExportOrdersProcedure = "/brew.BrewService/ExportOrders"
+	// BrewServiceListMyOrdersProcedure is the fully-qualified name of the BrewService's ListMyOrders
+	// RPC.
+	BrewServiceListMyOrdersProcedure = "/brew.BrewService/ListMyOrders"
+	// BrewServiceReorderProcedure is the fully-qualified name of the BrewService's Reorder RPC.
+	BrewServiceReorderProcedure = "/brew.BrewService/Reorder"
 )
 
 // BrewServiceClient is a client for the brew.BrewService service.
@@ -53,6 +77,29 @@ type BrewServiceClient interface {
 	GetOrder(context.Context, *connect.Request[brew.GetOrderRequest]) (*connect.Response[brew.GetOrderResponse], error)
 	UpdateOrderStatus(context.Context, *connect.Request[brew.UpdateOrderStatusRequest]) (*connect.Response[brew.UpdateOrderStatusResponse], error)
 	DeleteOrder(context.Context, *connect.Request[brew.DeleteOrderRequest]) (*connect.Response[brew.DeleteOrderResponse], error)
+	GetBaristaTicket(context.Context, *connect.Request[brew.GetBaristaTicketRequest]) (*connect.Response[brew.GetBaristaTicketResponse], error)
+	PayOrder(context.Context, *connect.Request[brew.PayOrderRequest]) (*connect.Response[brew.PayOrderResponse], error)
+	GetPayment(context.Context, *connect.Request[brew.GetPaymentRequest]) (*connect.Response[brew.GetPaymentResponse], error)
+	// Cancels an order that is not READY yet, refunding it in full if paid.
+	CancelOrder(context.Context, *connect.Request[brew.CancelOrderRequest]) (*connect.Response[brew.CancelOrderResponse], error)
+	// Refunds all or part of a paid order, e.g. a drink that had to be remade.
+	RefundOrder(context.Context, *connect.Request[brew.RefundOrderRequest]) (*connect.Response[brew.RefundOrderResponse], error)
+	// The receipt of an order as plain text and HTML.
+	GetReceipt(context.Context, *connect.Request[brew.GetReceiptRequest]) (*connect.Response[brew.GetReceiptResponse], error)
+	// Hands a READY order over to the customer after checking the pickup
+	// code, typically scanned from the QR code on their receipt.
+	VerifyPickup(context.Context, *connect.Request[brew.VerifyPickupRequest]) (*connect.Response[brew.VerifyPickupResponse], error)
+	// Streams the orders placed in [start, end), with their modifiers and
+	// status history, as a CSV or NDJSON file split into chunks. Also served
+	// as a download from GET /orders/export.
+	ExportOrders(context.Context, *connect.Request[brew.ExportOrdersRequest]) (*connect.ServerStreamForClient[brew.ExportOrdersResponse], error)
+	// The caller's own orders, newest first: those placed with their token
+	// or for their customer ID.
+	ListMyOrders(context.Context, *connect.Request[brew.ListMyOrdersRequest]) (*connect.Response[brew.ListMyOrdersResponse], error)
+	// Places a new order for the same drink, modifiers and quantity as an
+	// earlier one, priced with today's menu. Promo codes and loyalty
+	// rewards are not carried over.
+	Reorder(context.Context, *connect.Request[brew.ReorderRequest]) (*connect.Response[brew.OrderResponse], error)
 }
 
 // NewBrewServiceClient constructs a client for the brew.BrewService service. By default, it uses
@@ -96,6 +143,69 @@ func NewBrewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(brewServiceMethods.ByName("DeleteOrder")),
 			connect.WithClientOptions(opts...),
 		),
+		getBaristaTicket: connect.NewClient[brew.GetBaristaTicketRequest, brew.GetBaristaTicketResponse](
+			httpClient,
+			baseURL+BrewServiceGetBaristaTicketProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("GetBaristaTicket")),
+			connect.WithClientOptions(opts...),
+		),
+		payOrder: connect.NewClient[brew.PayOrderRequest, brew.PayOrderResponse](
+			httpClient,
+			baseURL+BrewServicePayOrderProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("PayOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		getPayment: connect.NewClient[brew.GetPaymentRequest, brew.GetPaymentResponse](
+			httpClient,
+			baseURL+BrewServiceGetPaymentProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("GetPayment")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelOrder: connect.NewClient[brew.CancelOrderRequest, brew.CancelOrderResponse](
+			httpClient,
+			baseURL+BrewServiceCancelOrderProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("CancelOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		refundOrder: connect.NewClient[brew.RefundOrderRequest, brew.RefundOrderResponse](
+			httpClient,
+			baseURL+BrewServiceRefundOrderProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("RefundOrder")),
+			connect.WithClientOptions(opts...),
+		),
+		getReceipt: connect.NewClient[brew.GetReceiptRequest, brew.GetReceiptResponse](
+			httpClient,
+			baseURL+BrewServiceGetReceiptProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("GetReceipt")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		verifyPickup: connect.NewClient[brew.VerifyPickupRequest, brew.VerifyPickupResponse](
+			httpClient,
+			baseURL+BrewServiceVerifyPickupProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("VerifyPickup")),
+			connect.WithClientOptions(opts...),
+		),
+		exportOrders: connect.NewClient[brew.ExportOrdersRequest, brew.ExportOrdersResponse](
+			httpClient,
+			baseURL+BrewServiceExportOrdersProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("ExportOrders")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listMyOrders: connect.NewClient[brew.ListMyOrdersRequest, brew.ListMyOrdersResponse](
+			httpClient,
+			baseURL+BrewServiceListMyOrdersProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("ListMyOrders")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		reorder: connect.NewClient[brew.ReorderRequest, brew.OrderResponse](
+			httpClient,
+			baseURL+BrewServiceReorderProcedure,
+			connect.WithSchema(brewServiceMethods.ByName("Reorder")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -106,6 +216,16 @@ type brewServiceClient struct {
 	getOrder          *connect.Client[brew.GetOrderRequest, brew.GetOrderResponse]
 	updateOrderStatus *connect.Client[brew.UpdateOrderStatusRequest, brew.UpdateOrderStatusResponse]
 	deleteOrder       *connect.Client[brew.DeleteOrderRequest, brew.DeleteOrderResponse]
+	getBaristaTicket  *connect.Client[brew.GetBaristaTicketRequest, brew.GetBaristaTicketResponse]
+	payOrder          *connect.Client[brew.PayOrderRequest, brew.PayOrderResponse]
+	getPayment        *connect.Client[brew.GetPaymentRequest, brew.GetPaymentResponse]
+	cancelOrder       *connect.Client[brew.CancelOrderRequest, brew.CancelOrderResponse]
+	refundOrder       *connect.Client[brew.RefundOrderRequest, brew.RefundOrderResponse]
+	getReceipt        *connect.Client[brew.GetReceiptRequest, brew.GetReceiptResponse]
+	verifyPickup      *connect.Client[brew.VerifyPickupRequest, brew.VerifyPickupResponse]
+	exportOrders      *connect.Client[brew.ExportOrdersRequest, brew.ExportOrdersResponse]
+	listMyOrders      *connect.Client[brew.ListMyOrdersRequest, brew.ListMyOrdersResponse]
+	reorder           *connect.Client[brew.ReorderRequest, brew.OrderResponse]
 }
 
 // OrderDrink calls brew.BrewService.OrderDrink.
@@ -133,6 +253,56 @@ func (c *brewServiceClient) DeleteOrder(ctx context.Context, req *connect.Reques
 	return c.deleteOrder.CallUnary(ctx, req)
 }
 
+// GetBaristaTicket calls brew.BrewService.GetBaristaTicket.
+func (c *brewServiceClient) GetBaristaTicket(ctx context.Context, req *connect.Request[brew.GetBaristaTicketRequest]) (*connect.Response[brew.GetBaristaTicketResponse], error) {
+	return c.getBaristaTicket.CallUnary(ctx, req)
+}
+
+// PayOrder calls brew.BrewService.PayOrder.
+func (c *brewServiceClient) PayOrder(ctx context.Context, req *connect.Request[brew.PayOrderRequest]) (*connect.Response[brew.PayOrderResponse], error) {
+	return c.payOrder.CallUnary(ctx, req)
+}
+
+// GetPayment calls brew.BrewService.GetPayment.
+func (c *brewServiceClient) GetPayment(ctx context.Context, req *connect.Request[brew.GetPaymentRequest]) (*connect.Response[brew.GetPaymentResponse], error) {
+	return c.getPayment.CallUnary(ctx, req)
+}
+
+// CancelOrder calls brew.BrewService.CancelOrder.
+func (c *brewServiceClient) CancelOrder(ctx context.Context, req *connect.Request[brew.CancelOrderRequest]) (*connect.Response[brew.CancelOrderResponse], error) {
+	return c.cancelOrder.CallUnary(ctx, req)
+}
+
+// RefundOrder calls brew.BrewService.RefundOrder.
+func (c *brewServiceClient) RefundOrder(ctx context.Context, req *connect.Request[brew.RefundOrderRequest]) (*connect.Response[brew.RefundOrderResponse], error) {
+	return c.refundOrder.CallUnary(ctx, req)
+}
+
+// GetReceipt calls brew.BrewService.GetReceipt.
+func (c *brewServiceClient) GetReceipt(ctx context.Context, req *connect.Request[brew.GetReceiptRequest]) (*connect.Response[brew.GetReceiptResponse], error) {
+	return c.getReceipt.CallUnary(ctx, req)
+}
+
+// VerifyPickup calls brew.BrewService.VerifyPickup.
+func (c *brewServiceClient) VerifyPickup(ctx context.Context, req *connect.Request[brew.VerifyPickupRequest]) (*connect.Response[brew.VerifyPickupResponse], error) {
+	return c.verifyPickup.CallUnary(ctx, req)
+}
+
+// ExportOrders calls brew.BrewService.ExportOrders.
+func (c *brewServiceClient) ExportOrders(ctx context.Context, req *connect.Request[brew.ExportOrdersRequest]) (*connect.ServerStreamForClient[brew.ExportOrdersResponse], error) {
+	return c.exportOrders.CallServerStream(ctx, req)
+}
+
+// ListMyOrders calls brew.BrewService.ListMyOrders.
+func (c *brewServiceClient) ListMyOrders(ctx context.Context, req *connect.Request[brew.ListMyOrdersRequest]) (*connect.Response[brew.ListMyOrdersResponse], error) {
+	return c.listMyOrders.CallUnary(ctx, req)
+}
+
+// Reorder calls brew.BrewService.Reorder.
+func (c *brewServiceClient) Reorder(ctx context.Context, req *connect.Request[brew.ReorderRequest]) (*connect.Response[brew.OrderResponse], error) {
+	return c.reorder.CallUnary(ctx, req)
+}
+
 // BrewServiceHandler is an implementation of the brew.BrewService service.
 type BrewServiceHandler interface {
 	OrderDrink(context.Context, *connect.Request[brew.OrderRequest]) (*connect.Response[brew.OrderResponse], error)
@@ -140,6 +310,29 @@ type BrewServiceHandler interface {
 	GetOrder(context.Context, *connect.Request[brew.GetOrderRequest]) (*connect.Response[brew.GetOrderResponse], error)
 	UpdateOrderStatus(context.Context, *connect.Request[brew.UpdateOrderStatusRequest]) (*connect.Response[brew.UpdateOrderStatusResponse], error)
 	DeleteOrder(context.Context, *connect.Request[brew.DeleteOrderRequest]) (*connect.Response[brew.DeleteOrderResponse], error)
+	GetBaristaTicket(context.Context, *connect.Request[brew.GetBaristaTicketRequest]) (*connect.Response[brew.GetBaristaTicketResponse], error)
+	PayOrder(context.Context, *connect.Request[brew.PayOrderRequest]) (*connect.Response[brew.PayOrderResponse], error)
+	GetPayment(context.Context, *connect.Request[brew.GetPaymentRequest]) (*connect.Response[brew.GetPaymentResponse], error)
+	// Cancels an order that is not READY yet, refunding it in full if paid.
+	CancelOrder(context.Context, *connect.Request[brew.CancelOrderRequest]) (*connect.Response[brew.CancelOrderResponse], error)
+	// Refunds all or part of a paid order, e.g. a drink that had to be remade.
+	RefundOrder(context.Context, *connect.Request[brew.RefundOrderRequest]) (*connect.Response[brew.RefundOrderResponse], error)
+	// The receipt of an order as plain text and HTML.
+	GetReceipt(context.Context, *connect.Request[brew.GetReceiptRequest]) (*connect.Response[brew.GetReceiptResponse], error)
+	// Hands a READY order over to the customer after checking the pickup
+	// code, typically scanned from the QR code on their receipt.
+	VerifyPickup(context.Context, *connect.Request[brew.VerifyPickupRequest]) (*connect.Response[brew.VerifyPickupResponse], error)
+	// Streams the orders placed in [start, end), with their modifiers and
+	// status history, as a CSV or NDJSON file split into chunks. Also served
+	// as a download from GET /orders/export.
+	ExportOrders(context.Context, *connect.Request[brew.ExportOrdersRequest], *connect.ServerStream[brew.ExportOrdersResponse]) error
+	// The caller's own orders, newest first: those placed with their token
+	// or for their customer ID.
+	ListMyOrders(context.Context, *connect.Request[brew.ListMyOrdersRequest]) (*connect.Response[brew.ListMyOrdersResponse], error)
+	// Places a new order for the same drink, modifiers and quantity as an
+	// earlier one, priced with today's menu. Promo codes and loyalty
+	// rewards are not carried over.
+	Reorder(context.Context, *connect.Request[brew.ReorderRequest]) (*connect.Response[brew.OrderResponse], error)
 }
 
 // NewBrewServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -179,6 +372,69 @@ func NewBrewServiceHandler(svc BrewServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(brewServiceMethods.ByName("DeleteOrder")),
 		connect.WithHandlerOptions(opts...),
 	)
+	brewServiceGetBaristaTicketHandler := connect.NewUnaryHandler(
+		BrewServiceGetBaristaTicketProcedure,
+		svc.GetBaristaTicket,
+		connect.WithSchema(brewServiceMethods.ByName("GetBaristaTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServicePayOrderHandler := connect.NewUnaryHandler(
+		BrewServicePayOrderProcedure,
+		svc.PayOrder,
+		connect.WithSchema(brewServiceMethods.ByName("PayOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceGetPaymentHandler := connect.NewUnaryHandler(
+		BrewServiceGetPaymentProcedure,
+		svc.GetPayment,
+		connect.WithSchema(brewServiceMethods.ByName("GetPayment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceCancelOrderHandler := connect.NewUnaryHandler(
+		BrewServiceCancelOrderProcedure,
+		svc.CancelOrder,
+		connect.WithSchema(brewServiceMethods.ByName("CancelOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceRefundOrderHandler := connect.NewUnaryHandler(
+		BrewServiceRefundOrderProcedure,
+		svc.RefundOrder,
+		connect.WithSchema(brewServiceMethods.ByName("RefundOrder")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceGetReceiptHandler := connect.NewUnaryHandler(
+		BrewServiceGetReceiptProcedure,
+		svc.GetReceipt,
+		connect.WithSchema(brewServiceMethods.ByName("GetReceipt")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceVerifyPickupHandler := connect.NewUnaryHandler(
+		BrewServiceVerifyPickupProcedure,
+		svc.VerifyPickup,
+		connect.WithSchema(brewServiceMethods.ByName("VerifyPickup")),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceExportOrdersHandler := connect.NewServerStreamHandler(
+		BrewServiceExportOrdersProcedure,
+		svc.ExportOrders,
+		connect.WithSchema(brewServiceMethods.ByName("ExportOrders")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceListMyOrdersHandler := connect.NewUnaryHandler(
+		BrewServiceListMyOrdersProcedure,
+		svc.ListMyOrders,
+		connect.WithSchema(brewServiceMethods.ByName("ListMyOrders")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	brewServiceReorderHandler := connect.NewUnaryHandler(
+		BrewServiceReorderProcedure,
+		svc.Reorder,
+		connect.WithSchema(brewServiceMethods.ByName("Reorder")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/brew.BrewService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BrewServiceOrderDrinkProcedure:
@@ -191,6 +447,26 @@ func NewBrewServiceHandler(svc BrewServiceHandler, opts ...connect.HandlerOption
 			brewServiceUpdateOrderStatusHandler.ServeHTTP(w, r)
 		case BrewServiceDeleteOrderProcedure:
 			brewServiceDeleteOrderHandler.ServeHTTP(w, r)
+		case BrewServiceGetBaristaTicketProcedure:
+			brewServiceGetBaristaTicketHandler.ServeHTTP(w, r)
+		case BrewServicePayOrderProcedure:
+			brewServicePayOrderHandler.ServeHTTP(w, r)
+		case BrewServiceGetPaymentProcedure:
+			brewServiceGetPaymentHandler.ServeHTTP(w, r)
+		case BrewServiceCancelOrderProcedure:
+			brewServiceCancelOrderHandler.ServeHTTP(w, r)
+		case BrewServiceRefundOrderProcedure:
+			brewServiceRefundOrderHandler.ServeHTTP(w, r)
+		case BrewServiceGetReceiptProcedure:
+			brewServiceGetReceiptHandler.ServeHTTP(w, r)
+		case BrewServiceVerifyPickupProcedure:
+			brewServiceVerifyPickupHandler.ServeHTTP(w, r)
+		case BrewServiceExportOrdersProcedure:
+			brewServiceExportOrdersHandler.ServeHTTP(w, r)
+		case BrewServiceListMyOrdersProcedure:
+			brewServiceListMyOrdersHandler.ServeHTTP(w, r)
+		case BrewServiceReorderProcedure:
+			brewServiceReorderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -219,3 +495,43 @@ func (UnimplementedBrewServiceHandler) UpdateOrderStatus(context.Context, *conne
 func (UnimplementedBrewServiceHandler) DeleteOrder(context.Context, *connect.Request[brew.DeleteOrderRequest]) (*connect.Response[brew.DeleteOrderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.DeleteOrder is not implemented"))
 }
+
+func (UnimplementedBrewServiceHandler) GetBaristaTicket(context.Context, *connect.Request[brew.GetBaristaTicketRequest]) (*connect.Response[brew.GetBaristaTicketResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.GetBaristaTicket is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) PayOrder(context.Context, *connect.Request[brew.PayOrderRequest]) (*connect.Response[brew.PayOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.PayOrder is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) GetPayment(context.Context, *connect.Request[brew.GetPaymentRequest]) (*connect.Response[brew.GetPaymentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.GetPayment is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) CancelOrder(context.Context, *connect.Request[brew.CancelOrderRequest]) (*connect.Response[brew.CancelOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.CancelOrder is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) RefundOrder(context.Context, *connect.Request[brew.RefundOrderRequest]) (*connect.Response[brew.RefundOrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.RefundOrder is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) GetReceipt(context.Context, *connect.Request[brew.GetReceiptRequest]) (*connect.Response[brew.GetReceiptResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.GetReceipt is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) VerifyPickup(context.Context, *connect.Request[brew.VerifyPickupRequest]) (*connect.Response[brew.VerifyPickupResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.VerifyPickup is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) ExportOrders(context.Context, *connect.Request[brew.ExportOrdersRequest], *connect.ServerStream[brew.ExportOrdersResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.ExportOrders is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) ListMyOrders(context.Context, *connect.Request[brew.ListMyOrdersRequest]) (*connect.Response[brew.ListMyOrdersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.ListMyOrders is not implemented"))
+}
+
+func (UnimplementedBrewServiceHandler) Reorder(context.Context, *connect.Request[brew.ReorderRequest]) (*connect.Response[brew.OrderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("brew.BrewService.Reorder is not implemented"))
+}
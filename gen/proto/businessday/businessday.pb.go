@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: businessday/businessday.proto
+
+package businessday
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Tender struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CARD, GIFT_CARD or CASH
+	Method        string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Payments      int64  `protobuf:"varint,2,opt,name=payments,proto3" json:"payments,omitempty"`
+	AmountCents   int64  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	RefundedCents int64  `protobuf:"varint,4,opt,name=refunded_cents,json=refundedCents,proto3" json:"refunded_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tender) Reset() {
+	*x = Tender{}
+	mi := &file_businessday_businessday_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tender) ProtoMessage() {}
+
+func (x *Tender) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tender.ProtoReflect.Descriptor instead.
+func (*Tender) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Tender) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Tender) GetPayments() int64 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *Tender) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *Tender) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+// ZReport totals the orders placed on a business day, and the payments
+// and refunds made that day.
+type ZReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Z number, counting up over all reports.
+	Number       uint32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	BusinessDate string `protobuf:"bytes,2,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	// Orders that were not cancelled.
+	Orders          int64                  `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+	Drinks          int64                  `protobuf:"varint,4,opt,name=drinks,proto3" json:"drinks,omitempty"`
+	SubtotalCents   int64                  `protobuf:"varint,5,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"`
+	DiscountCents   int64                  `protobuf:"varint,6,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`
+	TaxCents        int64                  `protobuf:"varint,7,opt,name=tax_cents,json=taxCents,proto3" json:"tax_cents,omitempty"`
+	TotalCents      int64                  `protobuf:"varint,8,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	CancelledOrders int64                  `protobuf:"varint,9,opt,name=cancelled_orders,json=cancelledOrders,proto3" json:"cancelled_orders,omitempty"`
+	CancelledCents  int64                  `protobuf:"varint,10,opt,name=cancelled_cents,json=cancelledCents,proto3" json:"cancelled_cents,omitempty"`
+	Refunds         int64                  `protobuf:"varint,11,opt,name=refunds,proto3" json:"refunds,omitempty"`
+	RefundedCents   int64                  `protobuf:"varint,12,opt,name=refunded_cents,json=refundedCents,proto3" json:"refunded_cents,omitempty"`
+	Tenders         []*Tender              `protobuf:"bytes,13,rep,name=tenders,proto3" json:"tenders,omitempty"`
+	Currency        string                 `protobuf:"bytes,14,opt,name=currency,proto3" json:"currency,omitempty"`
+	ClosedBy        string                 `protobuf:"bytes,15,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	ClosedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ZReport) Reset() {
+	*x = ZReport{}
+	mi := &file_businessday_businessday_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZReport) ProtoMessage() {}
+
+func (x *ZReport) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZReport.ProtoReflect.Descriptor instead.
+func (*ZReport) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ZReport) GetNumber() uint32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ZReport) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *ZReport) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *ZReport) GetDrinks() int64 {
+	if x != nil {
+		return x.Drinks
+	}
+	return 0
+}
+
+func (x *ZReport) GetSubtotalCents() int64 {
+	if x != nil {
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetTaxCents() int64 {
+	if x != nil {
+		return x.TaxCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetCancelledOrders() int64 {
+	if x != nil {
+		return x.CancelledOrders
+	}
+	return 0
+}
+
+func (x *ZReport) GetCancelledCents() int64 {
+	if x != nil {
+		return x.CancelledCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetRefunds() int64 {
+	if x != nil {
+		return x.Refunds
+	}
+	return 0
+}
+
+func (x *ZReport) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+func (x *ZReport) GetTenders() []*Tender {
+	if x != nil {
+		return x.Tenders
+	}
+	return nil
+}
+
+func (x *ZReport) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ZReport) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *ZReport) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type BusinessDayEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CLOSE or REOPEN
+	Action        string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ZReportNumber uint32                 `protobuf:"varint,2,opt,name=z_report_number,json=zReportNumber,proto3" json:"z_report_number,omitempty"`
+	Operator      string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BusinessDayEvent) Reset() {
+	*x = BusinessDayEvent{}
+	mi := &file_businessday_businessday_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BusinessDayEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BusinessDayEvent) ProtoMessage() {}
+
+func (x *BusinessDayEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BusinessDayEvent.ProtoReflect.Descriptor instead.
+func (*BusinessDayEvent) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BusinessDayEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *BusinessDayEvent) GetZReportNumber() uint32 {
+	if x != nil {
+		return x.ZReportNumber
+	}
+	return 0
+}
+
+func (x *BusinessDayEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *BusinessDayEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BusinessDayEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type CloseBusinessDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseBusinessDayRequest) Reset() {
+	*x = CloseBusinessDayRequest{}
+	mi := &file_businessday_businessday_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseBusinessDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBusinessDayRequest) ProtoMessage() {}
+
+func (x *CloseBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBusinessDayRequest.ProtoReflect.Descriptor instead.
+func (*CloseBusinessDayRequest) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CloseBusinessDayRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+type CloseBusinessDayResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ZReport               `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseBusinessDayResponse) Reset() {
+	*x = CloseBusinessDayResponse{}
+	mi := &file_businessday_businessday_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseBusinessDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseBusinessDayResponse) ProtoMessage() {}
+
+func (x *CloseBusinessDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseBusinessDayResponse.ProtoReflect.Descriptor instead.
+func (*CloseBusinessDayResponse) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CloseBusinessDayResponse) GetReport() *ZReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ReopenBusinessDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenBusinessDayRequest) Reset() {
+	*x = ReopenBusinessDayRequest{}
+	mi := &file_businessday_businessday_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenBusinessDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenBusinessDayRequest) ProtoMessage() {}
+
+func (x *ReopenBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenBusinessDayRequest.ProtoReflect.Descriptor instead.
+func (*ReopenBusinessDayRequest) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReopenBusinessDayRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *ReopenBusinessDayRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenBusinessDayResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	// OPEN
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenBusinessDayResponse) Reset() {
+	*x = ReopenBusinessDayResponse{}
+	mi := &file_businessday_businessday_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenBusinessDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenBusinessDayResponse) ProtoMessage() {}
+
+func (x *ReopenBusinessDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenBusinessDayResponse.ProtoReflect.Descriptor instead.
+func (*ReopenBusinessDayResponse) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReopenBusinessDayResponse) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *ReopenBusinessDayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetBusinessDayRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate  string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessDayRequest) Reset() {
+	*x = GetBusinessDayRequest{}
+	mi := &file_businessday_businessday_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessDayRequest) ProtoMessage() {}
+
+func (x *GetBusinessDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessDayRequest.ProtoReflect.Descriptor instead.
+func (*GetBusinessDayRequest) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBusinessDayRequest) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+type GetBusinessDayResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	BusinessDate string                 `protobuf:"bytes,1,opt,name=business_date,json=businessDate,proto3" json:"business_date,omitempty"`
+	// OPEN or CLOSED
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Oldest first; the last one is current while the day is closed.
+	Reports       []*ZReport          `protobuf:"bytes,3,rep,name=reports,proto3" json:"reports,omitempty"`
+	Events        []*BusinessDayEvent `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBusinessDayResponse) Reset() {
+	*x = GetBusinessDayResponse{}
+	mi := &file_businessday_businessday_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBusinessDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBusinessDayResponse) ProtoMessage() {}
+
+func (x *GetBusinessDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_businessday_businessday_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBusinessDayResponse.ProtoReflect.Descriptor instead.
+func (*GetBusinessDayResponse) Descriptor() ([]byte, []int) {
+	return file_businessday_businessday_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBusinessDayResponse) GetBusinessDate() string {
+	if x != nil {
+		return x.BusinessDate
+	}
+	return ""
+}
+
+func (x *GetBusinessDayResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetBusinessDayResponse) GetReports() []*ZReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+func (x *GetBusinessDayResponse) GetEvents() []*BusinessDayEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+var File_businessday_businessday_proto protoreflect.FileDescriptor
+
+const file_businessday_businessday_proto_rawDesc = "" +
+	"\n" +
+	"\x1dbusinessday/businessday.proto\x12\vbusinessday\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x86\x01\n" +
+	"\x06Tender\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1a\n" +
+	"\bpayments\x18\x02 \x01(\x03R\bpayments\x12!\n" +
+	"\famount_cents\x18\x03 \x01(\x03R\vamountCents\x12%\n" +
+	"\x0erefunded_cents\x18\x04 \x01(\x03R\rrefundedCents\"\xb8\x04\n" +
+	"\aZReport\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\rR\x06number\x12#\n" +
+	"\rbusiness_date\x18\x02 \x01(\tR\fbusinessDate\x12\x16\n" +
+	"\x06orders\x18\x03 \x01(\x03R\x06orders\x12\x16\n" +
+	"\x06drinks\x18\x04 \x01(\x03R\x06drinks\x12%\n" +
+	"\x0esubtotal_cents\x18\x05 \x01(\x03R\rsubtotalCents\x12%\n" +
+	"\x0ediscount_cents\x18\x06 \x01(\x03R\rdiscountCents\x12\x1b\n" +
+	"\ttax_cents\x18\a \x01(\x03R\btaxCents\x12\x1f\n" +
+	"\vtotal_cents\x18\b \x01(\x03R\n" +
+	"totalCents\x12)\n" +
+	"\x10cancelled_orders\x18\t \x01(\x03R\x0fcancelledOrders\x12'\n" +
+	"\x0fcancelled_cents\x18\n" +
+	" \x01(\x03R\x0ecancelledCents\x12\x18\n" +
+	"\arefunds\x18\v \x01(\x03R\arefunds\x12%\n" +
+	"\x0erefunded_cents\x18\f \x01(\x03R\rrefundedCents\x12-\n" +
+	"\atenders\x18\r \x03(\v2\x13.businessday.TenderR\atenders\x12\x1a\n" +
+	"\bcurrency\x18\x0e \x01(\tR\bcurrency\x12\x1b\n" +
+	"\tclosed_by\x18\x0f \x01(\tR\bclosedBy\x127\n" +
+	"\tclosed_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\xb2\x01\n" +
+	"\x10BusinessDayEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12&\n" +
+	"\x0fz_report_number\x18\x02 \x01(\rR\rzReportNumber\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12*\n" +
+	"\x02at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"s\n" +
+	"\x17CloseBusinessDayRequest\x12H\n" +
+	"\rbusiness_date\x18\x01 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\fbusinessDateJ\x04\b\x02\x10\x03R\boperator\"H\n" +
+	"\x18CloseBusinessDayResponse\x12,\n" +
+	"\x06report\x18\x01 \x01(\v2\x14.businessday.ZReportR\x06report\"\xa6\x01\n" +
+	"\x18ReopenBusinessDayRequest\x12H\n" +
+	"\rbusiness_date\x18\x01 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\fbusinessDate\x12\x1f\n" +
+	"\x06reason\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06reasonJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05R\boperatorR\tadmin_pin\"X\n" +
+	"\x19ReopenBusinessDayResponse\x12#\n" +
+	"\rbusiness_date\x18\x01 \x01(\tR\fbusinessDate\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"a\n" +
+	"\x15GetBusinessDayRequest\x12H\n" +
+	"\rbusiness_date\x18\x01 \x01(\tB#\xbaH r\x1e2\x1c^[0-9]{4}-[0-9]{2}-[0-9]{2}$R\fbusinessDate\"\xbc\x01\n" +
+	"\x16GetBusinessDayResponse\x12#\n" +
+	"\rbusiness_date\x18\x01 \x01(\tR\fbusinessDate\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12.\n" +
+	"\areports\x18\x03 \x03(\v2\x14.businessday.ZReportR\areports\x125\n" +
+	"\x06events\x18\x04 \x03(\v2\x1d.businessday.BusinessDayEventR\x06events2\xb9\x02\n" +
+	"\x12BusinessDayService\x12_\n" +
+	"\x10CloseBusinessDay\x12$.businessday.CloseBusinessDayRequest\x1a%.businessday.CloseBusinessDayResponse\x12b\n" +
+	"\x11ReopenBusinessDay\x12%.businessday.ReopenBusinessDayRequest\x1a&.businessday.ReopenBusinessDayResponse\x12^\n" +
+	"\x0eGetBusinessDay\x12\".businessday.GetBusinessDayRequest\x1a#.businessday.GetBusinessDayResponse\"\x03\x90\x02\x01B\xa0\x01\n" +
+	"\x0fcom.businessdayB\x10BusinessdayProtoP\x01Z/github.com/jany/my-coffee/gen/proto/businessday\xa2\x02\x03BXX\xaa\x02\vBusinessday\xca\x02\vBusinessday\xe2\x02\x17Businessday\\GPBMetadata\xea\x02\vBusinessdayb\x06proto3"
+
+var (
+	file_businessday_businessday_proto_rawDescOnce sync.Once
+	file_businessday_businessday_proto_rawDescData []byte
+)
+
+func file_businessday_businessday_proto_rawDescGZIP() []byte {
+	file_businessday_businessday_proto_rawDescOnce.Do(func() {
+		file_businessday_businessday_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_businessday_businessday_proto_rawDesc), len(file_businessday_businessday_proto_rawDesc)))
+	})
+	return file_businessday_businessday_proto_rawDescData
+}
+
+var file_businessday_businessday_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_businessday_businessday_proto_goTypes = []any{
+	(*Tender)(nil),                    // 0: businessday.Tender
+	(*ZReport)(nil),                   // 1: businessday.ZReport
+	(*BusinessDayEvent)(nil),          // 2: businessday.BusinessDayEvent
+	(*CloseBusinessDayRequest)(nil),   // 3: businessday.CloseBusinessDayRequest
+	(*CloseBusinessDayResponse)(nil),  // 4: businessday.CloseBusinessDayResponse
+	(*ReopenBusinessDayRequest)(nil),  // 5: businessday.ReopenBusinessDayRequest
+	(*ReopenBusinessDayResponse)(nil), // 6: businessday.ReopenBusinessDayResponse
+	(*GetBusinessDayRequest)(nil),     // 7: businessday.GetBusinessDayRequest
+	(*GetBusinessDayResponse)(nil),    // 8: businessday.GetBusinessDayResponse
+	(*timestamppb.Timestamp)(nil),     // 9: google.protobuf.Timestamp
+}
+var file_businessday_businessday_proto_depIdxs = []int32{
+	0, // 0: businessday.ZReport.tenders:type_name -> businessday.Tender
+	9, // 1: businessday.ZReport.closed_at:type_name -> google.protobuf.Timestamp
+	9, // 2: businessday.BusinessDayEvent.at:type_name -> google.protobuf.Timestamp
+	1, // 3: businessday.CloseBusinessDayResponse.report:type_name -> businessday.ZReport
+	1, // 4: businessday.GetBusinessDayResponse.reports:type_name -> businessday.ZReport
+	2, // 5: businessday.GetBusinessDayResponse.events:type_name -> businessday.BusinessDayEvent
+	3, // 6: businessday.BusinessDayService.CloseBusinessDay:input_type -> businessday.CloseBusinessDayRequest
+	5, // 7: businessday.BusinessDayService.ReopenBusinessDay:input_type -> businessday.ReopenBusinessDayRequest
+	7, // 8: businessday.BusinessDayService.GetBusinessDay:input_type -> businessday.GetBusinessDayRequest
+	4, // 9: businessday.BusinessDayService.CloseBusinessDay:output_type -> businessday.CloseBusinessDayResponse
+	6, // 10: businessday.BusinessDayService.ReopenBusinessDay:output_type -> businessday.ReopenBusinessDayResponse
+	8, // 11: businessday.BusinessDayService.GetBusinessDay:output_type -> businessday.GetBusinessDayResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_businessday_businessday_proto_init() }
+func file_businessday_businessday_proto_init() {
+	if File_businessday_businessday_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_businessday_businessday_proto_rawDesc), len(file_businessday_businessday_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_businessday_businessday_proto_goTypes,
+		DependencyIndexes: file_businessday_businessday_proto_depIdxs,
+		MessageInfos:      file_businessday_businessday_proto_msgTypes,
+	}.Build()
+	File_businessday_businessday_proto = out.File
+	file_businessday_businessday_proto_goTypes = nil
+	file_businessday_businessday_proto_depIdxs = nil
+}
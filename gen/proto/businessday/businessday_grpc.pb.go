@@ -0,0 +1,211 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: businessday/businessday.proto
+
+package businessday
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	BusinessDayService_CloseBusinessDay_FullMethodName  = "/businessday.BusinessDayService/CloseBusinessDay"
+	BusinessDayService_ReopenBusinessDay_FullMethodName = "/businessday.BusinessDayService/ReopenBusinessDay"
+	BusinessDayService_GetBusinessDay_FullMethodName    = "/businessday.BusinessDayService/GetBusinessDay"
+)
+
+// BusinessDayServiceClient is the client API for BusinessDayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// BusinessDayService is hosted by brewsvc. Closing a day writes its
+// Z-report and stops the day's orders from being changed, paid, cancelled
+// or refunded. Business dates are YYYY-MM-DD in the shop's time zone.
+type BusinessDayServiceClient interface {
+	CloseBusinessDay(ctx context.Context, in *CloseBusinessDayRequest, opts ...grpc.CallOption) (*CloseBusinessDayResponse, error)
+	// Unlocks a closed day. Only admins may, and the reason is kept in the
+	// day's audit trail.
+	ReopenBusinessDay(ctx context.Context, in *ReopenBusinessDayRequest, opts ...grpc.CallOption) (*ReopenBusinessDayResponse, error)
+	// Whether a day is closed, its Z-reports and its audit trail.
+	GetBusinessDay(ctx context.Context, in *GetBusinessDayRequest, opts ...grpc.CallOption) (*GetBusinessDayResponse, error)
+}
+
+type businessDayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBusinessDayServiceClient(cc grpc.ClientConnInterface) BusinessDayServiceClient {
+	return &businessDayServiceClient{cc}
+}
+
+func (c *businessDayServiceClient) CloseBusinessDay(ctx context.Context, in *CloseBusinessDayRequest, opts ...grpc.CallOption) (*CloseBusinessDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseBusinessDayResponse)
+	err := c.cc.Invoke(ctx, BusinessDayService_CloseBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessDayServiceClient) ReopenBusinessDay(ctx context.Context, in *ReopenBusinessDayRequest, opts ...grpc.CallOption) (*ReopenBusinessDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenBusinessDayResponse)
+	err := c.cc.Invoke(ctx, BusinessDayService_ReopenBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *businessDayServiceClient) GetBusinessDay(ctx context.Context, in *GetBusinessDayRequest, opts ...grpc.CallOption) (*GetBusinessDayResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBusinessDayResponse)
+	err := c.cc.Invoke(ctx, BusinessDayService_GetBusinessDay_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BusinessDayServiceServer is the server API for BusinessDayService service.
+// All implementations must embed UnimplementedBusinessDayServiceServer
+// for forward compatibility.
+//
+// BusinessDayService is hosted by brewsvc. Closing a day writes its
+// Z-report and stops the day's orders from being changed, paid, cancelled
+// or refunded. Business dates are YYYY-MM-DD in the shop's time zone.
+type BusinessDayServiceServer interface {
+	CloseBusinessDay(context.Context, *CloseBusinessDayRequest) (*CloseBusinessDayResponse, error)
+	// Unlocks a closed day. Only admins may, and the reason is kept in the
+	// day's audit trail.
+	ReopenBusinessDay(context.Context, *ReopenBusinessDayRequest) (*ReopenBusinessDayResponse, error)
+	// Whether a day is closed, its Z-reports and its audit trail.
+	GetBusinessDay(context.Context, *GetBusinessDayRequest) (*GetBusinessDayResponse, error)
+	mustEmbedUnimplementedBusinessDayServiceServer()
+}
+
+// UnimplementedBusinessDayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBusinessDayServiceServer struct{}
+
+func (UnimplementedBusinessDayServiceServer) CloseBusinessDay(context.Context, *CloseBusinessDayRequest) (*CloseBusinessDayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseBusinessDay not implemented")
+}
+func (UnimplementedBusinessDayServiceServer) ReopenBusinessDay(context.Context, *ReopenBusinessDayRequest) (*ReopenBusinessDayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReopenBusinessDay not implemented")
+}
+func (UnimplementedBusinessDayServiceServer) GetBusinessDay(context.Context, *GetBusinessDayRequest) (*GetBusinessDayResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBusinessDay not implemented")
+}
+func (UnimplementedBusinessDayServiceServer) mustEmbedUnimplementedBusinessDayServiceServer() {}
+func (UnimplementedBusinessDayServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeBusinessDayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BusinessDayServiceServer will
+// result in compilation errors.
+type UnsafeBusinessDayServiceServer interface {
+	mustEmbedUnimplementedBusinessDayServiceServer()
+}
+
+func RegisterBusinessDayServiceServer(s grpc.ServiceRegistrar, srv BusinessDayServiceServer) {
+	// If the following call panics, it indicates UnimplementedBusinessDayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BusinessDayService_ServiceDesc, srv)
+}
+
+func _BusinessDayService_CloseBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessDayServiceServer).CloseBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusinessDayService_CloseBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessDayServiceServer).CloseBusinessDay(ctx, req.(*CloseBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusinessDayService_ReopenBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessDayServiceServer).ReopenBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusinessDayService_ReopenBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessDayServiceServer).ReopenBusinessDay(ctx, req.(*ReopenBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BusinessDayService_GetBusinessDay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBusinessDayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BusinessDayServiceServer).GetBusinessDay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BusinessDayService_GetBusinessDay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BusinessDayServiceServer).GetBusinessDay(ctx, req.(*GetBusinessDayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BusinessDayService_ServiceDesc is the grpc.ServiceDesc for BusinessDayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BusinessDayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "businessday.BusinessDayService",
+	HandlerType: (*BusinessDayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CloseBusinessDay",
+			Handler:    _BusinessDayService_CloseBusinessDay_Handler,
+		},
+		{
+			MethodName: "ReopenBusinessDay",
+			Handler:    _BusinessDayService_ReopenBusinessDay_Handler,
+		},
+		{
+			MethodName: "GetBusinessDay",
+			Handler:    _BusinessDayService_GetBusinessDay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "businessday/businessday.proto",
+}
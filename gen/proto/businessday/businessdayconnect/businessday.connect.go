@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: businessday/businessday.proto
+
+package businessdayconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	businessday "github.com/jany/my-coffee/gen/proto/businessday"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// BusinessDayServiceName is the fully-qualified name of the BusinessDayService service.
+	BusinessDayServiceName = "businessday.BusinessDayService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// BusinessDayServiceCloseBusinessDayProcedure is the fully-qualified name of the
+	// BusinessDayService's CloseBusinessDay RPC.
+	BusinessDayServiceCloseBusinessDayProcedure = "/businessday.BusinessDayService/CloseBusinessDay"
+	// BusinessDayServiceReopenBusinessDayProcedure is the fully-qualified name of the
+	// BusinessDayService's ReopenBusinessDay RPC.
+	BusinessDayServiceReopenBusinessDayProcedure = "/businessday.BusinessDayService/ReopenBusinessDay"
+	// BusinessDayServiceGetBusinessDayProcedure is the fully-qualified name of the BusinessDayService's
+	// GetBusinessDay RPC.
+	BusinessDayServiceGetBusinessDayProcedure = "/businessday.BusinessDayService/GetBusinessDay"
+)
+
+// BusinessDayServiceClient is a client for the businessday.BusinessDayService service.
+type BusinessDayServiceClient interface {
+	CloseBusinessDay(context.Context, *connect.Request[businessday.CloseBusinessDayRequest]) (*connect.Response[businessday.CloseBusinessDayResponse], error)
+	// Unlocks a closed day. Only admins may, and the reason is kept in the
+	// day's audit trail.
+	ReopenBusinessDay(context.Context, *connect.Request[businessday.ReopenBusinessDayRequest]) (*connect.Response[businessday.ReopenBusinessDayResponse], error)
+	// Whether a day is closed, its Z-reports and its audit trail.
+	GetBusinessDay(context.Context, *connect.Request[businessday.GetBusinessDayRequest]) (*connect.Response[businessday.GetBusinessDayResponse], error)
+}
+
+// NewBusinessDayServiceClient constructs a client for the businessday.BusinessDayService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewBusinessDayServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BusinessDayServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	businessDayServiceMethods := businessday.File_businessday_businessday_proto.Services().ByName("BusinessDayService").Methods()
+	return &businessDayServiceClient{
+		closeBusinessDay: connect.NewClient[businessday.CloseBusinessDayRequest, businessday.CloseBusinessDayResponse](
+			httpClient,
+			baseURL+BusinessDayServiceCloseBusinessDayProcedure,
+			connect.WithSchema(businessDayServiceMethods.ByName("CloseBusinessDay")),
+			connect.WithClientOptions(opts...),
+		),
+		reopenBusinessDay: connect.NewClient[businessday.ReopenBusinessDayRequest, businessday.ReopenBusinessDayResponse](
+			httpClient,
+			baseURL+BusinessDayServiceReopenBusinessDayProcedure,
+			connect.WithSchema(businessDayServiceMethods.ByName("ReopenBusinessDay")),
+			connect.WithClientOptions(opts...),
+		),
+		getBusinessDay: connect.NewClient[businessday.GetBusinessDayRequest, businessday.GetBusinessDayResponse](
+			httpClient,
+			baseURL+BusinessDayServiceGetBusinessDayProcedure,
+			connect.WithSchema(businessDayServiceMethods.ByName("GetBusinessDay")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// businessDayServiceClient implements BusinessDayServiceClient.
+type businessDayServiceClient struct {
+	closeBusinessDay  *connect.Client[businessday.CloseBusinessDayRequest, businessday.CloseBusinessDayResponse]
+	reopenBusinessDay *connect.Client[businessday.ReopenBusinessDayRequest, businessday.ReopenBusinessDayResponse]
+	getBusinessDay    *connect.Client[businessday.GetBusinessDayRequest, businessday.GetBusinessDayResponse]
+}
+
+// CloseBusinessDay calls businessday.BusinessDayService.CloseBusinessDay.
+func (c *businessDayServiceClient) CloseBusinessDay(ctx context.Context, req *connect.Request[businessday.CloseBusinessDayRequest]) (*connect.Response[businessday.CloseBusinessDayResponse], error) {
+	return c.closeBusinessDay.CallUnary(ctx, req)
+}
+
+// ReopenBusinessDay calls businessday.BusinessDayService.ReopenBusinessDay.
+func (c *businessDayServiceClient) ReopenBusinessDay(ctx context.Context, req *connect.Request[businessday.ReopenBusinessDayRequest]) (*connect.Response[businessday.ReopenBusinessDayResponse], error) {
+	return c.reopenBusinessDay.CallUnary(ctx, req)
+}
+
+// GetBusinessDay calls businessday.BusinessDayService.GetBusinessDay.
+func (c *businessDayServiceClient) GetBusinessDay(ctx context.Context, req *connect.Request[businessday.GetBusinessDayRequest]) (*connect.Response[businessday.GetBusinessDayResponse], error) {
+	return c.getBusinessDay.CallUnary(ctx, req)
+}
+
+// BusinessDayServiceHandler is an implementation of the businessday.BusinessDayService service.
+type BusinessDayServiceHandler interface {
+	CloseBusinessDay(context.Context, *connect.Request[businessday.CloseBusinessDayRequest]) (*connect.Response[businessday.CloseBusinessDayResponse], error)
+	// Unlocks a closed day. Only admins may, and the reason is kept in the
+	// day's audit trail.
+	ReopenBusinessDay(context.Context, *connect.Request[businessday.ReopenBusinessDayRequest]) (*connect.Response[businessday.ReopenBusinessDayResponse], error)
+	// Whether a day is closed, its Z-reports and its audit trail.
+	GetBusinessDay(context.Context, *connect.Request[businessday.GetBusinessDayRequest]) (*connect.Response[businessday.GetBusinessDayResponse], error)
+}
+
+// NewBusinessDayServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBusinessDayServiceHandler(svc BusinessDayServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	businessDayServiceMethods := businessday.File_businessday_businessday_proto.Services().ByName("BusinessDayService").Methods()
+	businessDayServiceCloseBusinessDayHandler := connect.NewUnaryHandler(
+		BusinessDayServiceCloseBusinessDayProcedure,
+		svc.CloseBusinessDay,
+		connect.WithSchema(businessDayServiceMethods.ByName("CloseBusinessDay")),
+		connect.WithHandlerOptions(opts...),
+	)
+	businessDayServiceReopenBusinessDayHandler := connect.NewUnaryHandler(
+		BusinessDayServiceReopenBusinessDayProcedure,
+		svc.ReopenBusinessDay,
+		connect.WithSchema(businessDayServiceMethods.ByName("ReopenBusinessDay")),
+		connect.WithHandlerOptions(opts...),
+	)
+	businessDayServiceGetBusinessDayHandler := connect.NewUnaryHandler(
+		BusinessDayServiceGetBusinessDayProcedure,
+		svc.GetBusinessDay,
+		connect.WithSchema(businessDayServiceMethods.ByName("GetBusinessDay")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/businessday.BusinessDayService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BusinessDayServiceCloseBusinessDayProcedure:
+			businessDayServiceCloseBusinessDayHandler.ServeHTTP(w, r)
+		case BusinessDayServiceReopenBusinessDayProcedure:
+			businessDayServiceReopenBusinessDayHandler.ServeHTTP(w, r)
+		case BusinessDayServiceGetBusinessDayProcedure:
+			businessDayServiceGetBusinessDayHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBusinessDayServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBusinessDayServiceHandler struct{}
+
+func (UnimplementedBusinessDayServiceHandler) CloseBusinessDay(context.Context, *connect.Request[businessday.CloseBusinessDayRequest]) (*connect.Response[businessday.CloseBusinessDayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("businessday.BusinessDayService.CloseBusinessDay is not implemented"))
+}
+
+func (UnimplementedBusinessDayServiceHandler) ReopenBusinessDay(context.Context, *connect.Request[businessday.ReopenBusinessDayRequest]) (*connect.Response[businessday.ReopenBusinessDayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("businessday.BusinessDayService.ReopenBusinessDay is not implemented"))
+}
+
+func (UnimplementedBusinessDayServiceHandler) GetBusinessDay(context.Context, *connect.Request[businessday.GetBusinessDayRequest]) (*connect.Response[businessday.GetBusinessDayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("businessday.BusinessDayService.GetBusinessDay is not implemented"))
+}
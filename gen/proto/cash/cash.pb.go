@@ -0,0 +1,1162 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: cash/cash.proto
+
+package cash
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CashMovementKind int32
+
+const (
+	CashMovementKind_CASH_MOVEMENT_KIND_UNSPECIFIED CashMovementKind = 0
+	CashMovementKind_PAID_IN                        CashMovementKind = 1
+	CashMovementKind_PAID_OUT                       CashMovementKind = 2
+)
+
+// Enum value maps for CashMovementKind.
+var (
+	CashMovementKind_name = map[int32]string{
+		0: "CASH_MOVEMENT_KIND_UNSPECIFIED",
+		1: "PAID_IN",
+		2: "PAID_OUT",
+	}
+	CashMovementKind_value = map[string]int32{
+		"CASH_MOVEMENT_KIND_UNSPECIFIED": 0,
+		"PAID_IN":                        1,
+		"PAID_OUT":                       2,
+	}
+)
+
+func (x CashMovementKind) Enum() *CashMovementKind {
+	p := new(CashMovementKind)
+	*p = x
+	return p
+}
+
+func (x CashMovementKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CashMovementKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_cash_cash_proto_enumTypes[0].Descriptor()
+}
+
+func (CashMovementKind) Type() protoreflect.EnumType {
+	return &file_cash_cash_proto_enumTypes[0]
+}
+
+func (x CashMovementKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CashMovementKind.Descriptor instead.
+func (CashMovementKind) EnumDescriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{0}
+}
+
+type CashDrawer struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Register string                 `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Set while a shift is open.
+	OpenShift     *Shift `protobuf:"bytes,3,opt,name=open_shift,json=openShift,proto3" json:"open_shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashDrawer) Reset() {
+	*x = CashDrawer{}
+	mi := &file_cash_cash_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashDrawer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashDrawer) ProtoMessage() {}
+
+func (x *CashDrawer) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashDrawer.ProtoReflect.Descriptor instead.
+func (*CashDrawer) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CashDrawer) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *CashDrawer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CashDrawer) GetOpenShift() *Shift {
+	if x != nil {
+		return x.OpenShift
+	}
+	return nil
+}
+
+type Shift struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ShiftId  uint32                 `protobuf:"varint,1,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	Register string                 `protobuf:"bytes,2,opt,name=register,proto3" json:"register,omitempty"`
+	// OPEN or CLOSED
+	Status            string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	OpenedBy          string                 `protobuf:"bytes,4,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	OpeningFloatCents int64                  `protobuf:"varint,5,opt,name=opening_float_cents,json=openingFloatCents,proto3" json:"opening_float_cents,omitempty"`
+	OpenedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedBy          string                 `protobuf:"bytes,7,opt,name=closed_by,json=closedBy,proto3" json:"closed_by,omitempty"`
+	ClosedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Shift) Reset() {
+	*x = Shift{}
+	mi := &file_cash_cash_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shift) ProtoMessage() {}
+
+func (x *Shift) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shift.ProtoReflect.Descriptor instead.
+func (*Shift) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Shift) GetShiftId() uint32 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *Shift) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *Shift) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Shift) GetOpenedBy() string {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return ""
+}
+
+func (x *Shift) GetOpeningFloatCents() int64 {
+	if x != nil {
+		return x.OpeningFloatCents
+	}
+	return 0
+}
+
+func (x *Shift) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *Shift) GetClosedBy() string {
+	if x != nil {
+		return x.ClosedBy
+	}
+	return ""
+}
+
+func (x *Shift) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+type CashMovement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// SALE, REFUND, PAID_IN or PAID_OUT
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Positive into the drawer, negative out of it.
+	AmountCents   int64                  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator      string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CashMovement) Reset() {
+	*x = CashMovement{}
+	mi := &file_cash_cash_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashMovement) ProtoMessage() {}
+
+func (x *CashMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashMovement.ProtoReflect.Descriptor instead.
+func (*CashMovement) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CashMovement) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CashMovement) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *CashMovement) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CashMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CashMovement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *CashMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ShiftReport reconciles a drawer. expected_cents is the opening float plus
+// every movement; counted and over_short are set once the shift is closed.
+type ShiftReport struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Shift             *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	OpeningFloatCents int64                  `protobuf:"varint,2,opt,name=opening_float_cents,json=openingFloatCents,proto3" json:"opening_float_cents,omitempty"`
+	SalesCents        int64                  `protobuf:"varint,3,opt,name=sales_cents,json=salesCents,proto3" json:"sales_cents,omitempty"`
+	RefundsCents      int64                  `protobuf:"varint,4,opt,name=refunds_cents,json=refundsCents,proto3" json:"refunds_cents,omitempty"`
+	PaidInCents       int64                  `protobuf:"varint,5,opt,name=paid_in_cents,json=paidInCents,proto3" json:"paid_in_cents,omitempty"`
+	PaidOutCents      int64                  `protobuf:"varint,6,opt,name=paid_out_cents,json=paidOutCents,proto3" json:"paid_out_cents,omitempty"`
+	ExpectedCents     int64                  `protobuf:"varint,7,opt,name=expected_cents,json=expectedCents,proto3" json:"expected_cents,omitempty"`
+	CountedCents      int64                  `protobuf:"varint,8,opt,name=counted_cents,json=countedCents,proto3" json:"counted_cents,omitempty"`
+	// counted minus expected: positive when the drawer is over.
+	OverShortCents int64           `protobuf:"varint,9,opt,name=over_short_cents,json=overShortCents,proto3" json:"over_short_cents,omitempty"`
+	Note           string          `protobuf:"bytes,10,opt,name=note,proto3" json:"note,omitempty"`
+	Movements      []*CashMovement `protobuf:"bytes,11,rep,name=movements,proto3" json:"movements,omitempty"`
+	Currency       string          `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ShiftReport) Reset() {
+	*x = ShiftReport{}
+	mi := &file_cash_cash_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShiftReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftReport) ProtoMessage() {}
+
+func (x *ShiftReport) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftReport.ProtoReflect.Descriptor instead.
+func (*ShiftReport) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShiftReport) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetOpeningFloatCents() int64 {
+	if x != nil {
+		return x.OpeningFloatCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetSalesCents() int64 {
+	if x != nil {
+		return x.SalesCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetRefundsCents() int64 {
+	if x != nil {
+		return x.RefundsCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetPaidInCents() int64 {
+	if x != nil {
+		return x.PaidInCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetPaidOutCents() int64 {
+	if x != nil {
+		return x.PaidOutCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetExpectedCents() int64 {
+	if x != nil {
+		return x.ExpectedCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetCountedCents() int64 {
+	if x != nil {
+		return x.CountedCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetOverShortCents() int64 {
+	if x != nil {
+		return x.OverShortCents
+	}
+	return 0
+}
+
+func (x *ShiftReport) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ShiftReport) GetMovements() []*CashMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ShiftReport) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type ListCashDrawersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCashDrawersRequest) Reset() {
+	*x = ListCashDrawersRequest{}
+	mi := &file_cash_cash_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCashDrawersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCashDrawersRequest) ProtoMessage() {}
+
+func (x *ListCashDrawersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCashDrawersRequest.ProtoReflect.Descriptor instead.
+func (*ListCashDrawersRequest) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{4}
+}
+
+type ListCashDrawersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drawers       []*CashDrawer          `protobuf:"bytes,1,rep,name=drawers,proto3" json:"drawers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCashDrawersResponse) Reset() {
+	*x = ListCashDrawersResponse{}
+	mi := &file_cash_cash_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCashDrawersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCashDrawersResponse) ProtoMessage() {}
+
+func (x *ListCashDrawersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCashDrawersResponse.ProtoReflect.Descriptor instead.
+func (*ListCashDrawersResponse) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListCashDrawersResponse) GetDrawers() []*CashDrawer {
+	if x != nil {
+		return x.Drawers
+	}
+	return nil
+}
+
+type OpenShiftRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Register string                 `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	// Cash counted into the drawer at the start of the shift.
+	OpeningFloatCents int64 `protobuf:"varint,3,opt,name=opening_float_cents,json=openingFloatCents,proto3" json:"opening_float_cents,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *OpenShiftRequest) Reset() {
+	*x = OpenShiftRequest{}
+	mi := &file_cash_cash_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShiftRequest) ProtoMessage() {}
+
+func (x *OpenShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShiftRequest.ProtoReflect.Descriptor instead.
+func (*OpenShiftRequest) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OpenShiftRequest) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *OpenShiftRequest) GetOpeningFloatCents() int64 {
+	if x != nil {
+		return x.OpeningFloatCents
+	}
+	return 0
+}
+
+type OpenShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shift         *Shift                 `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenShiftResponse) Reset() {
+	*x = OpenShiftResponse{}
+	mi := &file_cash_cash_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenShiftResponse) ProtoMessage() {}
+
+func (x *OpenShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenShiftResponse.ProtoReflect.Descriptor instead.
+func (*OpenShiftResponse) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenShiftResponse) GetShift() *Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+type CloseShiftRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Register     string                 `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	CountedCents int64                  `protobuf:"varint,3,opt,name=counted_cents,json=countedCents,proto3" json:"counted_cents,omitempty"`
+	// Explanation for any difference.
+	Note          string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseShiftRequest) Reset() {
+	*x = CloseShiftRequest{}
+	mi := &file_cash_cash_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShiftRequest) ProtoMessage() {}
+
+func (x *CloseShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShiftRequest.ProtoReflect.Descriptor instead.
+func (*CloseShiftRequest) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CloseShiftRequest) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *CloseShiftRequest) GetCountedCents() int64 {
+	if x != nil {
+		return x.CountedCents
+	}
+	return 0
+}
+
+func (x *CloseShiftRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CloseShiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ShiftReport           `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseShiftResponse) Reset() {
+	*x = CloseShiftResponse{}
+	mi := &file_cash_cash_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseShiftResponse) ProtoMessage() {}
+
+func (x *CloseShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseShiftResponse.ProtoReflect.Descriptor instead.
+func (*CloseShiftResponse) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CloseShiftResponse) GetReport() *ShiftReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type RecordCashMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Register      string                 `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	Kind          CashMovementKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=cash.CashMovementKind" json:"kind,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,3,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCashMovementRequest) Reset() {
+	*x = RecordCashMovementRequest{}
+	mi := &file_cash_cash_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCashMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCashMovementRequest) ProtoMessage() {}
+
+func (x *RecordCashMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCashMovementRequest.ProtoReflect.Descriptor instead.
+func (*RecordCashMovementRequest) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RecordCashMovementRequest) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *RecordCashMovementRequest) GetKind() CashMovementKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CashMovementKind_CASH_MOVEMENT_KIND_UNSPECIFIED
+}
+
+func (x *RecordCashMovementRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *RecordCashMovementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RecordCashMovementResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Movement *CashMovement          `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	// Cash that should now be in the drawer.
+	ExpectedCents int64 `protobuf:"varint,2,opt,name=expected_cents,json=expectedCents,proto3" json:"expected_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCashMovementResponse) Reset() {
+	*x = RecordCashMovementResponse{}
+	mi := &file_cash_cash_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCashMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCashMovementResponse) ProtoMessage() {}
+
+func (x *RecordCashMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCashMovementResponse.ProtoReflect.Descriptor instead.
+func (*RecordCashMovementResponse) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RecordCashMovementResponse) GetMovement() *CashMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+func (x *RecordCashMovementResponse) GetExpectedCents() int64 {
+	if x != nil {
+		return x.ExpectedCents
+	}
+	return 0
+}
+
+type GetShiftReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Shift:
+	//
+	//	*GetShiftReportRequest_ShiftId
+	//	*GetShiftReportRequest_Register
+	Shift         isGetShiftReportRequest_Shift `protobuf_oneof:"shift"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShiftReportRequest) Reset() {
+	*x = GetShiftReportRequest{}
+	mi := &file_cash_cash_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftReportRequest) ProtoMessage() {}
+
+func (x *GetShiftReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftReportRequest.ProtoReflect.Descriptor instead.
+func (*GetShiftReportRequest) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetShiftReportRequest) GetShift() isGetShiftReportRequest_Shift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *GetShiftReportRequest) GetShiftId() uint32 {
+	if x != nil {
+		if x, ok := x.Shift.(*GetShiftReportRequest_ShiftId); ok {
+			return x.ShiftId
+		}
+	}
+	return 0
+}
+
+func (x *GetShiftReportRequest) GetRegister() string {
+	if x != nil {
+		if x, ok := x.Shift.(*GetShiftReportRequest_Register); ok {
+			return x.Register
+		}
+	}
+	return ""
+}
+
+type isGetShiftReportRequest_Shift interface {
+	isGetShiftReportRequest_Shift()
+}
+
+type GetShiftReportRequest_ShiftId struct {
+	ShiftId uint32 `protobuf:"varint,1,opt,name=shift_id,json=shiftId,proto3,oneof"`
+}
+
+type GetShiftReportRequest_Register struct {
+	// The open shift of this register.
+	Register string `protobuf:"bytes,2,opt,name=register,proto3,oneof"`
+}
+
+func (*GetShiftReportRequest_ShiftId) isGetShiftReportRequest_Shift() {}
+
+func (*GetShiftReportRequest_Register) isGetShiftReportRequest_Shift() {}
+
+type GetShiftReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *ShiftReport           `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShiftReportResponse) Reset() {
+	*x = GetShiftReportResponse{}
+	mi := &file_cash_cash_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShiftReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShiftReportResponse) ProtoMessage() {}
+
+func (x *GetShiftReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cash_cash_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShiftReportResponse.ProtoReflect.Descriptor instead.
+func (*GetShiftReportResponse) Descriptor() ([]byte, []int) {
+	return file_cash_cash_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetShiftReportResponse) GetReport() *ShiftReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+var File_cash_cash_proto protoreflect.FileDescriptor
+
+const file_cash_cash_proto_rawDesc = "" +
+	"\n" +
+	"\x0fcash/cash.proto\x12\x04cash\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"h\n" +
+	"\n" +
+	"CashDrawer\x12\x1a\n" +
+	"\bregister\x18\x01 \x01(\tR\bregister\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12*\n" +
+	"\n" +
+	"open_shift\x18\x03 \x01(\v2\v.cash.ShiftR\topenShift\"\xb2\x02\n" +
+	"\x05Shift\x12\x19\n" +
+	"\bshift_id\x18\x01 \x01(\rR\ashiftId\x12\x1a\n" +
+	"\bregister\x18\x02 \x01(\tR\bregister\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\topened_by\x18\x04 \x01(\tR\bopenedBy\x12.\n" +
+	"\x13opening_float_cents\x18\x05 \x01(\x03R\x11openingFloatCents\x127\n" +
+	"\topened_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x12\x1b\n" +
+	"\tclosed_by\x18\a \x01(\tR\bclosedBy\x127\n" +
+	"\tclosed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bclosedAt\"\xcf\x01\n" +
+	"\fCashMovement\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1a\n" +
+	"\boperator\x18\x05 \x01(\tR\boperator\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc8\x03\n" +
+	"\vShiftReport\x12!\n" +
+	"\x05shift\x18\x01 \x01(\v2\v.cash.ShiftR\x05shift\x12.\n" +
+	"\x13opening_float_cents\x18\x02 \x01(\x03R\x11openingFloatCents\x12\x1f\n" +
+	"\vsales_cents\x18\x03 \x01(\x03R\n" +
+	"salesCents\x12#\n" +
+	"\rrefunds_cents\x18\x04 \x01(\x03R\frefundsCents\x12\"\n" +
+	"\rpaid_in_cents\x18\x05 \x01(\x03R\vpaidInCents\x12$\n" +
+	"\x0epaid_out_cents\x18\x06 \x01(\x03R\fpaidOutCents\x12%\n" +
+	"\x0eexpected_cents\x18\a \x01(\x03R\rexpectedCents\x12#\n" +
+	"\rcounted_cents\x18\b \x01(\x03R\fcountedCents\x12(\n" +
+	"\x10over_short_cents\x18\t \x01(\x03R\x0eoverShortCents\x12\x12\n" +
+	"\x04note\x18\n" +
+	" \x01(\tR\x04note\x120\n" +
+	"\tmovements\x18\v \x03(\v2\x12.cash.CashMovementR\tmovements\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\"\x18\n" +
+	"\x16ListCashDrawersRequest\"E\n" +
+	"\x17ListCashDrawersResponse\x12*\n" +
+	"\adrawers\x18\x01 \x03(\v2\x10.cash.CashDrawerR\adrawers\"\x80\x01\n" +
+	"\x10OpenShiftRequest\x12#\n" +
+	"\bregister\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bregister\x127\n" +
+	"\x13opening_float_cents\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x11openingFloatCentsJ\x04\b\x02\x10\x03R\boperator\"6\n" +
+	"\x11OpenShiftResponse\x12!\n" +
+	"\x05shift\x18\x01 \x01(\v2\v.cash.ShiftR\x05shift\"\x8a\x01\n" +
+	"\x11CloseShiftRequest\x12#\n" +
+	"\bregister\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bregister\x12,\n" +
+	"\rcounted_cents\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\fcountedCents\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04noteJ\x04\b\x02\x10\x03R\boperator\"?\n" +
+	"\x12CloseShiftResponse\x12)\n" +
+	"\x06report\x18\x01 \x01(\v2\x11.cash.ShiftReportR\x06report\"\xd5\x01\n" +
+	"\x19RecordCashMovementRequest\x12#\n" +
+	"\bregister\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bregister\x126\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x16.cash.CashMovementKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x12*\n" +
+	"\famount_cents\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\vamountCents\x12\x1f\n" +
+	"\x06reason\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06reasonJ\x04\b\x05\x10\x06R\boperator\"s\n" +
+	"\x1aRecordCashMovementResponse\x12.\n" +
+	"\bmovement\x18\x01 \x01(\v2\x12.cash.CashMovementR\bmovement\x12%\n" +
+	"\x0eexpected_cents\x18\x02 \x01(\x03R\rexpectedCents\"b\n" +
+	"\x15GetShiftReportRequest\x12\x1b\n" +
+	"\bshift_id\x18\x01 \x01(\rH\x00R\ashiftId\x12\x1c\n" +
+	"\bregister\x18\x02 \x01(\tH\x00R\bregisterB\x0e\n" +
+	"\x05shift\x12\x05\xbaH\x02\b\x01\"C\n" +
+	"\x16GetShiftReportResponse\x12)\n" +
+	"\x06report\x18\x01 \x01(\v2\x11.cash.ShiftReportR\x06report*Q\n" +
+	"\x10CashMovementKind\x12\"\n" +
+	"\x1eCASH_MOVEMENT_KIND_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPAID_IN\x10\x01\x12\f\n" +
+	"\bPAID_OUT\x10\x022\x8c\x03\n" +
+	"\vCashService\x12S\n" +
+	"\x0fListCashDrawers\x12\x1c.cash.ListCashDrawersRequest\x1a\x1d.cash.ListCashDrawersResponse\"\x03\x90\x02\x01\x12<\n" +
+	"\tOpenShift\x12\x16.cash.OpenShiftRequest\x1a\x17.cash.OpenShiftResponse\x12?\n" +
+	"\n" +
+	"CloseShift\x12\x17.cash.CloseShiftRequest\x1a\x18.cash.CloseShiftResponse\x12W\n" +
+	"\x12RecordCashMovement\x12\x1f.cash.RecordCashMovementRequest\x1a .cash.RecordCashMovementResponse\x12P\n" +
+	"\x0eGetShiftReport\x12\x1b.cash.GetShiftReportRequest\x1a\x1c.cash.GetShiftReportResponse\"\x03\x90\x02\x01Bo\n" +
+	"\bcom.cashB\tCashProtoP\x01Z(github.com/jany/my-coffee/gen/proto/cash\xa2\x02\x03CXX\xaa\x02\x04Cash\xca\x02\x04Cash\xe2\x02\x10Cash\\GPBMetadata\xea\x02\x04Cashb\x06proto3"
+
+var (
+	file_cash_cash_proto_rawDescOnce sync.Once
+	file_cash_cash_proto_rawDescData []byte
+)
+
+func file_cash_cash_proto_rawDescGZIP() []byte {
+	file_cash_cash_proto_rawDescOnce.Do(func() {
+		file_cash_cash_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cash_cash_proto_rawDesc), len(file_cash_cash_proto_rawDesc)))
+	})
+	return file_cash_cash_proto_rawDescData
+}
+
+var file_cash_cash_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cash_cash_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_cash_cash_proto_goTypes = []any{
+	(CashMovementKind)(0),              // 0: cash.CashMovementKind
+	(*CashDrawer)(nil),                 // 1: cash.CashDrawer
+	(*Shift)(nil),                      // 2: cash.Shift
+	(*CashMovement)(nil),               // 3: cash.CashMovement
+	(*ShiftReport)(nil),                // 4: cash.ShiftReport
+	(*ListCashDrawersRequest)(nil),     // 5: cash.ListCashDrawersRequest
+	(*ListCashDrawersResponse)(nil),    // 6: cash.ListCashDrawersResponse
+	(*OpenShiftRequest)(nil),           // 7: cash.OpenShiftRequest
+	(*OpenShiftResponse)(nil),          // 8: cash.OpenShiftResponse
+	(*CloseShiftRequest)(nil),          // 9: cash.CloseShiftRequest
+	(*CloseShiftResponse)(nil),         // 10: cash.CloseShiftResponse
+	(*RecordCashMovementRequest)(nil),  // 11: cash.RecordCashMovementRequest
+	(*RecordCashMovementResponse)(nil), // 12: cash.RecordCashMovementResponse
+	(*GetShiftReportRequest)(nil),      // 13: cash.GetShiftReportRequest
+	(*GetShiftReportResponse)(nil),     // 14: cash.GetShiftReportResponse
+	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
+}
+var file_cash_cash_proto_depIdxs = []int32{
+	2,  // 0: cash.CashDrawer.open_shift:type_name -> cash.Shift
+	15, // 1: cash.Shift.opened_at:type_name -> google.protobuf.Timestamp
+	15, // 2: cash.Shift.closed_at:type_name -> google.protobuf.Timestamp
+	15, // 3: cash.CashMovement.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: cash.ShiftReport.shift:type_name -> cash.Shift
+	3,  // 5: cash.ShiftReport.movements:type_name -> cash.CashMovement
+	1,  // 6: cash.ListCashDrawersResponse.drawers:type_name -> cash.CashDrawer
+	2,  // 7: cash.OpenShiftResponse.shift:type_name -> cash.Shift
+	4,  // 8: cash.CloseShiftResponse.report:type_name -> cash.ShiftReport
+	0,  // 9: cash.RecordCashMovementRequest.kind:type_name -> cash.CashMovementKind
+	3,  // 10: cash.RecordCashMovementResponse.movement:type_name -> cash.CashMovement
+	4,  // 11: cash.GetShiftReportResponse.report:type_name -> cash.ShiftReport
+	5,  // 12: cash.CashService.ListCashDrawers:input_type -> cash.ListCashDrawersRequest
+	7,  // 13: cash.CashService.OpenShift:input_type -> cash.OpenShiftRequest
+	9,  // 14: cash.CashService.CloseShift:input_type -> cash.CloseShiftRequest
+	11, // 15: cash.CashService.RecordCashMovement:input_type -> cash.RecordCashMovementRequest
+	13, // 16: cash.CashService.GetShiftReport:input_type -> cash.GetShiftReportRequest
+	6,  // 17: cash.CashService.ListCashDrawers:output_type -> cash.ListCashDrawersResponse
+	8,  // 18: cash.CashService.OpenShift:output_type -> cash.OpenShiftResponse
+	10, // 19: cash.CashService.CloseShift:output_type -> cash.CloseShiftResponse
+	12, // 20: cash.CashService.RecordCashMovement:output_type -> cash.RecordCashMovementResponse
+	14, // 21: cash.CashService.GetShiftReport:output_type -> cash.GetShiftReportResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_cash_cash_proto_init() }
+func file_cash_cash_proto_init() {
+	if File_cash_cash_proto != nil {
+		return
+	}
+	file_cash_cash_proto_msgTypes[12].OneofWrappers = []any{
+		(*GetShiftReportRequest_ShiftId)(nil),
+		(*GetShiftReportRequest_Register)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cash_cash_proto_rawDesc), len(file_cash_cash_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cash_cash_proto_goTypes,
+		DependencyIndexes: file_cash_cash_proto_depIdxs,
+		EnumInfos:         file_cash_cash_proto_enumTypes,
+		MessageInfos:      file_cash_cash_proto_msgTypes,
+	}.Build()
+	File_cash_cash_proto = out.File
+	file_cash_cash_proto_goTypes = nil
+	file_cash_cash_proto_depIdxs = nil
+}
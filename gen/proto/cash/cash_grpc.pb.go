@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: cash/cash.proto
+
+package cash
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CashService_ListCashDrawers_FullMethodName    = "/cash.CashService/ListCashDrawers"
+	CashService_OpenShift_FullMethodName          = "/cash.CashService/OpenShift"
+	CashService_CloseShift_FullMethodName         = "/cash.CashService/CloseShift"
+	CashService_RecordCashMovement_FullMethodName = "/cash.CashService/RecordCashMovement"
+	CashService_GetShiftReport_FullMethodName     = "/cash.CashService/GetShiftReport"
+)
+
+// CashServiceClient is the client API for CashService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CashService is hosted by brewsvc. Every register has a cash drawer that
+// is counted in when a shift opens and counted out when it closes. Cash
+// sales come from brew.PayOrderRequest.cash.
+type CashServiceClient interface {
+	ListCashDrawers(ctx context.Context, in *ListCashDrawersRequest, opts ...grpc.CallOption) (*ListCashDrawersResponse, error)
+	OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*OpenShiftResponse, error)
+	// Counts the drawer out and reports expected against counted cash.
+	CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*CloseShiftResponse, error)
+	// Paid-in and paid-out entries: cash added to or taken from the drawer
+	// outside of sales.
+	RecordCashMovement(ctx context.Context, in *RecordCashMovementRequest, opts ...grpc.CallOption) (*RecordCashMovementResponse, error)
+	GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*GetShiftReportResponse, error)
+}
+
+type cashServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCashServiceClient(cc grpc.ClientConnInterface) CashServiceClient {
+	return &cashServiceClient{cc}
+}
+
+func (c *cashServiceClient) ListCashDrawers(ctx context.Context, in *ListCashDrawersRequest, opts ...grpc.CallOption) (*ListCashDrawersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCashDrawersResponse)
+	err := c.cc.Invoke(ctx, CashService_ListCashDrawers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) OpenShift(ctx context.Context, in *OpenShiftRequest, opts ...grpc.CallOption) (*OpenShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenShiftResponse)
+	err := c.cc.Invoke(ctx, CashService_OpenShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) CloseShift(ctx context.Context, in *CloseShiftRequest, opts ...grpc.CallOption) (*CloseShiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseShiftResponse)
+	err := c.cc.Invoke(ctx, CashService_CloseShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) RecordCashMovement(ctx context.Context, in *RecordCashMovementRequest, opts ...grpc.CallOption) (*RecordCashMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordCashMovementResponse)
+	err := c.cc.Invoke(ctx, CashService_RecordCashMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cashServiceClient) GetShiftReport(ctx context.Context, in *GetShiftReportRequest, opts ...grpc.CallOption) (*GetShiftReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShiftReportResponse)
+	err := c.cc.Invoke(ctx, CashService_GetShiftReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CashServiceServer is the server API for CashService service.
+// All implementations must embed UnimplementedCashServiceServer
+// for forward compatibility.
+//
+// CashService is hosted by brewsvc. Every register has a cash drawer that
+// is counted in when a shift opens and counted out when it closes. Cash
+// sales come from brew.PayOrderRequest.cash.
+type CashServiceServer interface {
+	ListCashDrawers(context.Context, *ListCashDrawersRequest) (*ListCashDrawersResponse, error)
+	OpenShift(context.Context, *OpenShiftRequest) (*OpenShiftResponse, error)
+	// Counts the drawer out and reports expected against counted cash.
+	CloseShift(context.Context, *CloseShiftRequest) (*CloseShiftResponse, error)
+	// Paid-in and paid-out entries: cash added to or taken from the drawer
+	// outside of sales.
+	RecordCashMovement(context.Context, *RecordCashMovementRequest) (*RecordCashMovementResponse, error)
+	GetShiftReport(context.Context, *GetShiftReportRequest) (*GetShiftReportResponse, error)
+	mustEmbedUnimplementedCashServiceServer()
+}
+
+// UnimplementedCashServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCashServiceServer struct{}
+
+func (UnimplementedCashServiceServer) ListCashDrawers(context.Context, *ListCashDrawersRequest) (*ListCashDrawersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCashDrawers not implemented")
+}
+func (UnimplementedCashServiceServer) OpenShift(context.Context, *OpenShiftRequest) (*OpenShiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method OpenShift not implemented")
+}
+func (UnimplementedCashServiceServer) CloseShift(context.Context, *CloseShiftRequest) (*CloseShiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloseShift not implemented")
+}
+func (UnimplementedCashServiceServer) RecordCashMovement(context.Context, *RecordCashMovementRequest) (*RecordCashMovementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordCashMovement not implemented")
+}
+func (UnimplementedCashServiceServer) GetShiftReport(context.Context, *GetShiftReportRequest) (*GetShiftReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetShiftReport not implemented")
+}
+func (UnimplementedCashServiceServer) mustEmbedUnimplementedCashServiceServer() {}
+func (UnimplementedCashServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCashServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CashServiceServer will
+// result in compilation errors.
+type UnsafeCashServiceServer interface {
+	mustEmbedUnimplementedCashServiceServer()
+}
+
+func RegisterCashServiceServer(s grpc.ServiceRegistrar, srv CashServiceServer) {
+	// If the following call panics, it indicates UnimplementedCashServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CashService_ServiceDesc, srv)
+}
+
+func _CashService_ListCashDrawers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCashDrawersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).ListCashDrawers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_ListCashDrawers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).ListCashDrawers(ctx, req.(*ListCashDrawersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_OpenShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).OpenShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_OpenShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).OpenShift(ctx, req.(*OpenShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_CloseShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).CloseShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_CloseShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).CloseShift(ctx, req.(*CloseShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_RecordCashMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCashMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).RecordCashMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_RecordCashMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).RecordCashMovement(ctx, req.(*RecordCashMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CashService_GetShiftReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShiftReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CashServiceServer).GetShiftReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CashService_GetShiftReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CashServiceServer).GetShiftReport(ctx, req.(*GetShiftReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CashService_ServiceDesc is the grpc.ServiceDesc for CashService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CashService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cash.CashService",
+	HandlerType: (*CashServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCashDrawers",
+			Handler:    _CashService_ListCashDrawers_Handler,
+		},
+		{
+			MethodName: "OpenShift",
+			Handler:    _CashService_OpenShift_Handler,
+		},
+		{
+			MethodName: "CloseShift",
+			Handler:    _CashService_CloseShift_Handler,
+		},
+		{
+			MethodName: "RecordCashMovement",
+			Handler:    _CashService_RecordCashMovement_Handler,
+		},
+		{
+			MethodName: "GetShiftReport",
+			Handler:    _CashService_GetShiftReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash/cash.proto",
+}
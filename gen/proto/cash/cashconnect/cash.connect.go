@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: cash/cash.proto
+
+package cashconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	cash "github.com/jany/my-coffee/gen/proto/cash"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// CashServiceName is the fully-qualified name of the CashService service.
+	CashServiceName = "cash.CashService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// CashServiceListCashDrawersProcedure is the fully-qualified name of the CashService's
+	// ListCashDrawers RPC.
+	CashServiceListCashDrawersProcedure = "/cash.CashService/ListCashDrawers"
+	// CashServiceOpenShiftProcedure is the fully-qualified name of the CashService's OpenShift RPC.
+	CashServiceOpenShiftProcedure = "/cash.CashService/OpenShift"
+	// CashServiceCloseShiftProcedure is the fully-qualified name of the CashService's CloseShift RPC.
+	CashServiceCloseShiftProcedure = "/cash.CashService/CloseShift"
+	// CashServiceRecordCashMovementProcedure is the fully-qualified name of the CashService's
+	// RecordCashMovement RPC.
+	CashServiceRecordCashMovementProcedure = "/cash.CashService/RecordCashMovement"
+	// CashServiceGetShiftReportProcedure is the fully-qualified name of the CashService's
+	// GetShiftReport RPC.
+	CashServiceGetShiftReportProcedure = "/cash.CashService/GetShiftReport"
+)
+
+// CashServiceClient is a client for the cash.CashService service.
+type CashServiceClient interface {
+	ListCashDrawers(context.Context, *connect.Request[cash.ListCashDrawersRequest]) (*connect.Response[cash.ListCashDrawersResponse], error)
+	OpenShift(context.Context, *connect.Request[cash.OpenShiftRequest]) (*connect.Response[cash.OpenShiftResponse], error)
+	// Counts the drawer out and reports expected against counted cash.
+	CloseShift(context.Context, *connect.Request[cash.CloseShiftRequest]) (*connect.Response[cash.CloseShiftResponse], error)
+	// Paid-in and paid-out entries: cash added to or taken from the drawer
+	// outside of sales.
+	RecordCashMovement(context.Context, *connect.Request[cash.RecordCashMovementRequest]) (*connect.Response[cash.RecordCashMovementResponse], error)
+	GetShiftReport(context.Context, *connect.Request[cash.GetShiftReportRequest]) (*connect.Response[cash.GetShiftReportResponse], error)
+}
+
+// NewCashServiceClient constructs a client for the cash.CashService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCashServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CashServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	cashServiceMethods := cash.File_cash_cash_proto.Services().ByName("CashService").Methods()
+	return &cashServiceClient{
+		listCashDrawers: connect.NewClient[cash.ListCashDrawersRequest, cash.ListCashDrawersResponse](
+			httpClient,
+			baseURL+CashServiceListCashDrawersProcedure,
+			connect.WithSchema(cashServiceMethods.ByName("ListCashDrawers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		openShift: connect.NewClient[cash.OpenShiftRequest, cash.OpenShiftResponse](
+			httpClient,
+			baseURL+CashServiceOpenShiftProcedure,
+			connect.WithSchema(cashServiceMethods.ByName("OpenShift")),
+			connect.WithClientOptions(opts...),
+		),
+		closeShift: connect.NewClient[cash.CloseShiftRequest, cash.CloseShiftResponse](
+			httpClient,
+			baseURL+CashServiceCloseShiftProcedure,
+			connect.WithSchema(cashServiceMethods.ByName("CloseShift")),
+			connect.WithClientOptions(opts...),
+		),
+		recordCashMovement: connect.NewClient[cash.RecordCashMovementRequest, cash.RecordCashMovementResponse](
+			httpClient,
+			baseURL+CashServiceRecordCashMovementProcedure,
+			connect.WithSchema(cashServiceMethods.ByName("RecordCashMovement")),
+			connect.WithClientOptions(opts...),
+		),
+		getShiftReport: connect.NewClient[cash.GetShiftReportRequest, cash.GetShiftReportResponse](
+			httpClient,
+			baseURL+CashServiceGetShiftReportProcedure,
+			connect.WithSchema(cashServiceMethods.ByName("GetShiftReport")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// cashServiceClient implements CashServiceClient.
+type cashServiceClient struct {
+	listCashDrawers    *connect.Client[cash.ListCashDrawersRequest, cash.ListCashDrawersResponse]
+	openShift          *connect.Client[cash.OpenShiftRequest, cash.OpenShiftResponse]
+	closeShift         *connect.Client[cash.CloseShiftRequest, cash.CloseShiftResponse]
+	recordCashMovement *connect.Client[cash.RecordCashMovementRequest, cash.RecordCashMovementResponse]
+	getShiftReport     *connect.Client[cash.GetShiftReportRequest, cash.GetShiftReportResponse]
+}
+
+// ListCashDrawers calls cash.CashService.ListCashDrawers.
+func (c *cashServiceClient) ListCashDrawers(ctx context.Context, req *connect.Request[cash.ListCashDrawersRequest]) (*connect.Response[cash.ListCashDrawersResponse], error) {
+	return c.listCashDrawers.CallUnary(ctx, req)
+}
+
+// OpenShift calls cash.CashService.OpenShift.
+func (c *cashServiceClient) OpenShift(ctx context.Context, req *connect.Request[cash.OpenShiftRequest]) (*connect.Response[cash.OpenShiftResponse], error) {
+	return c.openShift.CallUnary(ctx, req)
+}
+
+// CloseShift calls cash.CashService.CloseShift.
+func (c *cashServiceClient) CloseShift(ctx context.Context, req *connect.Request[cash.CloseShiftRequest]) (*connect.Response[cash.CloseShiftResponse], error) {
+	return c.closeShift.CallUnary(ctx, req)
+}
+
+// RecordCashMovement calls cash.CashService.RecordCashMovement.
+func (c *cashServiceClient) RecordCashMovement(ctx context.Context, req *connect.Request[cash.RecordCashMovementRequest]) (*connect.Response[cash.RecordCashMovementResponse], error) {
+	return c.recordCashMovement.CallUnary(ctx, req)
+}
+
+// GetShiftReport calls cash.CashService.GetShiftReport.
+func (c *cashServiceClient) GetShiftReport(ctx context.Context, req *connect.Request[cash.GetShiftReportRequest]) (*connect.Response[cash.GetShiftReportResponse], error) {
+	return c.getShiftReport.CallUnary(ctx, req)
+}
+
+// CashServiceHandler is an implementation of the cash.CashService service.
+type CashServiceHandler interface {
+	ListCashDrawers(context.Context, *connect.Request[cash.ListCashDrawersRequest]) (*connect.Response[cash.ListCashDrawersResponse], error)
+	OpenShift(context.Context, *connect.Request[cash.OpenShiftRequest]) (*connect.Response[cash.OpenShiftResponse], error)
+	// Counts the drawer out and reports expected against counted cash.
+	CloseShift(context.Context, *connect.Request[cash.CloseShiftRequest]) (*connect.Response[cash.CloseShiftResponse], error)
+	// Paid-in and paid-out entries: cash added to or taken from the drawer
+	// outside of sales.
+	RecordCashMovement(context.Context, *connect.Request[cash.RecordCashMovementRequest]) (*connect.Response[cash.RecordCashMovementResponse], error)
+	GetShiftReport(context.Context, *connect.Request[cash.GetShiftReportRequest]) (*connect.Response[cash.GetShiftReportResponse], error)
+}
+
+// NewCashServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCashServiceHandler(svc CashServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	cashServiceMethods := cash.File_cash_cash_proto.Services().ByName("CashService").Methods()
+	cashServiceListCashDrawersHandler := connect.NewUnaryHandler(
+		CashServiceListCashDrawersProcedure,
+		svc.ListCashDrawers,
+		connect.WithSchema(cashServiceMethods.ByName("ListCashDrawers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	cashServiceOpenShiftHandler := connect.NewUnaryHandler(
+		CashServiceOpenShiftProcedure,
+		svc.OpenShift,
+		connect.WithSchema(cashServiceMethods.ByName("OpenShift")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cashServiceCloseShiftHandler := connect.NewUnaryHandler(
+		CashServiceCloseShiftProcedure,
+		svc.CloseShift,
+		connect.WithSchema(cashServiceMethods.ByName("CloseShift")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cashServiceRecordCashMovementHandler := connect.NewUnaryHandler(
+		CashServiceRecordCashMovementProcedure,
+		svc.RecordCashMovement,
+		connect.WithSchema(cashServiceMethods.ByName("RecordCashMovement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	cashServiceGetShiftReportHandler := connect.NewUnaryHandler(
+		CashServiceGetShiftReportProcedure,
+		svc.GetShiftReport,
+		connect.WithSchema(cashServiceMethods.ByName("GetShiftReport")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/cash.CashService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CashServiceListCashDrawersProcedure:
+			cashServiceListCashDrawersHandler.ServeHTTP(w, r)
+		case CashServiceOpenShiftProcedure:
+			cashServiceOpenShiftHandler.ServeHTTP(w, r)
+		case CashServiceCloseShiftProcedure:
+			cashServiceCloseShiftHandler.ServeHTTP(w, r)
+		case CashServiceRecordCashMovementProcedure:
+			cashServiceRecordCashMovementHandler.ServeHTTP(w, r)
+		case CashServiceGetShiftReportProcedure:
+			cashServiceGetShiftReportHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCashServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCashServiceHandler struct{}
+
+func (UnimplementedCashServiceHandler) ListCashDrawers(context.Context, *connect.Request[cash.ListCashDrawersRequest]) (*connect.Response[cash.ListCashDrawersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cash.CashService.ListCashDrawers is not implemented"))
+}
+
+func (UnimplementedCashServiceHandler) OpenShift(context.Context, *connect.Request[cash.OpenShiftRequest]) (*connect.Response[cash.OpenShiftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cash.CashService.OpenShift is not implemented"))
+}
+
+func (UnimplementedCashServiceHandler) CloseShift(context.Context, *connect.Request[cash.CloseShiftRequest]) (*connect.Response[cash.CloseShiftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cash.CashService.CloseShift is not implemented"))
+}
+
+func (UnimplementedCashServiceHandler) RecordCashMovement(context.Context, *connect.Request[cash.RecordCashMovementRequest]) (*connect.Response[cash.RecordCashMovementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cash.CashService.RecordCashMovement is not implemented"))
+}
+
+func (UnimplementedCashServiceHandler) GetShiftReport(context.Context, *connect.Request[cash.GetShiftReportRequest]) (*connect.Response[cash.GetShiftReportResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("cash.CashService.GetShiftReport is not implemented"))
+}
@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: giftcard/giftcard.proto
+
+package giftcard
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GiftCard struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	BalanceCents  int64                  `protobuf:"varint,3,opt,name=balance_cents,json=balanceCents,proto3" json:"balance_cents,omitempty"`
+	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftCard) Reset() {
+	*x = GiftCard{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCard) ProtoMessage() {}
+
+func (x *GiftCard) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCard.ProtoReflect.Descriptor instead.
+func (*GiftCard) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GiftCard) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GiftCard) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GiftCard) GetBalanceCents() int64 {
+	if x != nil {
+		return x.BalanceCents
+	}
+	return 0
+}
+
+func (x *GiftCard) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *GiftCard) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GiftCardTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISSUE, REDEEM or REFUND
+	Kind          string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	AmountCents   int64  `protobuf:"varint,2,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	DebitAccount  string `protobuf:"bytes,3,opt,name=debit_account,json=debitAccount,proto3" json:"debit_account,omitempty"`
+	CreditAccount string `protobuf:"bytes,4,opt,name=credit_account,json=creditAccount,proto3" json:"credit_account,omitempty"`
+	// Empty for issuance.
+	OrderId       string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GiftCardTransaction) Reset() {
+	*x = GiftCardTransaction{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiftCardTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardTransaction) ProtoMessage() {}
+
+func (x *GiftCardTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardTransaction.ProtoReflect.Descriptor instead.
+func (*GiftCardTransaction) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GiftCardTransaction) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GiftCardTransaction) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *GiftCardTransaction) GetDebitAccount() string {
+	if x != nil {
+		return x.DebitAccount
+	}
+	return ""
+}
+
+func (x *GiftCardTransaction) GetCreditAccount() string {
+	if x != nil {
+		return x.CreditAccount
+	}
+	return ""
+}
+
+func (x *GiftCardTransaction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GiftCardTransaction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type IssueGiftCardRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AmountCents int64                  `protobuf:"varint,1,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// The shop currency when empty.
+	Currency      string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueGiftCardRequest) Reset() {
+	*x = IssueGiftCardRequest{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueGiftCardRequest) ProtoMessage() {}
+
+func (x *IssueGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*IssueGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IssueGiftCardRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *IssueGiftCardRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type IssueGiftCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiftCard      *GiftCard              `protobuf:"bytes,1,opt,name=gift_card,json=giftCard,proto3" json:"gift_card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueGiftCardResponse) Reset() {
+	*x = IssueGiftCardResponse{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueGiftCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueGiftCardResponse) ProtoMessage() {}
+
+func (x *IssueGiftCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueGiftCardResponse.ProtoReflect.Descriptor instead.
+func (*IssueGiftCardResponse) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IssueGiftCardResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+type GetGiftCardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftCardRequest) Reset() {
+	*x = GetGiftCardRequest{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardRequest) ProtoMessage() {}
+
+func (x *GetGiftCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardRequest.ProtoReflect.Descriptor instead.
+func (*GetGiftCardRequest) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetGiftCardRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetGiftCardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GiftCard      *GiftCard              `protobuf:"bytes,1,opt,name=gift_card,json=giftCard,proto3" json:"gift_card,omitempty"`
+	Transactions  []*GiftCardTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGiftCardResponse) Reset() {
+	*x = GetGiftCardResponse{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGiftCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGiftCardResponse) ProtoMessage() {}
+
+func (x *GetGiftCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGiftCardResponse.ProtoReflect.Descriptor instead.
+func (*GetGiftCardResponse) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetGiftCardResponse) GetGiftCard() *GiftCard {
+	if x != nil {
+		return x.GiftCard
+	}
+	return nil
+}
+
+func (x *GetGiftCardResponse) GetTransactions() []*GiftCardTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type CheckGiftCardLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckGiftCardLedgerRequest) Reset() {
+	*x = CheckGiftCardLedgerRequest{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGiftCardLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGiftCardLedgerRequest) ProtoMessage() {}
+
+func (x *CheckGiftCardLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGiftCardLedgerRequest.ProtoReflect.Descriptor instead.
+func (*CheckGiftCardLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{6}
+}
+
+type CheckGiftCardLedgerResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Consistent bool                   `protobuf:"varint,1,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	// One line per problem found.
+	Problems []string `protobuf:"bytes,2,rep,name=problems,proto3" json:"problems,omitempty"`
+	// Money still owed to card holders.
+	OutstandingCents int64 `protobuf:"varint,3,opt,name=outstanding_cents,json=outstandingCents,proto3" json:"outstanding_cents,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CheckGiftCardLedgerResponse) Reset() {
+	*x = CheckGiftCardLedgerResponse{}
+	mi := &file_giftcard_giftcard_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckGiftCardLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGiftCardLedgerResponse) ProtoMessage() {}
+
+func (x *CheckGiftCardLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_giftcard_giftcard_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGiftCardLedgerResponse.ProtoReflect.Descriptor instead.
+func (*CheckGiftCardLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_giftcard_giftcard_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckGiftCardLedgerResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *CheckGiftCardLedgerResponse) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+func (x *CheckGiftCardLedgerResponse) GetOutstandingCents() int64 {
+	if x != nil {
+		return x.OutstandingCents
+	}
+	return 0
+}
+
+var File_giftcard_giftcard_proto protoreflect.FileDescriptor
+
+const file_giftcard_giftcard_proto_rawDesc = "" +
+	"\n" +
+	"\x17giftcard/giftcard.proto\x12\bgiftcard\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x01\n" +
+	"\bGiftCard\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12#\n" +
+	"\rbalance_cents\x18\x03 \x01(\x03R\fbalanceCents\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xee\x01\n" +
+	"\x13GiftCardTransaction\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12!\n" +
+	"\famount_cents\x18\x02 \x01(\x03R\vamountCents\x12#\n" +
+	"\rdebit_account\x18\x03 \x01(\tR\fdebitAccount\x12%\n" +
+	"\x0ecredit_account\x18\x04 \x01(\tR\rcreditAccount\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"x\n" +
+	"\x14IssueGiftCardRequest\x12.\n" +
+	"\famount_cents\x18\x01 \x01(\x03B\v\xbaH\b\"\x06\x18\xa0\x8d\x06 \x00R\vamountCents\x120\n" +
+	"\bcurrency\x18\x02 \x01(\tB\x14\xbaH\x11r\x0f2\r^([A-Z]{3})?$R\bcurrency\"H\n" +
+	"\x15IssueGiftCardResponse\x12/\n" +
+	"\tgift_card\x18\x01 \x01(\v2\x12.giftcard.GiftCardR\bgiftCard\"1\n" +
+	"\x12GetGiftCardRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\"\x89\x01\n" +
+	"\x13GetGiftCardResponse\x12/\n" +
+	"\tgift_card\x18\x01 \x01(\v2\x12.giftcard.GiftCardR\bgiftCard\x12A\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x1d.giftcard.GiftCardTransactionR\ftransactions\"\x1c\n" +
+	"\x1aCheckGiftCardLedgerRequest\"\x86\x01\n" +
+	"\x1bCheckGiftCardLedgerResponse\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x01 \x01(\bR\n" +
+	"consistent\x12\x1a\n" +
+	"\bproblems\x18\x02 \x03(\tR\bproblems\x12+\n" +
+	"\x11outstanding_cents\x18\x03 \x01(\x03R\x10outstandingCents2\x9d\x02\n" +
+	"\x0fGiftCardService\x12P\n" +
+	"\rIssueGiftCard\x12\x1e.giftcard.IssueGiftCardRequest\x1a\x1f.giftcard.IssueGiftCardResponse\x12O\n" +
+	"\vGetGiftCard\x12\x1c.giftcard.GetGiftCardRequest\x1a\x1d.giftcard.GetGiftCardResponse\"\x03\x90\x02\x01\x12g\n" +
+	"\x13CheckGiftCardLedger\x12$.giftcard.CheckGiftCardLedgerRequest\x1a%.giftcard.CheckGiftCardLedgerResponse\"\x03\x90\x02\x01B\x8b\x01\n" +
+	"\fcom.giftcardB\rGiftcardProtoP\x01Z,github.com/jany/my-coffee/gen/proto/giftcard\xa2\x02\x03GXX\xaa\x02\bGiftcard\xca\x02\bGiftcard\xe2\x02\x14Giftcard\\GPBMetadata\xea\x02\bGiftcardb\x06proto3"
+
+var (
+	file_giftcard_giftcard_proto_rawDescOnce sync.Once
+	file_giftcard_giftcard_proto_rawDescData []byte
+)
+
+func file_giftcard_giftcard_proto_rawDescGZIP() []byte {
+	file_giftcard_giftcard_proto_rawDescOnce.Do(func() {
+		file_giftcard_giftcard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_giftcard_giftcard_proto_rawDesc), len(file_giftcard_giftcard_proto_rawDesc)))
+	})
+	return file_giftcard_giftcard_proto_rawDescData
+}
+
+var file_giftcard_giftcard_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_giftcard_giftcard_proto_goTypes = []any{
+	(*GiftCard)(nil),                    // 0: giftcard.GiftCard
+	(*GiftCardTransaction)(nil),         // 1: giftcard.GiftCardTransaction
+	(*IssueGiftCardRequest)(nil),        // 2: giftcard.IssueGiftCardRequest
+	(*IssueGiftCardResponse)(nil),       // 3: giftcard.IssueGiftCardResponse
+	(*GetGiftCardRequest)(nil),          // 4: giftcard.GetGiftCardRequest
+	(*GetGiftCardResponse)(nil),         // 5: giftcard.GetGiftCardResponse
+	(*CheckGiftCardLedgerRequest)(nil),  // 6: giftcard.CheckGiftCardLedgerRequest
+	(*CheckGiftCardLedgerResponse)(nil), // 7: giftcard.CheckGiftCardLedgerResponse
+	(*timestamppb.Timestamp)(nil),       // 8: google.protobuf.Timestamp
+}
+var file_giftcard_giftcard_proto_depIdxs = []int32{
+	8, // 0: giftcard.GiftCard.created_at:type_name -> google.protobuf.Timestamp
+	8, // 1: giftcard.GiftCardTransaction.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: giftcard.IssueGiftCardResponse.gift_card:type_name -> giftcard.GiftCard
+	0, // 3: giftcard.GetGiftCardResponse.gift_card:type_name -> giftcard.GiftCard
+	1, // 4: giftcard.GetGiftCardResponse.transactions:type_name -> giftcard.GiftCardTransaction
+	2, // 5: giftcard.GiftCardService.IssueGiftCard:input_type -> giftcard.IssueGiftCardRequest
+	4, // 6: giftcard.GiftCardService.GetGiftCard:input_type -> giftcard.GetGiftCardRequest
+	6, // 7: giftcard.GiftCardService.CheckGiftCardLedger:input_type -> giftcard.CheckGiftCardLedgerRequest
+	3, // 8: giftcard.GiftCardService.IssueGiftCard:output_type -> giftcard.IssueGiftCardResponse
+	5, // 9: giftcard.GiftCardService.GetGiftCard:output_type -> giftcard.GetGiftCardResponse
+	7, // 10: giftcard.GiftCardService.CheckGiftCardLedger:output_type -> giftcard.CheckGiftCardLedgerResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_giftcard_giftcard_proto_init() }
+func file_giftcard_giftcard_proto_init() {
+	if File_giftcard_giftcard_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_giftcard_giftcard_proto_rawDesc), len(file_giftcard_giftcard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_giftcard_giftcard_proto_goTypes,
+		DependencyIndexes: file_giftcard_giftcard_proto_depIdxs,
+		MessageInfos:      file_giftcard_giftcard_proto_msgTypes,
+	}.Build()
+	File_giftcard_giftcard_proto = out.File
+	file_giftcard_giftcard_proto_goTypes = nil
+	file_giftcard_giftcard_proto_depIdxs = nil
+}
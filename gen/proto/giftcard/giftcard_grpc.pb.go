@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: giftcard/giftcard.proto
+
+package giftcard
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GiftCardService_IssueGiftCard_FullMethodName       = "/giftcard.GiftCardService/IssueGiftCard"
+	GiftCardService_GetGiftCard_FullMethodName         = "/giftcard.GiftCardService/GetGiftCard"
+	GiftCardService_CheckGiftCardLedger_FullMethodName = "/giftcard.GiftCardService/CheckGiftCardLedger"
+)
+
+// GiftCardServiceClient is the client API for GiftCardService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// GiftCardService is hosted by brewsvc. Cards are spent through
+// brew.PayOrderRequest.gift_card_code.
+type GiftCardServiceClient interface {
+	IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*IssueGiftCardResponse, error)
+	GetGiftCard(ctx context.Context, in *GetGiftCardRequest, opts ...grpc.CallOption) (*GetGiftCardResponse, error)
+	// Compares every card's balance with its ledger account and checks that
+	// the ledger balances.
+	CheckGiftCardLedger(ctx context.Context, in *CheckGiftCardLedgerRequest, opts ...grpc.CallOption) (*CheckGiftCardLedgerResponse, error)
+}
+
+type giftCardServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGiftCardServiceClient(cc grpc.ClientConnInterface) GiftCardServiceClient {
+	return &giftCardServiceClient{cc}
+}
+
+func (c *giftCardServiceClient) IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*IssueGiftCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueGiftCardResponse)
+	err := c.cc.Invoke(ctx, GiftCardService_IssueGiftCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftCardServiceClient) GetGiftCard(ctx context.Context, in *GetGiftCardRequest, opts ...grpc.CallOption) (*GetGiftCardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGiftCardResponse)
+	err := c.cc.Invoke(ctx, GiftCardService_GetGiftCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *giftCardServiceClient) CheckGiftCardLedger(ctx context.Context, in *CheckGiftCardLedgerRequest, opts ...grpc.CallOption) (*CheckGiftCardLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckGiftCardLedgerResponse)
+	err := c.cc.Invoke(ctx, GiftCardService_CheckGiftCardLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GiftCardServiceServer is the server API for GiftCardService service.
+// All implementations must embed UnimplementedGiftCardServiceServer
+// for forward compatibility.
+//
+// GiftCardService is hosted by brewsvc. Cards are spent through
+// brew.PayOrderRequest.gift_card_code.
+type GiftCardServiceServer interface {
+	IssueGiftCard(context.Context, *IssueGiftCardRequest) (*IssueGiftCardResponse, error)
+	GetGiftCard(context.Context, *GetGiftCardRequest) (*GetGiftCardResponse, error)
+	// Compares every card's balance with its ledger account and checks that
+	// the ledger balances.
+	CheckGiftCardLedger(context.Context, *CheckGiftCardLedgerRequest) (*CheckGiftCardLedgerResponse, error)
+	mustEmbedUnimplementedGiftCardServiceServer()
+}
+
+// UnimplementedGiftCardServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGiftCardServiceServer struct{}
+
+func (UnimplementedGiftCardServiceServer) IssueGiftCard(context.Context, *IssueGiftCardRequest) (*IssueGiftCardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueGiftCard not implemented")
+}
+func (UnimplementedGiftCardServiceServer) GetGiftCard(context.Context, *GetGiftCardRequest) (*GetGiftCardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGiftCard not implemented")
+}
+func (UnimplementedGiftCardServiceServer) CheckGiftCardLedger(context.Context, *CheckGiftCardLedgerRequest) (*CheckGiftCardLedgerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckGiftCardLedger not implemented")
+}
+func (UnimplementedGiftCardServiceServer) mustEmbedUnimplementedGiftCardServiceServer() {}
+func (UnimplementedGiftCardServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeGiftCardServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GiftCardServiceServer will
+// result in compilation errors.
+type UnsafeGiftCardServiceServer interface {
+	mustEmbedUnimplementedGiftCardServiceServer()
+}
+
+func RegisterGiftCardServiceServer(s grpc.ServiceRegistrar, srv GiftCardServiceServer) {
+	// If the following call panics, it indicates UnimplementedGiftCardServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GiftCardService_ServiceDesc, srv)
+}
+
+func _GiftCardService_IssueGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftCardServiceServer).IssueGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftCardService_IssueGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftCardServiceServer).IssueGiftCard(ctx, req.(*IssueGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftCardService_GetGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftCardServiceServer).GetGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftCardService_GetGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftCardServiceServer).GetGiftCard(ctx, req.(*GetGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GiftCardService_CheckGiftCardLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckGiftCardLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GiftCardServiceServer).CheckGiftCardLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GiftCardService_CheckGiftCardLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GiftCardServiceServer).CheckGiftCardLedger(ctx, req.(*CheckGiftCardLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GiftCardService_ServiceDesc is the grpc.ServiceDesc for GiftCardService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GiftCardService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "giftcard.GiftCardService",
+	HandlerType: (*GiftCardServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "IssueGiftCard",
+			Handler:    _GiftCardService_IssueGiftCard_Handler,
+		},
+		{
+			MethodName: "GetGiftCard",
+			Handler:    _GiftCardService_GetGiftCard_Handler,
+		},
+		{
+			MethodName: "CheckGiftCardLedger",
+			Handler:    _GiftCardService_CheckGiftCardLedger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "giftcard/giftcard.proto",
+}
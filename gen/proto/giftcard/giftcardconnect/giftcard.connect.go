@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: giftcard/giftcard.proto
+
+package giftcardconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	giftcard "github.com/jany/my-coffee/gen/proto/giftcard"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GiftCardServiceName is the fully-qualified name of the GiftCardService service.
+	GiftCardServiceName = "giftcard.GiftCardService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GiftCardServiceIssueGiftCardProcedure is the fully-qualified name of the GiftCardService's
+	// IssueGiftCard RPC.
+	GiftCardServiceIssueGiftCardProcedure = "/giftcard.GiftCardService/IssueGiftCard"
+	// GiftCardServiceGetGiftCardProcedure is the fully-qualified name of the GiftCardService's
+	// GetGiftCard RPC.
+	GiftCardServiceGetGiftCardProcedure = "/giftcard.GiftCardService/GetGiftCard"
+	// GiftCardServiceCheckGiftCardLedgerProcedure is the fully-qualified name of the GiftCardService's
+	// CheckGiftCardLedger RPC.
+	GiftCardServiceCheckGiftCardLedgerProcedure = "/giftcard.GiftCardService/CheckGiftCardLedger"
+)
+
+// GiftCardServiceClient is a client for the giftcard.GiftCardService service.
+type GiftCardServiceClient interface {
+	IssueGiftCard(context.Context, *connect.Request[giftcard.IssueGiftCardRequest]) (*connect.Response[giftcard.IssueGiftCardResponse], error)
+	GetGiftCard(context.Context, *connect.Request[giftcard.GetGiftCardRequest]) (*connect.Response[giftcard.GetGiftCardResponse], error)
+	// Compares every card's balance with its ledger account and checks that
+	// the ledger balances.
+	CheckGiftCardLedger(context.Context, *connect.Request[giftcard.CheckGiftCardLedgerRequest]) (*connect.Response[giftcard.CheckGiftCardLedgerResponse], error)
+}
+
+// NewGiftCardServiceClient constructs a client for the giftcard.GiftCardService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGiftCardServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GiftCardServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	giftCardServiceMethods := giftcard.File_giftcard_giftcard_proto.Services().ByName("GiftCardService").Methods()
+	return &giftCardServiceClient{
+		issueGiftCard: connect.NewClient[giftcard.IssueGiftCardRequest, giftcard.IssueGiftCardResponse](
+			httpClient,
+			baseURL+GiftCardServiceIssueGiftCardProcedure,
+			connect.WithSchema(giftCardServiceMethods.ByName("IssueGiftCard")),
+			connect.WithClientOptions(opts...),
+		),
+		getGiftCard: connect.NewClient[giftcard.GetGiftCardRequest, giftcard.GetGiftCardResponse](
+			httpClient,
+			baseURL+GiftCardServiceGetGiftCardProcedure,
+			connect.WithSchema(giftCardServiceMethods.ByName("GetGiftCard")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		checkGiftCardLedger: connect.NewClient[giftcard.CheckGiftCardLedgerRequest, giftcard.CheckGiftCardLedgerResponse](
+			httpClient,
+			baseURL+GiftCardServiceCheckGiftCardLedgerProcedure,
+			connect.WithSchema(giftCardServiceMethods.ByName("CheckGiftCardLedger")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// giftCardServiceClient implements GiftCardServiceClient.
+type giftCardServiceClient struct {
+	issueGiftCard       *connect.Client[giftcard.IssueGiftCardRequest, giftcard.IssueGiftCardResponse]
+	getGiftCard         *connect.Client[giftcard.GetGiftCardRequest, giftcard.GetGiftCardResponse]
+	checkGiftCardLedger *connect.Client[giftcard.CheckGiftCardLedgerRequest, giftcard.CheckGiftCardLedgerResponse]
+}
+
+// IssueGiftCard calls giftcard.GiftCardService.IssueGiftCard.
+func (c *giftCardServiceClient) IssueGiftCard(ctx context.Context, req *connect.Request[giftcard.IssueGiftCardRequest]) (*connect.Response[giftcard.IssueGiftCardResponse], error) {
+	return c.issueGiftCard.CallUnary(ctx, req)
+}
+
+// GetGiftCard calls giftcard.GiftCardService.GetGiftCard.
+func (c *giftCardServiceClient) GetGiftCard(ctx context.Context, req *connect.Request[giftcard.GetGiftCardRequest]) (*connect.Response[giftcard.GetGiftCardResponse], error) {
+	return c.getGiftCard.CallUnary(ctx, req)
+}
+
+// CheckGiftCardLedger calls giftcard.GiftCardService.CheckGiftCardLedger.
+func (c *giftCardServiceClient) CheckGiftCardLedger(ctx context.Context, req *connect.Request[giftcard.CheckGiftCardLedgerRequest]) (*connect.Response[giftcard.CheckGiftCardLedgerResponse], error) {
+	return c.checkGiftCardLedger.CallUnary(ctx, req)
+}
+
+// GiftCardServiceHandler is an implementation of the giftcard.GiftCardService service.
+type GiftCardServiceHandler interface {
+	IssueGiftCard(context.Context, *connect.Request[giftcard.IssueGiftCardRequest]) (*connect.Response[giftcard.IssueGiftCardResponse], error)
+	GetGiftCard(context.Context, *connect.Request[giftcard.GetGiftCardRequest]) (*connect.Response[giftcard.GetGiftCardResponse], error)
+	// Compares every card's balance with its ledger account and checks that
+	// the ledger balances.
+	CheckGiftCardLedger(context.Context, *connect.Request[giftcard.CheckGiftCardLedgerRequest]) (*connect.Response[giftcard.CheckGiftCardLedgerResponse], error)
+}
+
+// NewGiftCardServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGiftCardServiceHandler(svc GiftCardServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	giftCardServiceMethods := giftcard.File_giftcard_giftcard_proto.Services().ByName("GiftCardService").Methods()
+	giftCardServiceIssueGiftCardHandler := connect.NewUnaryHandler(
+		GiftCardServiceIssueGiftCardProcedure,
+		svc.IssueGiftCard,
+		connect.WithSchema(giftCardServiceMethods.ByName("IssueGiftCard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	giftCardServiceGetGiftCardHandler := connect.NewUnaryHandler(
+		GiftCardServiceGetGiftCardProcedure,
+		svc.GetGiftCard,
+		connect.WithSchema(giftCardServiceMethods.ByName("GetGiftCard")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	giftCardServiceCheckGiftCardLedgerHandler := connect.NewUnaryHandler(
+		GiftCardServiceCheckGiftCardLedgerProcedure,
+		svc.CheckGiftCardLedger,
+		connect.WithSchema(giftCardServiceMethods.ByName("CheckGiftCardLedger")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/giftcard.GiftCardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GiftCardServiceIssueGiftCardProcedure:
+			giftCardServiceIssueGiftCardHandler.ServeHTTP(w, r)
+		case GiftCardServiceGetGiftCardProcedure:
+			giftCardServiceGetGiftCardHandler.ServeHTTP(w, r)
+		case GiftCardServiceCheckGiftCardLedgerProcedure:
+			giftCardServiceCheckGiftCardLedgerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGiftCardServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGiftCardServiceHandler struct{}
+
+func (UnimplementedGiftCardServiceHandler) IssueGiftCard(context.Context, *connect.Request[giftcard.IssueGiftCardRequest]) (*connect.Response[giftcard.IssueGiftCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("giftcard.GiftCardService.IssueGiftCard is not implemented"))
+}
+
+func (UnimplementedGiftCardServiceHandler) GetGiftCard(context.Context, *connect.Request[giftcard.GetGiftCardRequest]) (*connect.Response[giftcard.GetGiftCardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("giftcard.GiftCardService.GetGiftCard is not implemented"))
+}
+
+func (UnimplementedGiftCardServiceHandler) CheckGiftCardLedger(context.Context, *connect.Request[giftcard.CheckGiftCardLedgerRequest]) (*connect.Response[giftcard.CheckGiftCardLedgerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("giftcard.GiftCardService.CheckGiftCardLedger is not implemented"))
+}
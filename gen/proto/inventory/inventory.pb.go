@@ -0,0 +1,858 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: inventory/inventory.proto
+
+package inventory
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Ingredient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// g, ml or pcs
+	Unit             string `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"`
+	Stock            int64  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	ReorderThreshold int64  `protobuf:"varint,5,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	PackSize         int64  `protobuf:"varint,6,opt,name=pack_size,json=packSize,proto3" json:"pack_size,omitempty"`
+	Supplier         string `protobuf:"bytes,7,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Low              bool   `protobuf:"varint,8,opt,name=low,proto3" json:"low,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_inventory_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Ingredient) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Ingredient) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *Ingredient) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Ingredient) GetPackSize() int64 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+func (x *Ingredient) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *Ingredient) GetLow() bool {
+	if x != nil {
+		return x.Low
+	}
+	return false
+}
+
+type ListIngredientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsRequest) Reset() {
+	*x = ListIngredientsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsRequest) ProtoMessage() {}
+
+func (x *ListIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+type ListIngredientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredients   []*Ingredient          `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsResponse) Reset() {
+	*x = ListIngredientsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsResponse) ProtoMessage() {}
+
+func (x *ListIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListIngredientsResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type RestockIngredientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockIngredientRequest) Reset() {
+	*x = RestockIngredientRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockIngredientRequest) ProtoMessage() {}
+
+func (x *RestockIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockIngredientRequest.ProtoReflect.Descriptor instead.
+func (*RestockIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RestockIngredientRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RestockIngredientRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RestockIngredientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient    *Ingredient            `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockIngredientResponse) Reset() {
+	*x = RestockIngredientResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockIngredientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockIngredientResponse) ProtoMessage() {}
+
+func (x *RestockIngredientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockIngredientResponse.ProtoReflect.Descriptor instead.
+func (*RestockIngredientResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RestockIngredientResponse) GetIngredient() *Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+type SetReorderSettingsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Code             string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ReorderThreshold int64                  `protobuf:"varint,2,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	PackSize         int64                  `protobuf:"varint,3,opt,name=pack_size,json=packSize,proto3" json:"pack_size,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetReorderSettingsRequest) Reset() {
+	*x = SetReorderSettingsRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderSettingsRequest) ProtoMessage() {}
+
+func (x *SetReorderSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetReorderSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetReorderSettingsRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetReorderSettingsRequest) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *SetReorderSettingsRequest) GetPackSize() int64 {
+	if x != nil {
+		return x.PackSize
+	}
+	return 0
+}
+
+type SetReorderSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient    *Ingredient            `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReorderSettingsResponse) Reset() {
+	*x = SetReorderSettingsResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderSettingsResponse) ProtoMessage() {}
+
+func (x *SetReorderSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetReorderSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetReorderSettingsResponse) GetIngredient() *Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+type ListLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockRequest) Reset() {
+	*x = ListLowStockRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockRequest) ProtoMessage() {}
+
+func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+type ListLowStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredients   []*Ingredient          `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockResponse) Reset() {
+	*x = ListLowStockResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockResponse) ProtoMessage() {}
+
+func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListLowStockResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type GetPurchaseListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Days of order history used to estimate daily consumption, 7 when zero.
+	LookbackDays int32 `protobuf:"varint,1,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`
+	// Days the delivery should last, 7 when zero.
+	CoverDays     int32 `protobuf:"varint,2,opt,name=cover_days,json=coverDays,proto3" json:"cover_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseListRequest) Reset() {
+	*x = GetPurchaseListRequest{}
+	mi := &file_inventory_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseListRequest) ProtoMessage() {}
+
+func (x *GetPurchaseListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseListRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseListRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPurchaseListRequest) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+func (x *GetPurchaseListRequest) GetCoverDays() int32 {
+	if x != nil {
+		return x.CoverDays
+	}
+	return 0
+}
+
+type PurchaseLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient       *Ingredient            `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	DailyConsumption float64                `protobuf:"fixed64,2,opt,name=daily_consumption,json=dailyConsumption,proto3" json:"daily_consumption,omitempty"`
+	// Suggested quantity in the ingredient's unit, a multiple of pack_size.
+	Quantity      int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Packs         int64 `protobuf:"varint,4,opt,name=packs,proto3" json:"packs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseLine) Reset() {
+	*x = PurchaseLine{}
+	mi := &file_inventory_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseLine) ProtoMessage() {}
+
+func (x *PurchaseLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseLine.ProtoReflect.Descriptor instead.
+func (*PurchaseLine) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PurchaseLine) GetIngredient() *Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *PurchaseLine) GetDailyConsumption() float64 {
+	if x != nil {
+		return x.DailyConsumption
+	}
+	return 0
+}
+
+func (x *PurchaseLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseLine) GetPacks() int64 {
+	if x != nil {
+		return x.Packs
+	}
+	return 0
+}
+
+type SupplierOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Lines         []*PurchaseLine        `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierOrder) Reset() {
+	*x = SupplierOrder{}
+	mi := &file_inventory_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierOrder) ProtoMessage() {}
+
+func (x *SupplierOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierOrder.ProtoReflect.Descriptor instead.
+func (*SupplierOrder) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SupplierOrder) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *SupplierOrder) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SupplierOrder) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SupplierOrder) GetLines() []*PurchaseLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetPurchaseListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*SupplierOrder       `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseListResponse) Reset() {
+	*x = GetPurchaseListResponse{}
+	mi := &file_inventory_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseListResponse) ProtoMessage() {}
+
+func (x *GetPurchaseListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseListResponse.ProtoReflect.Descriptor instead.
+func (*GetPurchaseListResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPurchaseListResponse) GetSuppliers() []*SupplierOrder {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+var File_inventory_inventory_proto protoreflect.FileDescriptor
+
+const file_inventory_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x19inventory/inventory.proto\x12\tinventory\x1a\x1bbuf/validate/validate.proto\"\xd6\x01\n" +
+	"\n" +
+	"Ingredient\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x03R\x05stock\x12+\n" +
+	"\x11reorder_threshold\x18\x05 \x01(\x03R\x10reorderThreshold\x12\x1b\n" +
+	"\tpack_size\x18\x06 \x01(\x03R\bpackSize\x12\x1a\n" +
+	"\bsupplier\x18\a \x01(\tR\bsupplier\x12\x10\n" +
+	"\x03low\x18\b \x01(\bR\x03low\"\x18\n" +
+	"\x16ListIngredientsRequest\"R\n" +
+	"\x17ListIngredientsResponse\x127\n" +
+	"\vingredients\x18\x01 \x03(\v2\x15.inventory.IngredientR\vingredients\"\\\n" +
+	"\x18RestockIngredientRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bquantity\"R\n" +
+	"\x19RestockIngredientResponse\x125\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x15.inventory.IngredientR\n" +
+	"ingredient\"\x94\x01\n" +
+	"\x19SetReorderSettingsRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\x124\n" +
+	"\x11reorder_threshold\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x10reorderThreshold\x12$\n" +
+	"\tpack_size\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\bpackSize\"S\n" +
+	"\x1aSetReorderSettingsResponse\x125\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x15.inventory.IngredientR\n" +
+	"ingredient\"\x15\n" +
+	"\x13ListLowStockRequest\"O\n" +
+	"\x14ListLowStockResponse\x127\n" +
+	"\vingredients\x18\x01 \x03(\v2\x15.inventory.IngredientR\vingredients\"r\n" +
+	"\x16GetPurchaseListRequest\x12.\n" +
+	"\rlookback_days\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18Z(\x00R\flookbackDays\x12(\n" +
+	"\n" +
+	"cover_days\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18<(\x00R\tcoverDays\"\xa4\x01\n" +
+	"\fPurchaseLine\x125\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x15.inventory.IngredientR\n" +
+	"ingredient\x12+\n" +
+	"\x11daily_consumption\x18\x02 \x01(\x01R\x10dailyConsumption\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x14\n" +
+	"\x05packs\x18\x04 \x01(\x03R\x05packs\"\x86\x01\n" +
+	"\rSupplierOrder\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12-\n" +
+	"\x05lines\x18\x04 \x03(\v2\x17.inventory.PurchaseLineR\x05lines\"Q\n" +
+	"\x17GetPurchaseListResponse\x126\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x18.inventory.SupplierOrderR\tsuppliers2\xda\x03\n" +
+	"\x10InventoryService\x12X\n" +
+	"\x0fListIngredients\x12!.inventory.ListIngredientsRequest\x1a\".inventory.ListIngredientsResponse\x12^\n" +
+	"\x11RestockIngredient\x12#.inventory.RestockIngredientRequest\x1a$.inventory.RestockIngredientResponse\x12a\n" +
+	"\x12SetReorderSettings\x12$.inventory.SetReorderSettingsRequest\x1a%.inventory.SetReorderSettingsResponse\x12O\n" +
+	"\fListLowStock\x12\x1e.inventory.ListLowStockRequest\x1a\x1f.inventory.ListLowStockResponse\x12X\n" +
+	"\x0fGetPurchaseList\x12!.inventory.GetPurchaseListRequest\x1a\".inventory.GetPurchaseListResponseB\x92\x01\n" +
+	"\rcom.inventoryB\x0eInventoryProtoP\x01Z-github.com/jany/my-coffee/gen/proto/inventory\xa2\x02\x03IXX\xaa\x02\tInventory\xca\x02\tInventory\xe2\x02\x15Inventory\\GPBMetadata\xea\x02\tInventoryb\x06proto3"
+
+var (
+	file_inventory_inventory_proto_rawDescOnce sync.Once
+	file_inventory_inventory_proto_rawDescData []byte
+)
+
+func file_inventory_inventory_proto_rawDescGZIP() []byte {
+	file_inventory_inventory_proto_rawDescOnce.Do(func() {
+		file_inventory_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_inventory_inventory_proto_rawDesc), len(file_inventory_inventory_proto_rawDesc)))
+	})
+	return file_inventory_inventory_proto_rawDescData
+}
+
+var file_inventory_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_inventory_inventory_proto_goTypes = []any{
+	(*Ingredient)(nil),                 // 0: inventory.Ingredient
+	(*ListIngredientsRequest)(nil),     // 1: inventory.ListIngredientsRequest
+	(*ListIngredientsResponse)(nil),    // 2: inventory.ListIngredientsResponse
+	(*RestockIngredientRequest)(nil),   // 3: inventory.RestockIngredientRequest
+	(*RestockIngredientResponse)(nil),  // 4: inventory.RestockIngredientResponse
+	(*SetReorderSettingsRequest)(nil),  // 5: inventory.SetReorderSettingsRequest
+	(*SetReorderSettingsResponse)(nil), // 6: inventory.SetReorderSettingsResponse
+	(*ListLowStockRequest)(nil),        // 7: inventory.ListLowStockRequest
+	(*ListLowStockResponse)(nil),       // 8: inventory.ListLowStockResponse
+	(*GetPurchaseListRequest)(nil),     // 9: inventory.GetPurchaseListRequest
+	(*PurchaseLine)(nil),               // 10: inventory.PurchaseLine
+	(*SupplierOrder)(nil),              // 11: inventory.SupplierOrder
+	(*GetPurchaseListResponse)(nil),    // 12: inventory.GetPurchaseListResponse
+}
+var file_inventory_inventory_proto_depIdxs = []int32{
+	0,  // 0: inventory.ListIngredientsResponse.ingredients:type_name -> inventory.Ingredient
+	0,  // 1: inventory.RestockIngredientResponse.ingredient:type_name -> inventory.Ingredient
+	0,  // 2: inventory.SetReorderSettingsResponse.ingredient:type_name -> inventory.Ingredient
+	0,  // 3: inventory.ListLowStockResponse.ingredients:type_name -> inventory.Ingredient
+	0,  // 4: inventory.PurchaseLine.ingredient:type_name -> inventory.Ingredient
+	10, // 5: inventory.SupplierOrder.lines:type_name -> inventory.PurchaseLine
+	11, // 6: inventory.GetPurchaseListResponse.suppliers:type_name -> inventory.SupplierOrder
+	1,  // 7: inventory.InventoryService.ListIngredients:input_type -> inventory.ListIngredientsRequest
+	3,  // 8: inventory.InventoryService.RestockIngredient:input_type -> inventory.RestockIngredientRequest
+	5,  // 9: inventory.InventoryService.SetReorderSettings:input_type -> inventory.SetReorderSettingsRequest
+	7,  // 10: inventory.InventoryService.ListLowStock:input_type -> inventory.ListLowStockRequest
+	9,  // 11: inventory.InventoryService.GetPurchaseList:input_type -> inventory.GetPurchaseListRequest
+	2,  // 12: inventory.InventoryService.ListIngredients:output_type -> inventory.ListIngredientsResponse
+	4,  // 13: inventory.InventoryService.RestockIngredient:output_type -> inventory.RestockIngredientResponse
+	6,  // 14: inventory.InventoryService.SetReorderSettings:output_type -> inventory.SetReorderSettingsResponse
+	8,  // 15: inventory.InventoryService.ListLowStock:output_type -> inventory.ListLowStockResponse
+	12, // 16: inventory.InventoryService.GetPurchaseList:output_type -> inventory.GetPurchaseListResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_inventory_inventory_proto_init() }
+func file_inventory_inventory_proto_init() {
+	if File_inventory_inventory_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_inventory_proto_rawDesc), len(file_inventory_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_inventory_inventory_proto_goTypes,
+		DependencyIndexes: file_inventory_inventory_proto_depIdxs,
+		MessageInfos:      file_inventory_inventory_proto_msgTypes,
+	}.Build()
+	File_inventory_inventory_proto = out.File
+	file_inventory_inventory_proto_goTypes = nil
+	file_inventory_inventory_proto_depIdxs = nil
+}
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: inventory/inventory.proto
+
+package inventory
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InventoryService_ListIngredients_FullMethodName    = "/inventory.InventoryService/ListIngredients"
+	InventoryService_RestockIngredient_FullMethodName  = "/inventory.InventoryService/RestockIngredient"
+	InventoryService_SetReorderSettings_FullMethodName = "/inventory.InventoryService/SetReorderSettings"
+	InventoryService_ListLowStock_FullMethodName       = "/inventory.InventoryService/ListLowStock"
+	InventoryService_GetPurchaseList_FullMethodName    = "/inventory.InventoryService/GetPurchaseList"
+)
+
+// InventoryServiceClient is the client API for InventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InventoryService is hosted by brewsvc next to BrewService. Stock goes down
+// automatically when an order is placed.
+type InventoryServiceClient interface {
+	ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error)
+	RestockIngredient(ctx context.Context, in *RestockIngredientRequest, opts ...grpc.CallOption) (*RestockIngredientResponse, error)
+	SetReorderSettings(ctx context.Context, in *SetReorderSettingsRequest, opts ...grpc.CallOption) (*SetReorderSettingsResponse, error)
+	// Ingredients at or below their reorder threshold.
+	ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*ListLowStockResponse, error)
+	// What to buy from each supplier, based on recent consumption.
+	GetPurchaseList(ctx context.Context, in *GetPurchaseListRequest, opts ...grpc.CallOption) (*GetPurchaseListResponse, error)
+}
+
+type inventoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryServiceClient(cc grpc.ClientConnInterface) InventoryServiceClient {
+	return &inventoryServiceClient{cc}
+}
+
+func (c *inventoryServiceClient) ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RestockIngredient(ctx context.Context, in *RestockIngredientRequest, opts ...grpc.CallOption) (*RestockIngredientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestockIngredientResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RestockIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetReorderSettings(ctx context.Context, in *SetReorderSettingsRequest, opts ...grpc.CallOption) (*SetReorderSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReorderSettingsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetReorderSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*ListLowStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseList(ctx context.Context, in *GetPurchaseListRequest, opts ...grpc.CallOption) (*GetPurchaseListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPurchaseListResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+//
+// InventoryService is hosted by brewsvc next to BrewService. Stock goes down
+// automatically when an order is placed.
+type InventoryServiceServer interface {
+	ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error)
+	RestockIngredient(context.Context, *RestockIngredientRequest) (*RestockIngredientResponse, error)
+	SetReorderSettings(context.Context, *SetReorderSettingsRequest) (*SetReorderSettingsResponse, error)
+	// Ingredients at or below their reorder threshold.
+	ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error)
+	// What to buy from each supplier, based on recent consumption.
+	GetPurchaseList(context.Context, *GetPurchaseListRequest) (*GetPurchaseListResponse, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngredients not implemented")
+}
+func (UnimplementedInventoryServiceServer) RestockIngredient(context.Context, *RestockIngredientRequest) (*RestockIngredientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestockIngredient not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetReorderSettings(context.Context, *SetReorderSettingsRequest) (*SetReorderSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetReorderSettings not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLowStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseList(context.Context, *GetPurchaseListRequest) (*GetPurchaseListResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPurchaseList not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call panics, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_ListIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListIngredients(ctx, req.(*ListIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RestockIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RestockIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RestockIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RestockIngredient(ctx, req.(*RestockIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetReorderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReorderSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetReorderSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetReorderSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetReorderSettings(ctx, req.(*SetReorderSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLowStock(ctx, req.(*ListLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPurchaseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseList(ctx, req.(*GetPurchaseListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "inventory.InventoryService",
+	HandlerType: (*InventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListIngredients",
+			Handler:    _InventoryService_ListIngredients_Handler,
+		},
+		{
+			MethodName: "RestockIngredient",
+			Handler:    _InventoryService_RestockIngredient_Handler,
+		},
+		{
+			MethodName: "SetReorderSettings",
+			Handler:    _InventoryService_SetReorderSettings_Handler,
+		},
+		{
+			MethodName: "ListLowStock",
+			Handler:    _InventoryService_ListLowStock_Handler,
+		},
+		{
+			MethodName: "GetPurchaseList",
+			Handler:    _InventoryService_GetPurchaseList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inventory/inventory.proto",
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: inventory/inventory.proto
+
+package inventoryconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	inventory "github.com/jany/my-coffee/gen/proto/inventory"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// InventoryServiceName is the fully-qualified name of the InventoryService service.
+	InventoryServiceName = "inventory.InventoryService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// InventoryServiceListIngredientsProcedure is the fully-qualified name of the InventoryService's
+	// ListIngredients RPC.
+	InventoryServiceListIngredientsProcedure = "/inventory.InventoryService/ListIngredients"
+	// InventoryServiceRestockIngredientProcedure is the fully-qualified name of the InventoryService's
+	// RestockIngredient RPC.
+	InventoryServiceRestockIngredientProcedure = "/inventory.InventoryService/RestockIngredient"
+	// InventoryServiceSetReorderSettingsProcedure is the fully-qualified name of the InventoryService's
+	// SetReorderSettings RPC.
+	InventoryServiceSetReorderSettingsProcedure = "/inventory.InventoryService/SetReorderSettings"
+	// InventoryServiceListLowStockProcedure is the fully-qualified name of the InventoryService's
+	// ListLowStock RPC.
+	InventoryServiceListLowStockProcedure = "/inventory.InventoryService/ListLowStock"
+	// InventoryServiceGetPurchaseListProcedure is the fully-qualified name of the InventoryService's
+	// GetPurchaseList RPC.
+	InventoryServiceGetPurchaseListProcedure = "/inventory.InventoryService/GetPurchaseList"
+)
+
+// InventoryServiceClient is a client for the inventory.InventoryService service.
+type InventoryServiceClient interface {
+	ListIngredients(context.Context, *connect.Request[inventory.ListIngredientsRequest]) (*connect.Response[inventory.ListIngredientsResponse], error)
+	RestockIngredient(context.Context, *connect.Request[inventory.RestockIngredientRequest]) (*connect.Response[inventory.RestockIngredientResponse], error)
+	SetReorderSettings(context.Context, *connect.Request[inventory.SetReorderSettingsRequest]) (*connect.Response[inventory.SetReorderSettingsResponse], error)
+	// Ingredients at or below their reorder threshold.
+	ListLowStock(context.Context, *connect.Request[inventory.ListLowStockRequest]) (*connect.Response[inventory.ListLowStockResponse], error)
+	// What to buy from each supplier, based on recent consumption.
+	GetPurchaseList(context.Context, *connect.Request[inventory.GetPurchaseListRequest]) (*connect.Response[inventory.GetPurchaseListResponse], error)
+}
+
+// NewInventoryServiceClient constructs a client for the inventory.InventoryService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewInventoryServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) InventoryServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	inventoryServiceMethods := inventory.File_inventory_inventory_proto.Services().ByName("InventoryService").Methods()
+	return &inventoryServiceClient{
+		listIngredients: connect.NewClient[inventory.ListIngredientsRequest, inventory.ListIngredientsResponse](
+			httpClient,
+			baseURL+InventoryServiceListIngredientsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ListIngredients")),
+			connect.WithClientOptions(opts...),
+		),
+		restockIngredient: connect.NewClient[inventory.RestockIngredientRequest, inventory.RestockIngredientResponse](
+			httpClient,
+			baseURL+InventoryServiceRestockIngredientProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("RestockIngredient")),
+			connect.WithClientOptions(opts...),
+		),
+		setReorderSettings: connect.NewClient[inventory.SetReorderSettingsRequest, inventory.SetReorderSettingsResponse](
+			httpClient,
+			baseURL+InventoryServiceSetReorderSettingsProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("SetReorderSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		listLowStock: connect.NewClient[inventory.ListLowStockRequest, inventory.ListLowStockResponse](
+			httpClient,
+			baseURL+InventoryServiceListLowStockProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("ListLowStock")),
+			connect.WithClientOptions(opts...),
+		),
+		getPurchaseList: connect.NewClient[inventory.GetPurchaseListRequest, inventory.GetPurchaseListResponse](
+			httpClient,
+			baseURL+InventoryServiceGetPurchaseListProcedure,
+			connect.WithSchema(inventoryServiceMethods.ByName("GetPurchaseList")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// inventoryServiceClient implements InventoryServiceClient.
+type inventoryServiceClient struct {
+	listIngredients    *connect.Client[inventory.ListIngredientsRequest, inventory.ListIngredientsResponse]
+	restockIngredient  *connect.Client[inventory.RestockIngredientRequest, inventory.RestockIngredientResponse]
+	setReorderSettings *connect.Client[inventory.SetReorderSettingsRequest, inventory.SetReorderSettingsResponse]
+	listLowStock       *connect.Client[inventory.ListLowStockRequest, inventory.ListLowStockResponse]
+	getPurchaseList    *connect.Client[inventory.GetPurchaseListRequest, inventory.GetPurchaseListResponse]
+}
+
+// ListIngredients calls inventory.InventoryService.ListIngredients.
+func (c *inventoryServiceClient) ListIngredients(ctx context.Context, req *connect.Request[inventory.ListIngredientsRequest]) (*connect.Response[inventory.ListIngredientsResponse], error) {
+	return c.listIngredients.CallUnary(ctx, req)
+}
+
+// RestockIngredient calls inventory.InventoryService.RestockIngredient.
+func (c *inventoryServiceClient) RestockIngredient(ctx context.Context, req *connect.Request[inventory.RestockIngredientRequest]) (*connect.Response[inventory.RestockIngredientResponse], error) {
+	return c.restockIngredient.CallUnary(ctx, req)
+}
+
+// SetReorderSettings calls inventory.InventoryService.SetReorderSettings.
+func (c *inventoryServiceClient) SetReorderSettings(ctx context.Context, req *connect.Request[inventory.SetReorderSettingsRequest]) (*connect.Response[inventory.SetReorderSettingsResponse], error) {
+	return c.setReorderSettings.CallUnary(ctx, req)
+}
+
+// ListLowStock calls inventory.InventoryService.ListLowStock.
+func (c *inventoryServiceClient) ListLowStock(ctx context.Context, req *connect.Request[inventory.ListLowStockRequest]) (*connect.Response[inventory.ListLowStockResponse], error) {
+	return c.listLowStock.CallUnary(ctx, req)
+}
+
+// GetPurchaseList calls inventory.InventoryService.GetPurchaseList.
+func (c *inventoryServiceClient) GetPurchaseList(ctx context.Context, req *connect.Request[inventory.GetPurchaseListRequest]) (*connect.Response[inventory.GetPurchaseListResponse], error) {
+	return c.getPurchaseList.CallUnary(ctx, req)
+}
+
+// InventoryServiceHandler is an implementation of the inventory.InventoryService service.
+type InventoryServiceHandler interface {
+	ListIngredients(context.Context, *connect.Request[inventory.ListIngredientsRequest]) (*connect.Response[inventory.ListIngredientsResponse], error)
+	RestockIngredient(context.Context, *connect.Request[inventory.RestockIngredientRequest]) (*connect.Response[inventory.RestockIngredientResponse], error)
+	SetReorderSettings(context.Context, *connect.Request[inventory.SetReorderSettingsRequest]) (*connect.Response[inventory.SetReorderSettingsResponse], error)
+	// Ingredients at or below their reorder threshold.
+	ListLowStock(context.Context, *connect.Request[inventory.ListLowStockRequest]) (*connect.Response[inventory.ListLowStockResponse], error)
+	// What to buy from each supplier, based on recent consumption.
+	GetPurchaseList(context.Context, *connect.Request[inventory.GetPurchaseListRequest]) (*connect.Response[inventory.GetPurchaseListResponse], error)
+}
+
+// NewInventoryServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewInventoryServiceHandler(svc InventoryServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	inventoryServiceMethods := inventory.File_inventory_inventory_proto.Services().ByName("InventoryService").Methods()
+	inventoryServiceListIngredientsHandler := connect.NewUnaryHandler(
+		InventoryServiceListIngredientsProcedure,
+		svc.ListIngredients,
+		connect.WithSchema(inventoryServiceMethods.ByName("ListIngredients")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceRestockIngredientHandler := connect.NewUnaryHandler(
+		InventoryServiceRestockIngredientProcedure,
+		svc.RestockIngredient,
+		connect.WithSchema(inventoryServiceMethods.ByName("RestockIngredient")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceSetReorderSettingsHandler := connect.NewUnaryHandler(
+		InventoryServiceSetReorderSettingsProcedure,
+		svc.SetReorderSettings,
+		connect.WithSchema(inventoryServiceMethods.ByName("SetReorderSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceListLowStockHandler := connect.NewUnaryHandler(
+		InventoryServiceListLowStockProcedure,
+		svc.ListLowStock,
+		connect.WithSchema(inventoryServiceMethods.ByName("ListLowStock")),
+		connect.WithHandlerOptions(opts...),
+	)
+	inventoryServiceGetPurchaseListHandler := connect.NewUnaryHandler(
+		InventoryServiceGetPurchaseListProcedure,
+		svc.GetPurchaseList,
+		connect.WithSchema(inventoryServiceMethods.ByName("GetPurchaseList")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/inventory.InventoryService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case InventoryServiceListIngredientsProcedure:
+			inventoryServiceListIngredientsHandler.ServeHTTP(w, r)
+		case InventoryServiceRestockIngredientProcedure:
+			inventoryServiceRestockIngredientHandler.ServeHTTP(w, r)
+		case InventoryServiceSetReorderSettingsProcedure:
+			inventoryServiceSetReorderSettingsHandler.ServeHTTP(w, r)
+		case InventoryServiceListLowStockProcedure:
+			inventoryServiceListLowStockHandler.ServeHTTP(w, r)
+		case InventoryServiceGetPurchaseListProcedure:
+			inventoryServiceGetPurchaseListHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedInventoryServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedInventoryServiceHandler struct{}
+
+func (UnimplementedInventoryServiceHandler) ListIngredients(context.Context, *connect.Request[inventory.ListIngredientsRequest]) (*connect.Response[inventory.ListIngredientsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inventory.InventoryService.ListIngredients is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) RestockIngredient(context.Context, *connect.Request[inventory.RestockIngredientRequest]) (*connect.Response[inventory.RestockIngredientResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inventory.InventoryService.RestockIngredient is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) SetReorderSettings(context.Context, *connect.Request[inventory.SetReorderSettingsRequest]) (*connect.Response[inventory.SetReorderSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inventory.InventoryService.SetReorderSettings is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) ListLowStock(context.Context, *connect.Request[inventory.ListLowStockRequest]) (*connect.Response[inventory.ListLowStockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inventory.InventoryService.ListLowStock is not implemented"))
+}
+
+func (UnimplementedInventoryServiceHandler) GetPurchaseList(context.Context, *connect.Request[inventory.GetPurchaseListRequest]) (*connect.Response[inventory.GetPurchaseListResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("inventory.InventoryService.GetPurchaseList is not implemented"))
+}
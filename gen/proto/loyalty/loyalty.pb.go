@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: loyalty/loyalty.proto
+
+package loyalty
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoyaltyEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty for entries not tied to an order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Delta   int32  `protobuf:"varint,2,opt,name=delta,proto3" json:"delta,omitempty"`
+	// EARN, REDEEM or REVERSAL
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoyaltyEntry) Reset() {
+	*x = LoyaltyEntry{}
+	mi := &file_loyalty_loyalty_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoyaltyEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyEntry) ProtoMessage() {}
+
+func (x *LoyaltyEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_loyalty_loyalty_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyEntry.ProtoReflect.Descriptor instead.
+func (*LoyaltyEntry) Descriptor() ([]byte, []int) {
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoyaltyEntry) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *LoyaltyEntry) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *LoyaltyEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *LoyaltyEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetLoyaltyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Needed by staff. Customers always get their own ledger.
+	CustomerId    string `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoyaltyRequest) Reset() {
+	*x = GetLoyaltyRequest{}
+	mi := &file_loyalty_loyalty_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoyaltyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoyaltyRequest) ProtoMessage() {}
+
+func (x *GetLoyaltyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loyalty_loyalty_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoyaltyRequest.ProtoReflect.Descriptor instead.
+func (*GetLoyaltyRequest) Descriptor() ([]byte, []int) {
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLoyaltyRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetLoyaltyResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId      string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Stamps          int64                  `protobuf:"varint,2,opt,name=stamps,proto3" json:"stamps,omitempty"`
+	StampsPerReward int32                  `protobuf:"varint,3,opt,name=stamps_per_reward,json=stampsPerReward,proto3" json:"stamps_per_reward,omitempty"`
+	// Free drinks the customer can redeem now.
+	RewardsAvailable int64 `protobuf:"varint,4,opt,name=rewards_available,json=rewardsAvailable,proto3" json:"rewards_available,omitempty"`
+	// Latest entries, newest first.
+	Entries       []*LoyaltyEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoyaltyResponse) Reset() {
+	*x = GetLoyaltyResponse{}
+	mi := &file_loyalty_loyalty_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoyaltyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoyaltyResponse) ProtoMessage() {}
+
+func (x *GetLoyaltyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loyalty_loyalty_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoyaltyResponse.ProtoReflect.Descriptor instead.
+func (*GetLoyaltyResponse) Descriptor() ([]byte, []int) {
+	return file_loyalty_loyalty_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLoyaltyResponse) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetLoyaltyResponse) GetStamps() int64 {
+	if x != nil {
+		return x.Stamps
+	}
+	return 0
+}
+
+func (x *GetLoyaltyResponse) GetStampsPerReward() int32 {
+	if x != nil {
+		return x.StampsPerReward
+	}
+	return 0
+}
+
+func (x *GetLoyaltyResponse) GetRewardsAvailable() int64 {
+	if x != nil {
+		return x.RewardsAvailable
+	}
+	return 0
+}
+
+func (x *GetLoyaltyResponse) GetEntries() []*LoyaltyEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+var File_loyalty_loyalty_proto protoreflect.FileDescriptor
+
+const file_loyalty_loyalty_proto_rawDesc = "" +
+	"\n" +
+	"\x15loyalty/loyalty.proto\x12\aloyalty\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x01\n" +
+	"\fLoyaltyEntry\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x14\n" +
+	"\x05delta\x18\x02 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x11GetLoyaltyRequest\x12)\n" +
+	"\vcustomer_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\n" +
+	"customerId\"\xd7\x01\n" +
+	"\x12GetLoyaltyResponse\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x16\n" +
+	"\x06stamps\x18\x02 \x01(\x03R\x06stamps\x12*\n" +
+	"\x11stamps_per_reward\x18\x03 \x01(\x05R\x0fstampsPerReward\x12+\n" +
+	"\x11rewards_available\x18\x04 \x01(\x03R\x10rewardsAvailable\x12/\n" +
+	"\aentries\x18\x05 \x03(\v2\x15.loyalty.LoyaltyEntryR\aentries2\\\n" +
+	"\x0eLoyaltyService\x12J\n" +
+	"\n" +
+	"GetLoyalty\x12\x1a.loyalty.GetLoyaltyRequest\x1a\x1b.loyalty.GetLoyaltyResponse\"\x03\x90\x02\x01B\x84\x01\n" +
+	"\vcom.loyaltyB\fLoyaltyProtoP\x01Z+github.com/jany/my-coffee/gen/proto/loyalty\xa2\x02\x03LXX\xaa\x02\aLoyalty\xca\x02\aLoyalty\xe2\x02\x13Loyalty\\GPBMetadata\xea\x02\aLoyaltyb\x06proto3"
+
+var (
+	file_loyalty_loyalty_proto_rawDescOnce sync.Once
+	file_loyalty_loyalty_proto_rawDescData []byte
+)
+
+func file_loyalty_loyalty_proto_rawDescGZIP() []byte {
+	file_loyalty_loyalty_proto_rawDescOnce.Do(func() {
+		file_loyalty_loyalty_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_loyalty_loyalty_proto_rawDesc), len(file_loyalty_loyalty_proto_rawDesc)))
+	})
+	return file_loyalty_loyalty_proto_rawDescData
+}
+
+var file_loyalty_loyalty_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_loyalty_loyalty_proto_goTypes = []any{
+	(*LoyaltyEntry)(nil),          // 0: loyalty.LoyaltyEntry
+	(*GetLoyaltyRequest)(nil),     // 1: loyalty.GetLoyaltyRequest
+	(*GetLoyaltyResponse)(nil),    // 2: loyalty.GetLoyaltyResponse
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_loyalty_loyalty_proto_depIdxs = []int32{
+	3, // 0: loyalty.LoyaltyEntry.created_at:type_name -> google.protobuf.Timestamp
+	0, // 1: loyalty.GetLoyaltyResponse.entries:type_name -> loyalty.LoyaltyEntry
+	1, // 2: loyalty.LoyaltyService.GetLoyalty:input_type -> loyalty.GetLoyaltyRequest
+	2, // 3: loyalty.LoyaltyService.GetLoyalty:output_type -> loyalty.GetLoyaltyResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_loyalty_loyalty_proto_init() }
+func file_loyalty_loyalty_proto_init() {
+	if File_loyalty_loyalty_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loyalty_loyalty_proto_rawDesc), len(file_loyalty_loyalty_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_loyalty_loyalty_proto_goTypes,
+		DependencyIndexes: file_loyalty_loyalty_proto_depIdxs,
+		MessageInfos:      file_loyalty_loyalty_proto_msgTypes,
+	}.Build()
+	File_loyalty_loyalty_proto = out.File
+	file_loyalty_loyalty_proto_goTypes = nil
+	file_loyalty_loyalty_proto_depIdxs = nil
+}
@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: loyalty/loyalty.proto
+
+package loyaltyconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	loyalty "github.com/jany/my-coffee/gen/proto/loyalty"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LoyaltyServiceName is the fully-qualified name of the LoyaltyService service.
+	LoyaltyServiceName = "loyalty.LoyaltyService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LoyaltyServiceGetLoyaltyProcedure is the fully-qualified name of the LoyaltyService's GetLoyalty
+	// RPC.
+	LoyaltyServiceGetLoyaltyProcedure = "/loyalty.LoyaltyService/GetLoyalty"
+)
+
+// LoyaltyServiceClient is a client for the loyalty.LoyaltyService service.
+type LoyaltyServiceClient interface {
+	GetLoyalty(context.Context, *connect.Request[loyalty.GetLoyaltyRequest]) (*connect.Response[loyalty.GetLoyaltyResponse], error)
+}
+
+// NewLoyaltyServiceClient constructs a client for the loyalty.LoyaltyService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLoyaltyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LoyaltyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	loyaltyServiceMethods := loyalty.File_loyalty_loyalty_proto.Services().ByName("LoyaltyService").Methods()
+	return &loyaltyServiceClient{
+		getLoyalty: connect.NewClient[loyalty.GetLoyaltyRequest, loyalty.GetLoyaltyResponse](
+			httpClient,
+			baseURL+LoyaltyServiceGetLoyaltyProcedure,
+			connect.WithSchema(loyaltyServiceMethods.ByName("GetLoyalty")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// loyaltyServiceClient implements LoyaltyServiceClient.
+type loyaltyServiceClient struct {
+	getLoyalty *connect.Client[loyalty.GetLoyaltyRequest, loyalty.GetLoyaltyResponse]
+}
+
+// GetLoyalty calls loyalty.LoyaltyService.GetLoyalty.
+func (c *loyaltyServiceClient) GetLoyalty(ctx context.Context, req *connect.Request[loyalty.GetLoyaltyRequest]) (*connect.Response[loyalty.GetLoyaltyResponse], error) {
+	return c.getLoyalty.CallUnary(ctx, req)
+}
+
+// LoyaltyServiceHandler is an implementation of the loyalty.LoyaltyService service.
+type LoyaltyServiceHandler interface {
+	GetLoyalty(context.Context, *connect.Request[loyalty.GetLoyaltyRequest]) (*connect.Response[loyalty.GetLoyaltyResponse], error)
+}
+
+// NewLoyaltyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLoyaltyServiceHandler(svc LoyaltyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	loyaltyServiceMethods := loyalty.File_loyalty_loyalty_proto.Services().ByName("LoyaltyService").Methods()
+	loyaltyServiceGetLoyaltyHandler := connect.NewUnaryHandler(
+		LoyaltyServiceGetLoyaltyProcedure,
+		svc.GetLoyalty,
+		connect.WithSchema(loyaltyServiceMethods.ByName("GetLoyalty")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/loyalty.LoyaltyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LoyaltyServiceGetLoyaltyProcedure:
+			loyaltyServiceGetLoyaltyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLoyaltyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLoyaltyServiceHandler struct{}
+
+func (UnimplementedLoyaltyServiceHandler) GetLoyalty(context.Context, *connect.Request[loyalty.GetLoyaltyRequest]) (*connect.Response[loyalty.GetLoyaltyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("loyalty.LoyaltyService.GetLoyalty is not implemented"))
+}
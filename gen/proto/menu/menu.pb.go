@@ -21,10 +21,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Allergen int32
+
+const (
+	Allergen_ALLERGEN_UNSPECIFIED Allergen = 0
+	Allergen_DAIRY                Allergen = 1
+	Allergen_NUTS                 Allergen = 2
+	Allergen_GLUTEN               Allergen = 3
+	Allergen_SOY                  Allergen = 4
+)
+
+// Enum value maps for Allergen.
+var (
+	Allergen_name = map[int32]string{
+		0: "ALLERGEN_UNSPECIFIED",
+		1: "DAIRY",
+		2: "NUTS",
+		3: "GLUTEN",
+		4: "SOY",
+	}
+	Allergen_value = map[string]int32{
+		"ALLERGEN_UNSPECIFIED": 0,
+		"DAIRY":                1,
+		"NUTS":                 2,
+		"GLUTEN":               3,
+		"SOY":                  4,
+	}
+)
+
+func (x Allergen) Enum() *Allergen {
+	p := new(Allergen)
+	*p = x
+	return p
+}
+
+func (x Allergen) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Allergen) Descriptor() protoreflect.EnumDescriptor {
+	return file_menu_menu_proto_enumTypes[0].Descriptor()
+}
+
+func (Allergen) Type() protoreflect.EnumType {
+	return &file_menu_menu_proto_enumTypes[0]
+}
+
+func (x Allergen) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Allergen.Descriptor instead.
+func (Allergen) EnumDescriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{0}
+}
+
 type GetMenuRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// BCP 47 language tag such as "vi" or "en-US". When empty the
+	// Accept-Language header is used, then the default locale.
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	// Hide items and modifiers containing any of these allergens. An item is
+	// kept when one of its modifiers (e.g. oat milk) makes it safe.
+	ExcludeAllergens []Allergen `protobuf:"varint,2,rep,packed,name=exclude_allergens,json=excludeAllergens,proto3,enum=menu.Allergen" json:"exclude_allergens,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetMenuRequest) Reset() {
@@ -57,18 +118,183 @@ func (*GetMenuRequest) Descriptor() ([]byte, []int) {
 	return file_menu_menu_proto_rawDescGZIP(), []int{0}
 }
 
-type MenuItem struct {
+func (x *GetMenuRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetMenuRequest) GetExcludeAllergens() []Allergen {
+	if x != nil {
+		return x.ExcludeAllergens
+	}
+	return nil
+}
+
+type Nutrition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Calories      int32                  `protobuf:"varint,1,opt,name=calories,proto3" json:"calories,omitempty"`
+	CaffeineMg    int32                  `protobuf:"varint,2,opt,name=caffeine_mg,json=caffeineMg,proto3" json:"caffeine_mg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nutrition) Reset() {
+	*x = Nutrition{}
+	mi := &file_menu_menu_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nutrition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nutrition) ProtoMessage() {}
+
+func (x *Nutrition) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nutrition.ProtoReflect.Descriptor instead.
+func (*Nutrition) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Nutrition) GetCalories() int32 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Nutrition) GetCaffeineMg() int32 {
+	if x != nil {
+		return x.CaffeineMg
+	}
+	return 0
+}
+
+// Modifier is an optional change to a drink, such as a milk swap.
+type Modifier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Surcharge added to the item price.
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Allergens and nutrition of the drink with this modifier applied.
+	Allergens []Allergen `protobuf:"varint,4,rep,packed,name=allergens,proto3,enum=menu.Allergen" json:"allergens,omitempty"`
+	Nutrition *Nutrition `protobuf:"bytes,5,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	// False when an ingredient the modifier adds has run out.
+	Available     bool `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Modifier) Reset() {
+	*x = Modifier{}
+	mi := &file_menu_menu_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Modifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modifier) ProtoMessage() {}
+
+func (x *Modifier) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modifier.ProtoReflect.Descriptor instead.
+func (*Modifier) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Modifier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Modifier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Modifier) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Modifier) GetAllergens() []Allergen {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *Modifier) GetNutrition() *Nutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *Modifier) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type MenuItem struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price       float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Stable identifier that does not change with the language.
+	Id        string      `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Allergens []Allergen  `protobuf:"varint,5,rep,packed,name=allergens,proto3,enum=menu.Allergen" json:"allergens,omitempty"`
+	Nutrition *Nutrition  `protobuf:"bytes,6,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	Modifiers []*Modifier `protobuf:"bytes,7,rep,name=modifiers,proto3" json:"modifiers,omitempty"`
+	// Free-form keywords such as "cold" or "milk", used by SearchMenu.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Brew stages the drink goes through between QUEUED and READY, in order.
+	Recipe []*RecipeStage `protobuf:"bytes,9,rep,name=recipe,proto3" json:"recipe,omitempty"`
+	// False when an ingredient of the base recipe has run out.
+	Available     bool `protobuf:"varint,10,opt,name=available,proto3" json:"available,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MenuItem) Reset() {
 	*x = MenuItem{}
-	mi := &file_menu_menu_proto_msgTypes[1]
+	mi := &file_menu_menu_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -80,7 +306,7 @@ func (x *MenuItem) String() string {
 func (*MenuItem) ProtoMessage() {}
 
 func (x *MenuItem) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_menu_proto_msgTypes[1]
+	mi := &file_menu_menu_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -93,7 +319,7 @@ func (x *MenuItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuItem.ProtoReflect.Descriptor instead.
 func (*MenuItem) Descriptor() ([]byte, []int) {
-	return file_menu_menu_proto_rawDescGZIP(), []int{1}
+	return file_menu_menu_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *MenuItem) GetName() string {
@@ -117,19 +343,132 @@ func (x *MenuItem) GetPrice() float64 {
 	return 0
 }
 
+func (x *MenuItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItem) GetAllergens() []Allergen {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *MenuItem) GetNutrition() *Nutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *MenuItem) GetModifiers() []*Modifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *MenuItem) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MenuItem) GetRecipe() []*RecipeStage {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *MenuItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type RecipeStage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of a brew.DrinkStatus such as "GRINDING" or "FROTHING".
+	Stage         string `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	TargetSeconds int32  `protobuf:"varint,2,opt,name=target_seconds,json=targetSeconds,proto3" json:"target_seconds,omitempty"`
+	// Barista instructions, e.g. "grind_size": "fine", "shot_yield_g": "36".
+	Parameters    map[string]string `protobuf:"bytes,3,rep,name=parameters,proto3" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeStage) Reset() {
+	*x = RecipeStage{}
+	mi := &file_menu_menu_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeStage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeStage) ProtoMessage() {}
+
+func (x *RecipeStage) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeStage.ProtoReflect.Descriptor instead.
+func (*RecipeStage) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RecipeStage) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *RecipeStage) GetTargetSeconds() int32 {
+	if x != nil {
+		return x.TargetSeconds
+	}
+	return 0
+}
+
+func (x *RecipeStage) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
 // Instead of saving : "name=latte, description=strong, price=3.5"
 // Protobuf save: "1=latte, 2=strong, 3=3.5" lightweight serialization
 type GetMenuResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// repeated means array/slice
-	Items         []*MenuItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items []*MenuItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Language the names and descriptions were rendered in.
+	Language      string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetMenuResponse) Reset() {
 	*x = GetMenuResponse{}
-	mi := &file_menu_menu_proto_msgTypes[2]
+	mi := &file_menu_menu_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -141,7 +480,7 @@ func (x *GetMenuResponse) String() string {
 func (*GetMenuResponse) ProtoMessage() {}
 
 func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_menu_proto_msgTypes[2]
+	mi := &file_menu_menu_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +493,7 @@ func (x *GetMenuResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMenuResponse.ProtoReflect.Descriptor instead.
 func (*GetMenuResponse) Descriptor() ([]byte, []int) {
-	return file_menu_menu_proto_rawDescGZIP(), []int{2}
+	return file_menu_menu_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetMenuResponse) GetItems() []*MenuItem {
@@ -164,20 +503,236 @@ func (x *GetMenuResponse) GetItems() []*MenuItem {
 	return nil
 }
 
+func (x *GetMenuResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type SearchMenuRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Same rules as GetMenuRequest.language.
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// Maximum number of results, 5 when zero.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMenuRequest) Reset() {
+	*x = SearchMenuRequest{}
+	mi := &file_menu_menu_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMenuRequest) ProtoMessage() {}
+
+func (x *SearchMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMenuRequest.ProtoReflect.Descriptor instead.
+func (*SearchMenuRequest) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchMenuRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchMenuRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SearchMenuRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *MenuItem              `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Match quality between 0 and 1, higher is better.
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_menu_menu_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchResult) GetItem() *MenuItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchMenuResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best matches first.
+	Results       []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchMenuResponse) Reset() {
+	*x = SearchMenuResponse{}
+	mi := &file_menu_menu_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMenuResponse) ProtoMessage() {}
+
+func (x *SearchMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_menu_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMenuResponse.ProtoReflect.Descriptor instead.
+func (*SearchMenuResponse) Descriptor() ([]byte, []int) {
+	return file_menu_menu_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchMenuResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_menu_menu_proto protoreflect.FileDescriptor
 
 const file_menu_menu_proto_rawDesc = "" +
 	"\n" +
-	"\x0fmenu/menu.proto\x12\x04menu\"\x10\n" +
-	"\x0eGetMenuRequest\"V\n" +
+	"\x0fmenu/menu.proto\x12\x04menu\"i\n" +
+	"\x0eGetMenuRequest\x12\x1a\n" +
+	"\blanguage\x18\x01 \x01(\tR\blanguage\x12;\n" +
+	"\x11exclude_allergens\x18\x02 \x03(\x0e2\x0e.menu.AllergenR\x10excludeAllergens\"H\n" +
+	"\tNutrition\x12\x1a\n" +
+	"\bcalories\x18\x01 \x01(\x05R\bcalories\x12\x1f\n" +
+	"\vcaffeine_mg\x18\x02 \x01(\x05R\n" +
+	"caffeineMg\"\xbf\x01\n" +
+	"\bModifier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12,\n" +
+	"\tallergens\x18\x04 \x03(\x0e2\x0e.menu.AllergenR\tallergens\x12-\n" +
+	"\tnutrition\x18\x05 \x01(\v2\x0f.menu.NutritionR\tnutrition\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\"\xce\x02\n" +
 	"\bMenuItem\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
-	"\x05price\x18\x03 \x01(\x01R\x05price\"7\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12,\n" +
+	"\tallergens\x18\x05 \x03(\x0e2\x0e.menu.AllergenR\tallergens\x12-\n" +
+	"\tnutrition\x18\x06 \x01(\v2\x0f.menu.NutritionR\tnutrition\x12,\n" +
+	"\tmodifiers\x18\a \x03(\v2\x0e.menu.ModifierR\tmodifiers\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12)\n" +
+	"\x06recipe\x18\t \x03(\v2\x11.menu.RecipeStageR\x06recipe\x12\x1c\n" +
+	"\tavailable\x18\n" +
+	" \x01(\bR\tavailable\"\xcc\x01\n" +
+	"\vRecipeStage\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x12%\n" +
+	"\x0etarget_seconds\x18\x02 \x01(\x05R\rtargetSeconds\x12A\n" +
+	"\n" +
+	"parameters\x18\x03 \x03(\v2!.menu.RecipeStage.ParametersEntryR\n" +
+	"parameters\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
 	"\x0fGetMenuResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.menu.MenuItemR\x05items2E\n" +
-	"\vMenuService\x126\n" +
-	"\aGetMenu\x12\x14.menu.GetMenuRequest\x1a\x15.menu.GetMenuResponseBo\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.menu.MenuItemR\x05items\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\"[\n" +
+	"\x11SearchMenuRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"H\n" +
+	"\fSearchResult\x12\"\n" +
+	"\x04item\x18\x01 \x01(\v2\x0e.menu.MenuItemR\x04item\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"B\n" +
+	"\x12SearchMenuResponse\x12,\n" +
+	"\aresults\x18\x01 \x03(\v2\x12.menu.SearchResultR\aresults*N\n" +
+	"\bAllergen\x12\x18\n" +
+	"\x14ALLERGEN_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05DAIRY\x10\x01\x12\b\n" +
+	"\x04NUTS\x10\x02\x12\n" +
+	"\n" +
+	"\x06GLUTEN\x10\x03\x12\a\n" +
+	"\x03SOY\x10\x042\x90\x01\n" +
+	"\vMenuService\x12;\n" +
+	"\aGetMenu\x12\x14.menu.GetMenuRequest\x1a\x15.menu.GetMenuResponse\"\x03\x90\x02\x01\x12D\n" +
+	"\n" +
+	"SearchMenu\x12\x17.menu.SearchMenuRequest\x1a\x18.menu.SearchMenuResponse\"\x03\x90\x02\x01Bo\n" +
 	"\bcom.menuB\tMenuProtoP\x01Z(github.com/jany/my-coffee/gen/proto/menu\xa2\x02\x03MXX\xaa\x02\x04Menu\xca\x02\x04Menu\xe2\x02\x10Menu\\GPBMetadata\xea\x02\x04Menub\x06proto3"
 
 var (
@@ -192,21 +747,42 @@ func file_menu_menu_proto_rawDescGZIP() []byte {
 	return file_menu_menu_proto_rawDescData
 }
 
-var file_menu_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_menu_menu_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_menu_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_menu_menu_proto_goTypes = []any{
-	(*GetMenuRequest)(nil),  // 0: menu.GetMenuRequest
-	(*MenuItem)(nil),        // 1: menu.MenuItem
-	(*GetMenuResponse)(nil), // 2: menu.GetMenuResponse
+	(Allergen)(0),              // 0: menu.Allergen
+	(*GetMenuRequest)(nil),     // 1: menu.GetMenuRequest
+	(*Nutrition)(nil),          // 2: menu.Nutrition
+	(*Modifier)(nil),           // 3: menu.Modifier
+	(*MenuItem)(nil),           // 4: menu.MenuItem
+	(*RecipeStage)(nil),        // 5: menu.RecipeStage
+	(*GetMenuResponse)(nil),    // 6: menu.GetMenuResponse
+	(*SearchMenuRequest)(nil),  // 7: menu.SearchMenuRequest
+	(*SearchResult)(nil),       // 8: menu.SearchResult
+	(*SearchMenuResponse)(nil), // 9: menu.SearchMenuResponse
+	nil,                        // 10: menu.RecipeStage.ParametersEntry
 }
 var file_menu_menu_proto_depIdxs = []int32{
-	1, // 0: menu.GetMenuResponse.items:type_name -> menu.MenuItem
-	0, // 1: menu.MenuService.GetMenu:input_type -> menu.GetMenuRequest
-	2, // 2: menu.MenuService.GetMenu:output_type -> menu.GetMenuResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: menu.GetMenuRequest.exclude_allergens:type_name -> menu.Allergen
+	0,  // 1: menu.Modifier.allergens:type_name -> menu.Allergen
+	2,  // 2: menu.Modifier.nutrition:type_name -> menu.Nutrition
+	0,  // 3: menu.MenuItem.allergens:type_name -> menu.Allergen
+	2,  // 4: menu.MenuItem.nutrition:type_name -> menu.Nutrition
+	3,  // 5: menu.MenuItem.modifiers:type_name -> menu.Modifier
+	5,  // 6: menu.MenuItem.recipe:type_name -> menu.RecipeStage
+	10, // 7: menu.RecipeStage.parameters:type_name -> menu.RecipeStage.ParametersEntry
+	4,  // 8: menu.GetMenuResponse.items:type_name -> menu.MenuItem
+	4,  // 9: menu.SearchResult.item:type_name -> menu.MenuItem
+	8,  // 10: menu.SearchMenuResponse.results:type_name -> menu.SearchResult
+	1,  // 11: menu.MenuService.GetMenu:input_type -> menu.GetMenuRequest
+	7,  // 12: menu.MenuService.SearchMenu:input_type -> menu.SearchMenuRequest
+	6,  // 13: menu.MenuService.GetMenu:output_type -> menu.GetMenuResponse
+	9,  // 14: menu.MenuService.SearchMenu:output_type -> menu.SearchMenuResponse
+	13, // [13:15] is the sub-list for method output_type
+	11, // [11:13] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_menu_menu_proto_init() }
@@ -219,13 +795,14 @@ func file_menu_menu_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_menu_menu_proto_rawDesc), len(file_menu_menu_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_menu_menu_proto_goTypes,
 		DependencyIndexes: file_menu_menu_proto_depIdxs,
+		EnumInfos:         file_menu_menu_proto_enumTypes,
 		MessageInfos:      file_menu_menu_proto_msgTypes,
 	}.Build()
 	File_menu_menu_proto = out.File
@@ -19,14 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MenuService_GetMenu_FullMethodName = "/menu.MenuService/GetMenu"
+	MenuService_GetMenu_FullMethodName    = "/menu.MenuService/GetMenu"
+	MenuService_SearchMenu_FullMethodName = "/menu.MenuService/SearchMenu"
 )
 
 // MenuServiceClient is the client API for MenuService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MenuServiceClient interface {
+	// Side-effect free, so Connect also serves these over HTTP GET where
+	// browsers and CDNs can cache them.
 	GetMenu(ctx context.Context, in *GetMenuRequest, opts ...grpc.CallOption) (*GetMenuResponse, error)
+	SearchMenu(ctx context.Context, in *SearchMenuRequest, opts ...grpc.CallOption) (*SearchMenuResponse, error)
 }
 
 type menuServiceClient struct {
@@ -47,11 +51,24 @@ func (c *menuServiceClient) GetMenu(ctx context.Context, in *GetMenuRequest, opt
 	return out, nil
 }
 
+func (c *menuServiceClient) SearchMenu(ctx context.Context, in *SearchMenuRequest, opts ...grpc.CallOption) (*SearchMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMenuResponse)
+	err := c.cc.Invoke(ctx, MenuService_SearchMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MenuServiceServer is the server API for MenuService service.
 // All implementations must embed UnimplementedMenuServiceServer
 // for forward compatibility.
 type MenuServiceServer interface {
+	// Side-effect free, so Connect also serves these over HTTP GET where
+	// browsers and CDNs can cache them.
 	GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error)
+	SearchMenu(context.Context, *SearchMenuRequest) (*SearchMenuResponse, error)
 	mustEmbedUnimplementedMenuServiceServer()
 }
 
@@ -65,6 +82,9 @@ type UnimplementedMenuServiceServer struct{}
 func (UnimplementedMenuServiceServer) GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetMenu not implemented")
 }
+func (UnimplementedMenuServiceServer) SearchMenu(context.Context, *SearchMenuRequest) (*SearchMenuResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchMenu not implemented")
+}
 func (UnimplementedMenuServiceServer) mustEmbedUnimplementedMenuServiceServer() {}
 func (UnimplementedMenuServiceServer) testEmbeddedByValue()                     {}
 
@@ -104,6 +124,24 @@ func _MenuService_GetMenu_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MenuService_SearchMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).SearchMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_SearchMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).SearchMenu(ctx, req.(*SearchMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MenuService_ServiceDesc is the grpc.ServiceDesc for MenuService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +153,10 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMenu",
 			Handler:    _MenuService_GetMenu_Handler,
 		},
+		{
+			MethodName: "SearchMenu",
+			Handler:    _MenuService_SearchMenu_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "menu/menu.proto",
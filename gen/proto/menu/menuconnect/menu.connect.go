@@ -35,11 +35,16 @@ const (
 const (
 	// MenuServiceGetMenuProcedure is the fully-qualified name of the MenuService's GetMenu RPC.
 	MenuServiceGetMenuProcedure = "/menu.MenuService/GetMenu"
+	// MenuServiceSearchMenuProcedure is the fully-qualified name of the MenuService's SearchMenu RPC.
+	MenuServiceSearchMenuProcedure = "/menu.MenuService/SearchMenu"
 )
 
 // MenuServiceClient is a client for the menu.MenuService service.
 type MenuServiceClient interface {
+	// Side-effect free, so Connect also serves these over HTTP GET where
+	// browsers and CDNs can cache them.
 	GetMenu(context.Context, *connect.Request[menu.GetMenuRequest]) (*connect.Response[menu.GetMenuResponse], error)
+	SearchMenu(context.Context, *connect.Request[menu.SearchMenuRequest]) (*connect.Response[menu.SearchMenuResponse], error)
 }
 
 // NewMenuServiceClient constructs a client for the menu.MenuService service. By default, it uses
@@ -57,6 +62,14 @@ func NewMenuServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+MenuServiceGetMenuProcedure,
 			connect.WithSchema(menuServiceMethods.ByName("GetMenu")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		searchMenu: connect.NewClient[menu.SearchMenuRequest, menu.SearchMenuResponse](
+			httpClient,
+			baseURL+MenuServiceSearchMenuProcedure,
+			connect.WithSchema(menuServiceMethods.ByName("SearchMenu")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -64,7 +77,8 @@ func NewMenuServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // menuServiceClient implements MenuServiceClient.
 type menuServiceClient struct {
-	getMenu *connect.Client[menu.GetMenuRequest, menu.GetMenuResponse]
+	getMenu    *connect.Client[menu.GetMenuRequest, menu.GetMenuResponse]
+	searchMenu *connect.Client[menu.SearchMenuRequest, menu.SearchMenuResponse]
 }
 
 // GetMenu calls menu.MenuService.GetMenu.
@@ -72,9 +86,17 @@ func (c *menuServiceClient) GetMenu(ctx context.Context, req *connect.Request[me
 	return c.getMenu.CallUnary(ctx, req)
 }
 
+// SearchMenu calls menu.MenuService.SearchMenu.
+func (c *menuServiceClient) SearchMenu(ctx context.Context, req *connect.Request[menu.SearchMenuRequest]) (*connect.Response[menu.SearchMenuResponse], error) {
+	return c.searchMenu.CallUnary(ctx, req)
+}
+
 // MenuServiceHandler is an implementation of the menu.MenuService service.
 type MenuServiceHandler interface {
+	// Side-effect free, so Connect also serves these over HTTP GET where
+	// browsers and CDNs can cache them.
 	GetMenu(context.Context, *connect.Request[menu.GetMenuRequest]) (*connect.Response[menu.GetMenuResponse], error)
+	SearchMenu(context.Context, *connect.Request[menu.SearchMenuRequest]) (*connect.Response[menu.SearchMenuResponse], error)
 }
 
 // NewMenuServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -88,12 +110,22 @@ func NewMenuServiceHandler(svc MenuServiceHandler, opts ...connect.HandlerOption
 		MenuServiceGetMenuProcedure,
 		svc.GetMenu,
 		connect.WithSchema(menuServiceMethods.ByName("GetMenu")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	menuServiceSearchMenuHandler := connect.NewUnaryHandler(
+		MenuServiceSearchMenuProcedure,
+		svc.SearchMenu,
+		connect.WithSchema(menuServiceMethods.ByName("SearchMenu")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/menu.MenuService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case MenuServiceGetMenuProcedure:
 			menuServiceGetMenuHandler.ServeHTTP(w, r)
+		case MenuServiceSearchMenuProcedure:
+			menuServiceSearchMenuHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -106,3 +138,7 @@ type UnimplementedMenuServiceHandler struct{}
 func (UnimplementedMenuServiceHandler) GetMenu(context.Context, *connect.Request[menu.GetMenuRequest]) (*connect.Response[menu.GetMenuResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("menu.MenuService.GetMenu is not implemented"))
 }
+
+func (UnimplementedMenuServiceHandler) SearchMenu(context.Context, *connect.Request[menu.SearchMenuRequest]) (*connect.Response[menu.SearchMenuResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("menu.MenuService.SearchMenu is not implemented"))
+}
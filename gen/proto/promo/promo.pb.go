@@ -0,0 +1,1176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: promo/promo.proto
+
+package promo
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromoKind int32
+
+const (
+	PromoKind_PROMO_KIND_UNSPECIFIED PromoKind = 0
+	// percent off the order
+	PromoKind_PERCENT PromoKind = 1
+	// amount_cents off the order
+	PromoKind_FIXED PromoKind = 2
+	// every second drink free
+	PromoKind_BOGO PromoKind = 3
+)
+
+// Enum value maps for PromoKind.
+var (
+	PromoKind_name = map[int32]string{
+		0: "PROMO_KIND_UNSPECIFIED",
+		1: "PERCENT",
+		2: "FIXED",
+		3: "BOGO",
+	}
+	PromoKind_value = map[string]int32{
+		"PROMO_KIND_UNSPECIFIED": 0,
+		"PERCENT":                1,
+		"FIXED":                  2,
+		"BOGO":                   3,
+	}
+)
+
+func (x PromoKind) Enum() *PromoKind {
+	p := new(PromoKind)
+	*p = x
+	return p
+}
+
+func (x PromoKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PromoKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_promo_promo_proto_enumTypes[0].Descriptor()
+}
+
+func (PromoKind) Type() protoreflect.EnumType {
+	return &file_promo_promo_proto_enumTypes[0]
+}
+
+func (x PromoKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PromoKind.Descriptor instead.
+func (PromoKind) EnumDescriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{0}
+}
+
+type PromoCode struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Code        string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Kind        PromoKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=promo.PromoKind" json:"kind,omitempty"`
+	Percent     float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	AmountCents int64                  `protobuf:"varint,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	// Only valid on this menu item when set.
+	MenuItemName string                 `protobuf:"bytes,6,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	StartsAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Zero means unlimited.
+	MaxUses            int32 `protobuf:"varint,9,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerCustomer int32 `protobuf:"varint,10,opt,name=max_uses_per_customer,json=maxUsesPerCustomer,proto3" json:"max_uses_per_customer,omitempty"`
+	Active             bool  `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	Uses               int64 `protobuf:"varint,12,opt,name=uses,proto3" json:"uses,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PromoCode) Reset() {
+	*x = PromoCode{}
+	mi := &file_promo_promo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoCode) ProtoMessage() {}
+
+func (x *PromoCode) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoCode.ProtoReflect.Descriptor instead.
+func (*PromoCode) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PromoCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PromoCode) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PromoCode) GetKind() PromoKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PromoKind_PROMO_KIND_UNSPECIFIED
+}
+
+func (x *PromoCode) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PromoCode) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *PromoCode) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PromoCode) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *PromoCode) GetMaxUsesPerCustomer() int32 {
+	if x != nil {
+		return x.MaxUsesPerCustomer
+	}
+	return 0
+}
+
+func (x *PromoCode) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PromoCode) GetUses() int64 {
+	if x != nil {
+		return x.Uses
+	}
+	return 0
+}
+
+type CreatePromoCodeRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Code               string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Kind               PromoKind              `protobuf:"varint,3,opt,name=kind,proto3,enum=promo.PromoKind" json:"kind,omitempty"`
+	Percent            float64                `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`
+	AmountCents        int64                  `protobuf:"varint,5,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	MenuItemName       string                 `protobuf:"bytes,6,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	StartsAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt             *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	MaxUses            int32                  `protobuf:"varint,9,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerCustomer int32                  `protobuf:"varint,10,opt,name=max_uses_per_customer,json=maxUsesPerCustomer,proto3" json:"max_uses_per_customer,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeRequest) Reset() {
+	*x = CreatePromoCodeRequest{}
+	mi := &file_promo_promo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeRequest) ProtoMessage() {}
+
+func (x *CreatePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetKind() PromoKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PromoKind_PROMO_KIND_UNSPECIFIED
+}
+
+func (x *CreatePromoCodeRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *CreatePromoCodeRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreatePromoCodeRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreatePromoCodeRequest) GetMaxUsesPerCustomer() int32 {
+	if x != nil {
+		return x.MaxUsesPerCustomer
+	}
+	return 0
+}
+
+type CreatePromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePromoCodeResponse) Reset() {
+	*x = CreatePromoCodeResponse{}
+	mi := &file_promo_promo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromoCodeResponse) ProtoMessage() {}
+
+func (x *CreatePromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreatePromoCodeResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+type ListPromoCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesRequest) Reset() {
+	*x = ListPromoCodesRequest{}
+	mi := &file_promo_promo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesRequest) ProtoMessage() {}
+
+func (x *ListPromoCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesRequest.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{3}
+}
+
+type ListPromoCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCodes    []*PromoCode           `protobuf:"bytes,1,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPromoCodesResponse) Reset() {
+	*x = ListPromoCodesResponse{}
+	mi := &file_promo_promo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPromoCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPromoCodesResponse) ProtoMessage() {}
+
+func (x *ListPromoCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPromoCodesResponse.ProtoReflect.Descriptor instead.
+func (*ListPromoCodesResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPromoCodesResponse) GetPromoCodes() []*PromoCode {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type SetPromoCodeActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromoCodeActiveRequest) Reset() {
+	*x = SetPromoCodeActiveRequest{}
+	mi := &file_promo_promo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromoCodeActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromoCodeActiveRequest) ProtoMessage() {}
+
+func (x *SetPromoCodeActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromoCodeActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetPromoCodeActiveRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetPromoCodeActiveRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetPromoCodeActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetPromoCodeActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PromoCode     *PromoCode             `protobuf:"bytes,1,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromoCodeActiveResponse) Reset() {
+	*x = SetPromoCodeActiveResponse{}
+	mi := &file_promo_promo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromoCodeActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromoCodeActiveResponse) ProtoMessage() {}
+
+func (x *SetPromoCodeActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromoCodeActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetPromoCodeActiveResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetPromoCodeActiveResponse) GetPromoCode() *PromoCode {
+	if x != nil {
+		return x.PromoCode
+	}
+	return nil
+}
+
+// DiscountRule takes percent off matching orders placed between start_time
+// and end_time ("14:00", "16:00") in the shop's time zone, e.g. a happy
+// hour on cold drinks.
+type DiscountRule struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Percent      float64                `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	MenuItemName string                 `protobuf:"bytes,4,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	// A menu tag such as "cold".
+	Tag           string                 `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	StartTime     string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Active        bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountRule) Reset() {
+	*x = DiscountRule{}
+	mi := &file_promo_promo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountRule) ProtoMessage() {}
+
+func (x *DiscountRule) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountRule.ProtoReflect.Descriptor instead.
+func (*DiscountRule) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiscountRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiscountRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *DiscountRule) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *DiscountRule) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *DiscountRule) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *DiscountRule) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type CreateDiscountRuleRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Percent      float64                `protobuf:"fixed64,2,opt,name=percent,proto3" json:"percent,omitempty"`
+	MenuItemName string                 `protobuf:"bytes,3,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	Tag          string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// HH:MM, the whole day when both are empty.
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDiscountRuleRequest) Reset() {
+	*x = CreateDiscountRuleRequest{}
+	mi := &file_promo_promo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDiscountRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiscountRuleRequest) ProtoMessage() {}
+
+func (x *CreateDiscountRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiscountRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateDiscountRuleRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateDiscountRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDiscountRuleRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreateDiscountRuleRequest) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *CreateDiscountRuleRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *CreateDiscountRuleRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateDiscountRuleRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateDiscountRuleRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CreateDiscountRuleRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type CreateDiscountRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *DiscountRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDiscountRuleResponse) Reset() {
+	*x = CreateDiscountRuleResponse{}
+	mi := &file_promo_promo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDiscountRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiscountRuleResponse) ProtoMessage() {}
+
+func (x *CreateDiscountRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiscountRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateDiscountRuleResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateDiscountRuleResponse) GetRule() *DiscountRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type ListDiscountRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountRulesRequest) Reset() {
+	*x = ListDiscountRulesRequest{}
+	mi := &file_promo_promo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountRulesRequest) ProtoMessage() {}
+
+func (x *ListDiscountRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListDiscountRulesRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{10}
+}
+
+type ListDiscountRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*DiscountRule        `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountRulesResponse) Reset() {
+	*x = ListDiscountRulesResponse{}
+	mi := &file_promo_promo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountRulesResponse) ProtoMessage() {}
+
+func (x *ListDiscountRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListDiscountRulesResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListDiscountRulesResponse) GetRules() []*DiscountRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SetDiscountRuleActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDiscountRuleActiveRequest) Reset() {
+	*x = SetDiscountRuleActiveRequest{}
+	mi := &file_promo_promo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDiscountRuleActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscountRuleActiveRequest) ProtoMessage() {}
+
+func (x *SetDiscountRuleActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscountRuleActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetDiscountRuleActiveRequest) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetDiscountRuleActiveRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetDiscountRuleActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SetDiscountRuleActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          *DiscountRule          `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDiscountRuleActiveResponse) Reset() {
+	*x = SetDiscountRuleActiveResponse{}
+	mi := &file_promo_promo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDiscountRuleActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiscountRuleActiveResponse) ProtoMessage() {}
+
+func (x *SetDiscountRuleActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_promo_promo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiscountRuleActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetDiscountRuleActiveResponse) Descriptor() ([]byte, []int) {
+	return file_promo_promo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetDiscountRuleActiveResponse) GetRule() *DiscountRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+var File_promo_promo_proto protoreflect.FileDescriptor
+
+const file_promo_promo_proto_rawDesc = "" +
+	"\n" +
+	"\x11promo/promo.proto\x12\x05promo\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb2\x03\n" +
+	"\tPromoCode\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12$\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x10.promo.PromoKindR\x04kind\x12\x18\n" +
+	"\apercent\x18\x04 \x01(\x01R\apercent\x12!\n" +
+	"\famount_cents\x18\x05 \x01(\x03R\vamountCents\x12$\n" +
+	"\x0emenu_item_name\x18\x06 \x01(\tR\fmenuItemName\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x19\n" +
+	"\bmax_uses\x18\t \x01(\x05R\amaxUses\x121\n" +
+	"\x15max_uses_per_customer\x18\n" +
+	" \x01(\x05R\x12maxUsesPerCustomer\x12\x16\n" +
+	"\x06active\x18\v \x01(\bR\x06active\x12\x12\n" +
+	"\x04uses\x18\f \x01(\x03R\x04uses\"\xf9\x03\n" +
+	"\x16CreatePromoCodeRequest\x12/\n" +
+	"\x04code\x18\x01 \x01(\tB\x1b\xbaH\x18r\x16\x10\x03\x1822\x10^[A-Za-z0-9_-]+$R\x04code\x12)\n" +
+	"\vdescription\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\vdescription\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x10.promo.PromoKindB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04kind\x121\n" +
+	"\apercent\x18\x04 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@)\x00\x00\x00\x00\x00\x00\x00\x00R\apercent\x12*\n" +
+	"\famount_cents\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vamountCents\x12$\n" +
+	"\x0emenu_item_name\x18\x06 \x01(\tR\fmenuItemName\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\"\n" +
+	"\bmax_uses\x18\t \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\amaxUses\x12:\n" +
+	"\x15max_uses_per_customer\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x12maxUsesPerCustomer\"J\n" +
+	"\x17CreatePromoCodeResponse\x12/\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x10.promo.PromoCodeR\tpromoCode\"\x17\n" +
+	"\x15ListPromoCodesRequest\"K\n" +
+	"\x16ListPromoCodesResponse\x121\n" +
+	"\vpromo_codes\x18\x01 \x03(\v2\x10.promo.PromoCodeR\n" +
+	"promoCodes\"P\n" +
+	"\x19SetPromoCodeActiveRequest\x12\x1b\n" +
+	"\x04code\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04code\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"M\n" +
+	"\x1aSetPromoCodeActiveResponse\x12/\n" +
+	"\n" +
+	"promo_code\x18\x01 \x01(\v2\x10.promo.PromoCodeR\tpromoCode\"\xc4\x02\n" +
+	"\fDiscountRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12$\n" +
+	"\x0emenu_item_name\x18\x04 \x01(\tR\fmenuItemName\x12\x10\n" +
+	"\x03tag\x18\x05 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\x127\n" +
+	"\tstarts_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\"\xa1\x03\n" +
+	"\x19CreateDiscountRuleRequest\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x04name\x121\n" +
+	"\apercent\x18\x02 \x01(\x01B\x17\xbaH\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00Y@!\x00\x00\x00\x00\x00\x00\x00\x00R\apercent\x12$\n" +
+	"\x0emenu_item_name\x18\x03 \x01(\tR\fmenuItemName\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12H\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tB)\xbaH&r$2\"^([01][0-9]|2[0-3]):[0-5][0-9]$|^$R\tstartTime\x12D\n" +
+	"\bend_time\x18\x06 \x01(\tB)\xbaH&r$2\"^([01][0-9]|2[0-4]):[0-5][0-9]$|^$R\aendTime\x127\n" +
+	"\tstarts_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\"E\n" +
+	"\x1aCreateDiscountRuleResponse\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.promo.DiscountRuleR\x04rule\"\x1a\n" +
+	"\x18ListDiscountRulesRequest\"F\n" +
+	"\x19ListDiscountRulesResponse\x12)\n" +
+	"\x05rules\x18\x01 \x03(\v2\x13.promo.DiscountRuleR\x05rules\"O\n" +
+	"\x1cSetDiscountRuleActiveRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\rB\a\xbaH\x04*\x02 \x00R\x02id\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"H\n" +
+	"\x1dSetDiscountRuleActiveResponse\x12'\n" +
+	"\x04rule\x18\x01 \x01(\v2\x13.promo.DiscountRuleR\x04rule*I\n" +
+	"\tPromoKind\x12\x1a\n" +
+	"\x16PROMO_KIND_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPERCENT\x10\x01\x12\t\n" +
+	"\x05FIXED\x10\x02\x12\b\n" +
+	"\x04BOGO\x10\x032\xa1\x04\n" +
+	"\fPromoService\x12P\n" +
+	"\x0fCreatePromoCode\x12\x1d.promo.CreatePromoCodeRequest\x1a\x1e.promo.CreatePromoCodeResponse\x12M\n" +
+	"\x0eListPromoCodes\x12\x1c.promo.ListPromoCodesRequest\x1a\x1d.promo.ListPromoCodesResponse\x12Y\n" +
+	"\x12SetPromoCodeActive\x12 .promo.SetPromoCodeActiveRequest\x1a!.promo.SetPromoCodeActiveResponse\x12Y\n" +
+	"\x12CreateDiscountRule\x12 .promo.CreateDiscountRuleRequest\x1a!.promo.CreateDiscountRuleResponse\x12V\n" +
+	"\x11ListDiscountRules\x12\x1f.promo.ListDiscountRulesRequest\x1a .promo.ListDiscountRulesResponse\x12b\n" +
+	"\x15SetDiscountRuleActive\x12#.promo.SetDiscountRuleActiveRequest\x1a$.promo.SetDiscountRuleActiveResponseBv\n" +
+	"\tcom.promoB\n" +
+	"PromoProtoP\x01Z)github.com/jany/my-coffee/gen/proto/promo\xa2\x02\x03PXX\xaa\x02\x05Promo\xca\x02\x05Promo\xe2\x02\x11Promo\\GPBMetadata\xea\x02\x05Promob\x06proto3"
+
+var (
+	file_promo_promo_proto_rawDescOnce sync.Once
+	file_promo_promo_proto_rawDescData []byte
+)
+
+func file_promo_promo_proto_rawDescGZIP() []byte {
+	file_promo_promo_proto_rawDescOnce.Do(func() {
+		file_promo_promo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_promo_promo_proto_rawDesc), len(file_promo_promo_proto_rawDesc)))
+	})
+	return file_promo_promo_proto_rawDescData
+}
+
+var file_promo_promo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_promo_promo_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_promo_promo_proto_goTypes = []any{
+	(PromoKind)(0),                        // 0: promo.PromoKind
+	(*PromoCode)(nil),                     // 1: promo.PromoCode
+	(*CreatePromoCodeRequest)(nil),        // 2: promo.CreatePromoCodeRequest
+	(*CreatePromoCodeResponse)(nil),       // 3: promo.CreatePromoCodeResponse
+	(*ListPromoCodesRequest)(nil),         // 4: promo.ListPromoCodesRequest
+	(*ListPromoCodesResponse)(nil),        // 5: promo.ListPromoCodesResponse
+	(*SetPromoCodeActiveRequest)(nil),     // 6: promo.SetPromoCodeActiveRequest
+	(*SetPromoCodeActiveResponse)(nil),    // 7: promo.SetPromoCodeActiveResponse
+	(*DiscountRule)(nil),                  // 8: promo.DiscountRule
+	(*CreateDiscountRuleRequest)(nil),     // 9: promo.CreateDiscountRuleRequest
+	(*CreateDiscountRuleResponse)(nil),    // 10: promo.CreateDiscountRuleResponse
+	(*ListDiscountRulesRequest)(nil),      // 11: promo.ListDiscountRulesRequest
+	(*ListDiscountRulesResponse)(nil),     // 12: promo.ListDiscountRulesResponse
+	(*SetDiscountRuleActiveRequest)(nil),  // 13: promo.SetDiscountRuleActiveRequest
+	(*SetDiscountRuleActiveResponse)(nil), // 14: promo.SetDiscountRuleActiveResponse
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+}
+var file_promo_promo_proto_depIdxs = []int32{
+	0,  // 0: promo.PromoCode.kind:type_name -> promo.PromoKind
+	15, // 1: promo.PromoCode.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 2: promo.PromoCode.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: promo.CreatePromoCodeRequest.kind:type_name -> promo.PromoKind
+	15, // 4: promo.CreatePromoCodeRequest.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 5: promo.CreatePromoCodeRequest.ends_at:type_name -> google.protobuf.Timestamp
+	1,  // 6: promo.CreatePromoCodeResponse.promo_code:type_name -> promo.PromoCode
+	1,  // 7: promo.ListPromoCodesResponse.promo_codes:type_name -> promo.PromoCode
+	1,  // 8: promo.SetPromoCodeActiveResponse.promo_code:type_name -> promo.PromoCode
+	15, // 9: promo.DiscountRule.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 10: promo.DiscountRule.ends_at:type_name -> google.protobuf.Timestamp
+	15, // 11: promo.CreateDiscountRuleRequest.starts_at:type_name -> google.protobuf.Timestamp
+	15, // 12: promo.CreateDiscountRuleRequest.ends_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: promo.CreateDiscountRuleResponse.rule:type_name -> promo.DiscountRule
+	8,  // 14: promo.ListDiscountRulesResponse.rules:type_name -> promo.DiscountRule
+	8,  // 15: promo.SetDiscountRuleActiveResponse.rule:type_name -> promo.DiscountRule
+	2,  // 16: promo.PromoService.CreatePromoCode:input_type -> promo.CreatePromoCodeRequest
+	4,  // 17: promo.PromoService.ListPromoCodes:input_type -> promo.ListPromoCodesRequest
+	6,  // 18: promo.PromoService.SetPromoCodeActive:input_type -> promo.SetPromoCodeActiveRequest
+	9,  // 19: promo.PromoService.CreateDiscountRule:input_type -> promo.CreateDiscountRuleRequest
+	11, // 20: promo.PromoService.ListDiscountRules:input_type -> promo.ListDiscountRulesRequest
+	13, // 21: promo.PromoService.SetDiscountRuleActive:input_type -> promo.SetDiscountRuleActiveRequest
+	3,  // 22: promo.PromoService.CreatePromoCode:output_type -> promo.CreatePromoCodeResponse
+	5,  // 23: promo.PromoService.ListPromoCodes:output_type -> promo.ListPromoCodesResponse
+	7,  // 24: promo.PromoService.SetPromoCodeActive:output_type -> promo.SetPromoCodeActiveResponse
+	10, // 25: promo.PromoService.CreateDiscountRule:output_type -> promo.CreateDiscountRuleResponse
+	12, // 26: promo.PromoService.ListDiscountRules:output_type -> promo.ListDiscountRulesResponse
+	14, // 27: promo.PromoService.SetDiscountRuleActive:output_type -> promo.SetDiscountRuleActiveResponse
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_promo_promo_proto_init() }
+func file_promo_promo_proto_init() {
+	if File_promo_promo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_promo_promo_proto_rawDesc), len(file_promo_promo_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_promo_promo_proto_goTypes,
+		DependencyIndexes: file_promo_promo_proto_depIdxs,
+		EnumInfos:         file_promo_promo_proto_enumTypes,
+		MessageInfos:      file_promo_promo_proto_msgTypes,
+	}.Build()
+	File_promo_promo_proto = out.File
+	file_promo_promo_proto_goTypes = nil
+	file_promo_promo_proto_depIdxs = nil
+}
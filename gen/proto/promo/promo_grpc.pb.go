@@ -0,0 +1,317 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: promo/promo.proto
+
+package promo
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PromoService_CreatePromoCode_FullMethodName       = "/promo.PromoService/CreatePromoCode"
+	PromoService_ListPromoCodes_FullMethodName        = "/promo.PromoService/ListPromoCodes"
+	PromoService_SetPromoCodeActive_FullMethodName    = "/promo.PromoService/SetPromoCodeActive"
+	PromoService_CreateDiscountRule_FullMethodName    = "/promo.PromoService/CreateDiscountRule"
+	PromoService_ListDiscountRules_FullMethodName     = "/promo.PromoService/ListDiscountRules"
+	PromoService_SetDiscountRuleActive_FullMethodName = "/promo.PromoService/SetDiscountRuleActive"
+)
+
+// PromoServiceClient is the client API for PromoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PromoService is hosted by brewsvc. Customers redeem promo codes through
+// brew.OrderRequest.promo_code; discount rules apply on their own.
+type PromoServiceClient interface {
+	CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error)
+	ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error)
+	SetPromoCodeActive(ctx context.Context, in *SetPromoCodeActiveRequest, opts ...grpc.CallOption) (*SetPromoCodeActiveResponse, error)
+	CreateDiscountRule(ctx context.Context, in *CreateDiscountRuleRequest, opts ...grpc.CallOption) (*CreateDiscountRuleResponse, error)
+	ListDiscountRules(ctx context.Context, in *ListDiscountRulesRequest, opts ...grpc.CallOption) (*ListDiscountRulesResponse, error)
+	SetDiscountRuleActive(ctx context.Context, in *SetDiscountRuleActiveRequest, opts ...grpc.CallOption) (*SetDiscountRuleActiveResponse, error)
+}
+
+type promoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromoServiceClient(cc grpc.ClientConnInterface) PromoServiceClient {
+	return &promoServiceClient{cc}
+}
+
+func (c *promoServiceClient) CreatePromoCode(ctx context.Context, in *CreatePromoCodeRequest, opts ...grpc.CallOption) (*CreatePromoCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePromoCodeResponse)
+	err := c.cc.Invoke(ctx, PromoService_CreatePromoCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ListPromoCodes(ctx context.Context, in *ListPromoCodesRequest, opts ...grpc.CallOption) (*ListPromoCodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPromoCodesResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListPromoCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) SetPromoCodeActive(ctx context.Context, in *SetPromoCodeActiveRequest, opts ...grpc.CallOption) (*SetPromoCodeActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPromoCodeActiveResponse)
+	err := c.cc.Invoke(ctx, PromoService_SetPromoCodeActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) CreateDiscountRule(ctx context.Context, in *CreateDiscountRuleRequest, opts ...grpc.CallOption) (*CreateDiscountRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDiscountRuleResponse)
+	err := c.cc.Invoke(ctx, PromoService_CreateDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) ListDiscountRules(ctx context.Context, in *ListDiscountRulesRequest, opts ...grpc.CallOption) (*ListDiscountRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, PromoService_ListDiscountRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promoServiceClient) SetDiscountRuleActive(ctx context.Context, in *SetDiscountRuleActiveRequest, opts ...grpc.CallOption) (*SetDiscountRuleActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDiscountRuleActiveResponse)
+	err := c.cc.Invoke(ctx, PromoService_SetDiscountRuleActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromoServiceServer is the server API for PromoService service.
+// All implementations must embed UnimplementedPromoServiceServer
+// for forward compatibility.
+//
+// PromoService is hosted by brewsvc. Customers redeem promo codes through
+// brew.OrderRequest.promo_code; discount rules apply on their own.
+type PromoServiceServer interface {
+	CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error)
+	ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error)
+	SetPromoCodeActive(context.Context, *SetPromoCodeActiveRequest) (*SetPromoCodeActiveResponse, error)
+	CreateDiscountRule(context.Context, *CreateDiscountRuleRequest) (*CreateDiscountRuleResponse, error)
+	ListDiscountRules(context.Context, *ListDiscountRulesRequest) (*ListDiscountRulesResponse, error)
+	SetDiscountRuleActive(context.Context, *SetDiscountRuleActiveRequest) (*SetDiscountRuleActiveResponse, error)
+	mustEmbedUnimplementedPromoServiceServer()
+}
+
+// UnimplementedPromoServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromoServiceServer struct{}
+
+func (UnimplementedPromoServiceServer) CreatePromoCode(context.Context, *CreatePromoCodeRequest) (*CreatePromoCodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePromoCode not implemented")
+}
+func (UnimplementedPromoServiceServer) ListPromoCodes(context.Context, *ListPromoCodesRequest) (*ListPromoCodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPromoCodes not implemented")
+}
+func (UnimplementedPromoServiceServer) SetPromoCodeActive(context.Context, *SetPromoCodeActiveRequest) (*SetPromoCodeActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPromoCodeActive not implemented")
+}
+func (UnimplementedPromoServiceServer) CreateDiscountRule(context.Context, *CreateDiscountRuleRequest) (*CreateDiscountRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDiscountRule not implemented")
+}
+func (UnimplementedPromoServiceServer) ListDiscountRules(context.Context, *ListDiscountRulesRequest) (*ListDiscountRulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDiscountRules not implemented")
+}
+func (UnimplementedPromoServiceServer) SetDiscountRuleActive(context.Context, *SetDiscountRuleActiveRequest) (*SetDiscountRuleActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDiscountRuleActive not implemented")
+}
+func (UnimplementedPromoServiceServer) mustEmbedUnimplementedPromoServiceServer() {}
+func (UnimplementedPromoServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafePromoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromoServiceServer will
+// result in compilation errors.
+type UnsafePromoServiceServer interface {
+	mustEmbedUnimplementedPromoServiceServer()
+}
+
+func RegisterPromoServiceServer(s grpc.ServiceRegistrar, srv PromoServiceServer) {
+	// If the following call panics, it indicates UnimplementedPromoServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromoService_ServiceDesc, srv)
+}
+
+func _PromoService_CreatePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).CreatePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_CreatePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).CreatePromoCode(ctx, req.(*CreatePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ListPromoCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPromoCodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListPromoCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListPromoCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListPromoCodes(ctx, req.(*ListPromoCodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_SetPromoCodeActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPromoCodeActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).SetPromoCodeActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_SetPromoCodeActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).SetPromoCodeActive(ctx, req.(*SetPromoCodeActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_CreateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDiscountRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).CreateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_CreateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).CreateDiscountRule(ctx, req.(*CreateDiscountRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_ListDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiscountRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).ListDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_ListDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).ListDiscountRules(ctx, req.(*ListDiscountRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromoService_SetDiscountRuleActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiscountRuleActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromoServiceServer).SetDiscountRuleActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromoService_SetDiscountRuleActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromoServiceServer).SetDiscountRuleActive(ctx, req.(*SetDiscountRuleActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromoService_ServiceDesc is the grpc.ServiceDesc for PromoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "promo.PromoService",
+	HandlerType: (*PromoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePromoCode",
+			Handler:    _PromoService_CreatePromoCode_Handler,
+		},
+		{
+			MethodName: "ListPromoCodes",
+			Handler:    _PromoService_ListPromoCodes_Handler,
+		},
+		{
+			MethodName: "SetPromoCodeActive",
+			Handler:    _PromoService_SetPromoCodeActive_Handler,
+		},
+		{
+			MethodName: "CreateDiscountRule",
+			Handler:    _PromoService_CreateDiscountRule_Handler,
+		},
+		{
+			MethodName: "ListDiscountRules",
+			Handler:    _PromoService_ListDiscountRules_Handler,
+		},
+		{
+			MethodName: "SetDiscountRuleActive",
+			Handler:    _PromoService_SetDiscountRuleActive_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "promo/promo.proto",
+}
@@ -0,0 +1,254 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: promo/promo.proto
+
+package promoconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	promo "github.com/jany/my-coffee/gen/proto/promo"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PromoServiceName is the fully-qualified name of the PromoService service.
+	PromoServiceName = "promo.PromoService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PromoServiceCreatePromoCodeProcedure is the fully-qualified name of the PromoService's
+	// CreatePromoCode RPC.
+	PromoServiceCreatePromoCodeProcedure = "/promo.PromoService/CreatePromoCode"
+	// PromoServiceListPromoCodesProcedure is the fully-qualified name of the PromoService's
+	// ListPromoCodes RPC.
+	PromoServiceListPromoCodesProcedure = "/promo.PromoService/ListPromoCodes"
+	// PromoServiceSetPromoCodeActiveProcedure is the fully-qualified name of the PromoService's
+	// SetPromoCodeActive RPC.
+	PromoServiceSetPromoCodeActiveProcedure = "/promo.PromoService/SetPromoCodeActive"
+	// PromoServiceCreateDiscountRuleProcedure is the fully-qualified name of the PromoService's
+	// CreateDiscountRule RPC.
+	PromoServiceCreateDiscountRuleProcedure = "/promo.PromoService/CreateDiscountRule"
+	// PromoServiceListDiscountRulesProcedure is the fully-qualified name of the PromoService's
+	// ListDiscountRules RPC.
+	PromoServiceListDiscountRulesProcedure = "/promo.PromoService/ListDiscountRules"
+	// PromoServiceSetDiscountRuleActiveProcedure is the fully-qualified name of the PromoService's
+	// SetDiscountRuleActive RPC.
+	PromoServiceSetDiscountRuleActiveProcedure = "/promo.PromoService/SetDiscountRuleActive"
+)
+
+// PromoServiceClient is a client for the promo.PromoService service.
+type PromoServiceClient interface {
+	CreatePromoCode(context.Context, *connect.Request[promo.CreatePromoCodeRequest]) (*connect.Response[promo.CreatePromoCodeResponse], error)
+	ListPromoCodes(context.Context, *connect.Request[promo.ListPromoCodesRequest]) (*connect.Response[promo.ListPromoCodesResponse], error)
+	SetPromoCodeActive(context.Context, *connect.Request[promo.SetPromoCodeActiveRequest]) (*connect.Response[promo.SetPromoCodeActiveResponse], error)
+	CreateDiscountRule(context.Context, *connect.Request[promo.CreateDiscountRuleRequest]) (*connect.Response[promo.CreateDiscountRuleResponse], error)
+	ListDiscountRules(context.Context, *connect.Request[promo.ListDiscountRulesRequest]) (*connect.Response[promo.ListDiscountRulesResponse], error)
+	SetDiscountRuleActive(context.Context, *connect.Request[promo.SetDiscountRuleActiveRequest]) (*connect.Response[promo.SetDiscountRuleActiveResponse], error)
+}
+
+// NewPromoServiceClient constructs a client for the promo.PromoService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPromoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PromoServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	promoServiceMethods := promo.File_promo_promo_proto.Services().ByName("PromoService").Methods()
+	return &promoServiceClient{
+		createPromoCode: connect.NewClient[promo.CreatePromoCodeRequest, promo.CreatePromoCodeResponse](
+			httpClient,
+			baseURL+PromoServiceCreatePromoCodeProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("CreatePromoCode")),
+			connect.WithClientOptions(opts...),
+		),
+		listPromoCodes: connect.NewClient[promo.ListPromoCodesRequest, promo.ListPromoCodesResponse](
+			httpClient,
+			baseURL+PromoServiceListPromoCodesProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("ListPromoCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		setPromoCodeActive: connect.NewClient[promo.SetPromoCodeActiveRequest, promo.SetPromoCodeActiveResponse](
+			httpClient,
+			baseURL+PromoServiceSetPromoCodeActiveProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("SetPromoCodeActive")),
+			connect.WithClientOptions(opts...),
+		),
+		createDiscountRule: connect.NewClient[promo.CreateDiscountRuleRequest, promo.CreateDiscountRuleResponse](
+			httpClient,
+			baseURL+PromoServiceCreateDiscountRuleProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("CreateDiscountRule")),
+			connect.WithClientOptions(opts...),
+		),
+		listDiscountRules: connect.NewClient[promo.ListDiscountRulesRequest, promo.ListDiscountRulesResponse](
+			httpClient,
+			baseURL+PromoServiceListDiscountRulesProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("ListDiscountRules")),
+			connect.WithClientOptions(opts...),
+		),
+		setDiscountRuleActive: connect.NewClient[promo.SetDiscountRuleActiveRequest, promo.SetDiscountRuleActiveResponse](
+			httpClient,
+			baseURL+PromoServiceSetDiscountRuleActiveProcedure,
+			connect.WithSchema(promoServiceMethods.ByName("SetDiscountRuleActive")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// promoServiceClient implements PromoServiceClient.
+type promoServiceClient struct {
+	createPromoCode       *connect.Client[promo.CreatePromoCodeRequest, promo.CreatePromoCodeResponse]
+	listPromoCodes        *connect.Client[promo.ListPromoCodesRequest, promo.ListPromoCodesResponse]
+	setPromoCodeActive    *connect.Client[promo.SetPromoCodeActiveRequest, promo.SetPromoCodeActiveResponse]
+	createDiscountRule    *connect.Client[promo.CreateDiscountRuleRequest, promo.CreateDiscountRuleResponse]
+	listDiscountRules     *connect.Client[promo.ListDiscountRulesRequest, promo.ListDiscountRulesResponse]
+	setDiscountRuleActive *connect.Client[promo.SetDiscountRuleActiveRequest, promo.SetDiscountRuleActiveResponse]
+}
+
+// CreatePromoCode calls promo.PromoService.CreatePromoCode.
+func (c *promoServiceClient) CreatePromoCode(ctx context.Context, req *connect.Request[promo.CreatePromoCodeRequest]) (*connect.Response[promo.CreatePromoCodeResponse], error) {
+	return c.createPromoCode.CallUnary(ctx, req)
+}
+
+// ListPromoCodes calls promo.PromoService.ListPromoCodes.
+func (c *promoServiceClient) ListPromoCodes(ctx context.Context, req *connect.Request[promo.ListPromoCodesRequest]) (*connect.Response[promo.ListPromoCodesResponse], error) {
+	return c.listPromoCodes.CallUnary(ctx, req)
+}
+
+// SetPromoCodeActive calls promo.PromoService.SetPromoCodeActive.
+func (c *promoServiceClient) SetPromoCodeActive(ctx context.Context, req *connect.Request[promo.SetPromoCodeActiveRequest]) (*connect.Response[promo.SetPromoCodeActiveResponse], error) {
+	return c.setPromoCodeActive.CallUnary(ctx, req)
+}
+
+// CreateDiscountRule calls promo.PromoService.CreateDiscountRule.
+func (c *promoServiceClient) CreateDiscountRule(ctx context.Context, req *connect.Request[promo.CreateDiscountRuleRequest]) (*connect.Response[promo.CreateDiscountRuleResponse], error) {
+	return c.createDiscountRule.CallUnary(ctx, req)
+}
+
+// ListDiscountRules calls promo.PromoService.ListDiscountRules.
+func (c *promoServiceClient) ListDiscountRules(ctx context.Context, req *connect.Request[promo.ListDiscountRulesRequest]) (*connect.Response[promo.ListDiscountRulesResponse], error) {
+	return c.listDiscountRules.CallUnary(ctx, req)
+}
+
+// SetDiscountRuleActive calls promo.PromoService.SetDiscountRuleActive.
+func (c *promoServiceClient) SetDiscountRuleActive(ctx context.Context, req *connect.Request[promo.SetDiscountRuleActiveRequest]) (*connect.Response[promo.SetDiscountRuleActiveResponse], error) {
+	return c.setDiscountRuleActive.CallUnary(ctx, req)
+}
+
+// PromoServiceHandler is an implementation of the promo.PromoService service.
+type PromoServiceHandler interface {
+	CreatePromoCode(context.Context, *connect.Request[promo.CreatePromoCodeRequest]) (*connect.Response[promo.CreatePromoCodeResponse], error)
+	ListPromoCodes(context.Context, *connect.Request[promo.ListPromoCodesRequest]) (*connect.Response[promo.ListPromoCodesResponse], error)
+	SetPromoCodeActive(context.Context, *connect.Request[promo.SetPromoCodeActiveRequest]) (*connect.Response[promo.SetPromoCodeActiveResponse], error)
+	CreateDiscountRule(context.Context, *connect.Request[promo.CreateDiscountRuleRequest]) (*connect.Response[promo.CreateDiscountRuleResponse], error)
+	ListDiscountRules(context.Context, *connect.Request[promo.ListDiscountRulesRequest]) (*connect.Response[promo.ListDiscountRulesResponse], error)
+	SetDiscountRuleActive(context.Context, *connect.Request[promo.SetDiscountRuleActiveRequest]) (*connect.Response[promo.SetDiscountRuleActiveResponse], error)
+}
+
+// NewPromoServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPromoServiceHandler(svc PromoServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	promoServiceMethods := promo.File_promo_promo_proto.Services().ByName("PromoService").Methods()
+	promoServiceCreatePromoCodeHandler := connect.NewUnaryHandler(
+		PromoServiceCreatePromoCodeProcedure,
+		svc.CreatePromoCode,
+		connect.WithSchema(promoServiceMethods.ByName("CreatePromoCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	promoServiceListPromoCodesHandler := connect.NewUnaryHandler(
+		PromoServiceListPromoCodesProcedure,
+		svc.ListPromoCodes,
+		connect.WithSchema(promoServiceMethods.ByName("ListPromoCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	promoServiceSetPromoCodeActiveHandler := connect.NewUnaryHandler(
+		PromoServiceSetPromoCodeActiveProcedure,
+		svc.SetPromoCodeActive,
+		connect.WithSchema(promoServiceMethods.ByName("SetPromoCodeActive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	promoServiceCreateDiscountRuleHandler := connect.NewUnaryHandler(
+		PromoServiceCreateDiscountRuleProcedure,
+		svc.CreateDiscountRule,
+		connect.WithSchema(promoServiceMethods.ByName("CreateDiscountRule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	promoServiceListDiscountRulesHandler := connect.NewUnaryHandler(
+		PromoServiceListDiscountRulesProcedure,
+		svc.ListDiscountRules,
+		connect.WithSchema(promoServiceMethods.ByName("ListDiscountRules")),
+		connect.WithHandlerOptions(opts...),
+	)
+	promoServiceSetDiscountRuleActiveHandler := connect.NewUnaryHandler(
+		PromoServiceSetDiscountRuleActiveProcedure,
+		svc.SetDiscountRuleActive,
+		connect.WithSchema(promoServiceMethods.ByName("SetDiscountRuleActive")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/promo.PromoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PromoServiceCreatePromoCodeProcedure:
+			promoServiceCreatePromoCodeHandler.ServeHTTP(w, r)
+		case PromoServiceListPromoCodesProcedure:
+			promoServiceListPromoCodesHandler.ServeHTTP(w, r)
+		case PromoServiceSetPromoCodeActiveProcedure:
+			promoServiceSetPromoCodeActiveHandler.ServeHTTP(w, r)
+		case PromoServiceCreateDiscountRuleProcedure:
+			promoServiceCreateDiscountRuleHandler.ServeHTTP(w, r)
+		case PromoServiceListDiscountRulesProcedure:
+			promoServiceListDiscountRulesHandler.ServeHTTP(w, r)
+		case PromoServiceSetDiscountRuleActiveProcedure:
+			promoServiceSetDiscountRuleActiveHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPromoServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPromoServiceHandler struct{}
+
+func (UnimplementedPromoServiceHandler) CreatePromoCode(context.Context, *connect.Request[promo.CreatePromoCodeRequest]) (*connect.Response[promo.CreatePromoCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.CreatePromoCode is not implemented"))
+}
+
+func (UnimplementedPromoServiceHandler) ListPromoCodes(context.Context, *connect.Request[promo.ListPromoCodesRequest]) (*connect.Response[promo.ListPromoCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.ListPromoCodes is not implemented"))
+}
+
+func (UnimplementedPromoServiceHandler) SetPromoCodeActive(context.Context, *connect.Request[promo.SetPromoCodeActiveRequest]) (*connect.Response[promo.SetPromoCodeActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.SetPromoCodeActive is not implemented"))
+}
+
+func (UnimplementedPromoServiceHandler) CreateDiscountRule(context.Context, *connect.Request[promo.CreateDiscountRuleRequest]) (*connect.Response[promo.CreateDiscountRuleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.CreateDiscountRule is not implemented"))
+}
+
+func (UnimplementedPromoServiceHandler) ListDiscountRules(context.Context, *connect.Request[promo.ListDiscountRulesRequest]) (*connect.Response[promo.ListDiscountRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.ListDiscountRules is not implemented"))
+}
+
+func (UnimplementedPromoServiceHandler) SetDiscountRuleActive(context.Context, *connect.Request[promo.SetDiscountRuleActiveRequest]) (*connect.Response[promo.SetDiscountRuleActiveResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("promo.PromoService.SetDiscountRuleActive is not implemented"))
+}
@@ -0,0 +1,986 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: report/report.proto
+
+package report
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DateRange includes start and excludes end.
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_report_report_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DateRange) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DateRange) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ItemSales struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MenuItemName  string                 `protobuf:"bytes,1,opt,name=menu_item_name,json=menuItemName,proto3" json:"menu_item_name,omitempty"`
+	Orders        int64                  `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Drinks        int64                  `protobuf:"varint,3,opt,name=drinks,proto3" json:"drinks,omitempty"`
+	SubtotalCents int64                  `protobuf:"varint,4,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"`
+	DiscountCents int64                  `protobuf:"varint,5,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`
+	TotalCents    int64                  `protobuf:"varint,6,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	RefundedCents int64                  `protobuf:"varint,7,opt,name=refunded_cents,json=refundedCents,proto3" json:"refunded_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemSales) Reset() {
+	*x = ItemSales{}
+	mi := &file_report_report_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemSales) ProtoMessage() {}
+
+func (x *ItemSales) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemSales.ProtoReflect.Descriptor instead.
+func (*ItemSales) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ItemSales) GetMenuItemName() string {
+	if x != nil {
+		return x.MenuItemName
+	}
+	return ""
+}
+
+func (x *ItemSales) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *ItemSales) GetDrinks() int64 {
+	if x != nil {
+		return x.Drinks
+	}
+	return 0
+}
+
+func (x *ItemSales) GetSubtotalCents() int64 {
+	if x != nil {
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *ItemSales) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *ItemSales) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *ItemSales) GetRefundedCents() int64 {
+	if x != nil {
+		return x.RefundedCents
+	}
+	return 0
+}
+
+type GetSalesByItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByItemRequest) Reset() {
+	*x = GetSalesByItemRequest{}
+	mi := &file_report_report_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByItemRequest) ProtoMessage() {}
+
+func (x *GetSalesByItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByItemRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesByItemRequest) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetSalesByItemRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetSalesByItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ItemSales           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByItemResponse) Reset() {
+	*x = GetSalesByItemResponse{}
+	mi := &file_report_report_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByItemResponse) ProtoMessage() {}
+
+func (x *GetSalesByItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByItemResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesByItemResponse) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSalesByItemResponse) GetItems() []*ItemSales {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetSalesByItemResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type HourSales struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 to 23
+	Hour          int32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Orders        int64 `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Drinks        int64 `protobuf:"varint,3,opt,name=drinks,proto3" json:"drinks,omitempty"`
+	TotalCents    int64 `protobuf:"varint,4,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourSales) Reset() {
+	*x = HourSales{}
+	mi := &file_report_report_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourSales) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourSales) ProtoMessage() {}
+
+func (x *HourSales) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourSales.ProtoReflect.Descriptor instead.
+func (*HourSales) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HourSales) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourSales) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *HourSales) GetDrinks() int64 {
+	if x != nil {
+		return x.Drinks
+	}
+	return 0
+}
+
+func (x *HourSales) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+type GetSalesByHourRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByHourRequest) Reset() {
+	*x = GetSalesByHourRequest{}
+	mi := &file_report_report_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByHourRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByHourRequest) ProtoMessage() {}
+
+func (x *GetSalesByHourRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByHourRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesByHourRequest) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetSalesByHourRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetSalesByHourResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All 24 hours, including those without sales.
+	Hours         []*HourSales `protobuf:"bytes,1,rep,name=hours,proto3" json:"hours,omitempty"`
+	Currency      string       `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	TimeZone      string       `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesByHourResponse) Reset() {
+	*x = GetSalesByHourResponse{}
+	mi := &file_report_report_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesByHourResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesByHourResponse) ProtoMessage() {}
+
+func (x *GetSalesByHourResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesByHourResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesByHourResponse) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetSalesByHourResponse) GetHours() []*HourSales {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *GetSalesByHourResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetSalesByHourResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type OutcomeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        int64                  `protobuf:"varint,1,opt,name=orders,proto3" json:"orders,omitempty"`
+	Drinks        int64                  `protobuf:"varint,2,opt,name=drinks,proto3" json:"drinks,omitempty"`
+	TotalCents    int64                  `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutcomeCount) Reset() {
+	*x = OutcomeCount{}
+	mi := &file_report_report_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutcomeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutcomeCount) ProtoMessage() {}
+
+func (x *OutcomeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutcomeCount.ProtoReflect.Descriptor instead.
+func (*OutcomeCount) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OutcomeCount) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *OutcomeCount) GetDrinks() int64 {
+	if x != nil {
+		return x.Drinks
+	}
+	return 0
+}
+
+func (x *OutcomeCount) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+type GetStatusOutcomesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusOutcomesRequest) Reset() {
+	*x = GetStatusOutcomesRequest{}
+	mi := &file_report_report_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusOutcomesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusOutcomesRequest) ProtoMessage() {}
+
+func (x *GetStatusOutcomesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusOutcomesRequest.ProtoReflect.Descriptor instead.
+func (*GetStatusOutcomesRequest) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStatusOutcomesRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetStatusOutcomesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// READY orders.
+	Completed *OutcomeCount `protobuf:"bytes,1,opt,name=completed,proto3" json:"completed,omitempty"`
+	Cancelled *OutcomeCount `protobuf:"bytes,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	// Orders still waiting for payment or being made.
+	Open          *OutcomeCount `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`
+	Currency      string        `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatusOutcomesResponse) Reset() {
+	*x = GetStatusOutcomesResponse{}
+	mi := &file_report_report_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatusOutcomesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatusOutcomesResponse) ProtoMessage() {}
+
+func (x *GetStatusOutcomesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatusOutcomesResponse.ProtoReflect.Descriptor instead.
+func (*GetStatusOutcomesResponse) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStatusOutcomesResponse) GetCompleted() *OutcomeCount {
+	if x != nil {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *GetStatusOutcomesResponse) GetCancelled() *OutcomeCount {
+	if x != nil {
+		return x.Cancelled
+	}
+	return nil
+}
+
+func (x *GetStatusOutcomesResponse) GetOpen() *OutcomeCount {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *GetStatusOutcomesResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetPrepTimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrepTimeRequest) Reset() {
+	*x = GetPrepTimeRequest{}
+	mi := &file_report_report_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrepTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrepTimeRequest) ProtoMessage() {}
+
+func (x *GetPrepTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrepTimeRequest.ProtoReflect.Descriptor instead.
+func (*GetPrepTimeRequest) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPrepTimeRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type GetPrepTimeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Orders that have been READY; older orders without a ready time are
+	// left out.
+	Orders         int64   `protobuf:"varint,1,opt,name=orders,proto3" json:"orders,omitempty"`
+	AverageSeconds float64 `protobuf:"fixed64,2,opt,name=average_seconds,json=averageSeconds,proto3" json:"average_seconds,omitempty"`
+	MedianSeconds  float64 `protobuf:"fixed64,3,opt,name=median_seconds,json=medianSeconds,proto3" json:"median_seconds,omitempty"`
+	MaxSeconds     float64 `protobuf:"fixed64,4,opt,name=max_seconds,json=maxSeconds,proto3" json:"max_seconds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPrepTimeResponse) Reset() {
+	*x = GetPrepTimeResponse{}
+	mi := &file_report_report_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrepTimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrepTimeResponse) ProtoMessage() {}
+
+func (x *GetPrepTimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrepTimeResponse.ProtoReflect.Descriptor instead.
+func (*GetPrepTimeResponse) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPrepTimeResponse) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *GetPrepTimeResponse) GetAverageSeconds() float64 {
+	if x != nil {
+		return x.AverageSeconds
+	}
+	return 0
+}
+
+func (x *GetPrepTimeResponse) GetMedianSeconds() float64 {
+	if x != nil {
+		return x.MedianSeconds
+	}
+	return 0
+}
+
+func (x *GetPrepTimeResponse) GetMaxSeconds() float64 {
+	if x != nil {
+		return x.MaxSeconds
+	}
+	return 0
+}
+
+type GetTopItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Range *DateRange             `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	// 5 when zero.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopItemsRequest) Reset() {
+	*x = GetTopItemsRequest{}
+	mi := &file_report_report_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopItemsRequest) ProtoMessage() {}
+
+func (x *GetTopItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetTopItemsRequest) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetTopItemsRequest) GetRange() *DateRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *GetTopItemsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetTopItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ItemSales           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTopItemsResponse) Reset() {
+	*x = GetTopItemsResponse{}
+	mi := &file_report_report_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTopItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopItemsResponse) ProtoMessage() {}
+
+func (x *GetTopItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_report_report_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetTopItemsResponse) Descriptor() ([]byte, []int) {
+	return file_report_report_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTopItemsResponse) GetItems() []*ItemSales {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetTopItemsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+var File_report_report_proto protoreflect.FileDescriptor
+
+const file_report_report_proto_rawDesc = "" +
+	"\n" +
+	"\x13report/report.proto\x12\x06report\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"{\n" +
+	"\tDateRange\x128\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x05start\x124\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x03end\"\xf7\x01\n" +
+	"\tItemSales\x12$\n" +
+	"\x0emenu_item_name\x18\x01 \x01(\tR\fmenuItemName\x12\x16\n" +
+	"\x06orders\x18\x02 \x01(\x03R\x06orders\x12\x16\n" +
+	"\x06drinks\x18\x03 \x01(\x03R\x06drinks\x12%\n" +
+	"\x0esubtotal_cents\x18\x04 \x01(\x03R\rsubtotalCents\x12%\n" +
+	"\x0ediscount_cents\x18\x05 \x01(\x03R\rdiscountCents\x12\x1f\n" +
+	"\vtotal_cents\x18\x06 \x01(\x03R\n" +
+	"totalCents\x12%\n" +
+	"\x0erefunded_cents\x18\a \x01(\x03R\rrefundedCents\"H\n" +
+	"\x15GetSalesByItemRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\v2\x11.report.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\x05range\"]\n" +
+	"\x16GetSalesByItemResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.report.ItemSalesR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"p\n" +
+	"\tHourSales\x12\x12\n" +
+	"\x04hour\x18\x01 \x01(\x05R\x04hour\x12\x16\n" +
+	"\x06orders\x18\x02 \x01(\x03R\x06orders\x12\x16\n" +
+	"\x06drinks\x18\x03 \x01(\x03R\x06drinks\x12\x1f\n" +
+	"\vtotal_cents\x18\x04 \x01(\x03R\n" +
+	"totalCents\"H\n" +
+	"\x15GetSalesByHourRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\v2\x11.report.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\x05range\"z\n" +
+	"\x16GetSalesByHourResponse\x12'\n" +
+	"\x05hours\x18\x01 \x03(\v2\x11.report.HourSalesR\x05hours\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"_\n" +
+	"\fOutcomeCount\x12\x16\n" +
+	"\x06orders\x18\x01 \x01(\x03R\x06orders\x12\x16\n" +
+	"\x06drinks\x18\x02 \x01(\x03R\x06drinks\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\"K\n" +
+	"\x18GetStatusOutcomesRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\v2\x11.report.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\x05range\"\xc9\x01\n" +
+	"\x19GetStatusOutcomesResponse\x122\n" +
+	"\tcompleted\x18\x01 \x01(\v2\x14.report.OutcomeCountR\tcompleted\x122\n" +
+	"\tcancelled\x18\x02 \x01(\v2\x14.report.OutcomeCountR\tcancelled\x12(\n" +
+	"\x04open\x18\x03 \x01(\v2\x14.report.OutcomeCountR\x04open\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"E\n" +
+	"\x12GetPrepTimeRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\v2\x11.report.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\x05range\"\x9e\x01\n" +
+	"\x13GetPrepTimeResponse\x12\x16\n" +
+	"\x06orders\x18\x01 \x01(\x03R\x06orders\x12'\n" +
+	"\x0faverage_seconds\x18\x02 \x01(\x01R\x0eaverageSeconds\x12%\n" +
+	"\x0emedian_seconds\x18\x03 \x01(\x01R\rmedianSeconds\x12\x1f\n" +
+	"\vmax_seconds\x18\x04 \x01(\x01R\n" +
+	"maxSeconds\"f\n" +
+	"\x12GetTopItemsRequest\x12/\n" +
+	"\x05range\x18\x01 \x01(\v2\x11.report.DateRangeB\x06\xbaH\x03\xc8\x01\x01R\x05range\x12\x1f\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\x05limit\"Z\n" +
+	"\x13GetTopItemsResponse\x12'\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.report.ItemSalesR\x05items\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency2\xb4\x03\n" +
+	"\rReportService\x12T\n" +
+	"\x0eGetSalesByItem\x12\x1d.report.GetSalesByItemRequest\x1a\x1e.report.GetSalesByItemResponse\"\x03\x90\x02\x01\x12T\n" +
+	"\x0eGetSalesByHour\x12\x1d.report.GetSalesByHourRequest\x1a\x1e.report.GetSalesByHourResponse\"\x03\x90\x02\x01\x12]\n" +
+	"\x11GetStatusOutcomes\x12 .report.GetStatusOutcomesRequest\x1a!.report.GetStatusOutcomesResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\vGetPrepTime\x12\x1a.report.GetPrepTimeRequest\x1a\x1b.report.GetPrepTimeResponse\"\x03\x90\x02\x01\x12K\n" +
+	"\vGetTopItems\x12\x1a.report.GetTopItemsRequest\x1a\x1b.report.GetTopItemsResponse\"\x03\x90\x02\x01B}\n" +
+	"\n" +
+	"com.reportB\vReportProtoP\x01Z*github.com/jany/my-coffee/gen/proto/report\xa2\x02\x03RXX\xaa\x02\x06Report\xca\x02\x06Report\xe2\x02\x12Report\\GPBMetadata\xea\x02\x06Reportb\x06proto3"
+
+var (
+	file_report_report_proto_rawDescOnce sync.Once
+	file_report_report_proto_rawDescData []byte
+)
+
+func file_report_report_proto_rawDescGZIP() []byte {
+	file_report_report_proto_rawDescOnce.Do(func() {
+		file_report_report_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_report_report_proto_rawDesc), len(file_report_report_proto_rawDesc)))
+	})
+	return file_report_report_proto_rawDescData
+}
+
+var file_report_report_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_report_report_proto_goTypes = []any{
+	(*DateRange)(nil),                 // 0: report.DateRange
+	(*ItemSales)(nil),                 // 1: report.ItemSales
+	(*GetSalesByItemRequest)(nil),     // 2: report.GetSalesByItemRequest
+	(*GetSalesByItemResponse)(nil),    // 3: report.GetSalesByItemResponse
+	(*HourSales)(nil),                 // 4: report.HourSales
+	(*GetSalesByHourRequest)(nil),     // 5: report.GetSalesByHourRequest
+	(*GetSalesByHourResponse)(nil),    // 6: report.GetSalesByHourResponse
+	(*OutcomeCount)(nil),              // 7: report.OutcomeCount
+	(*GetStatusOutcomesRequest)(nil),  // 8: report.GetStatusOutcomesRequest
+	(*GetStatusOutcomesResponse)(nil), // 9: report.GetStatusOutcomesResponse
+	(*GetPrepTimeRequest)(nil),        // 10: report.GetPrepTimeRequest
+	(*GetPrepTimeResponse)(nil),       // 11: report.GetPrepTimeResponse
+	(*GetTopItemsRequest)(nil),        // 12: report.GetTopItemsRequest
+	(*GetTopItemsResponse)(nil),       // 13: report.GetTopItemsResponse
+	(*timestamppb.Timestamp)(nil),     // 14: google.protobuf.Timestamp
+}
+var file_report_report_proto_depIdxs = []int32{
+	14, // 0: report.DateRange.start:type_name -> google.protobuf.Timestamp
+	14, // 1: report.DateRange.end:type_name -> google.protobuf.Timestamp
+	0,  // 2: report.GetSalesByItemRequest.range:type_name -> report.DateRange
+	1,  // 3: report.GetSalesByItemResponse.items:type_name -> report.ItemSales
+	0,  // 4: report.GetSalesByHourRequest.range:type_name -> report.DateRange
+	4,  // 5: report.GetSalesByHourResponse.hours:type_name -> report.HourSales
+	0,  // 6: report.GetStatusOutcomesRequest.range:type_name -> report.DateRange
+	7,  // 7: report.GetStatusOutcomesResponse.completed:type_name -> report.OutcomeCount
+	7,  // 8: report.GetStatusOutcomesResponse.cancelled:type_name -> report.OutcomeCount
+	7,  // 9: report.GetStatusOutcomesResponse.open:type_name -> report.OutcomeCount
+	0,  // 10: report.GetPrepTimeRequest.range:type_name -> report.DateRange
+	0,  // 11: report.GetTopItemsRequest.range:type_name -> report.DateRange
+	1,  // 12: report.GetTopItemsResponse.items:type_name -> report.ItemSales
+	2,  // 13: report.ReportService.GetSalesByItem:input_type -> report.GetSalesByItemRequest
+	5,  // 14: report.ReportService.GetSalesByHour:input_type -> report.GetSalesByHourRequest
+	8,  // 15: report.ReportService.GetStatusOutcomes:input_type -> report.GetStatusOutcomesRequest
+	10, // 16: report.ReportService.GetPrepTime:input_type -> report.GetPrepTimeRequest
+	12, // 17: report.ReportService.GetTopItems:input_type -> report.GetTopItemsRequest
+	3,  // 18: report.ReportService.GetSalesByItem:output_type -> report.GetSalesByItemResponse
+	6,  // 19: report.ReportService.GetSalesByHour:output_type -> report.GetSalesByHourResponse
+	9,  // 20: report.ReportService.GetStatusOutcomes:output_type -> report.GetStatusOutcomesResponse
+	11, // 21: report.ReportService.GetPrepTime:output_type -> report.GetPrepTimeResponse
+	13, // 22: report.ReportService.GetTopItems:output_type -> report.GetTopItemsResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_report_report_proto_init() }
+func file_report_report_proto_init() {
+	if File_report_report_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_report_report_proto_rawDesc), len(file_report_report_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_report_report_proto_goTypes,
+		DependencyIndexes: file_report_report_proto_depIdxs,
+		MessageInfos:      file_report_report_proto_msgTypes,
+	}.Build()
+	File_report_report_proto = out.File
+	file_report_report_proto_goTypes = nil
+	file_report_report_proto_depIdxs = nil
+}
@@ -0,0 +1,291 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: report/report.proto
+
+package report
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReportService_GetSalesByItem_FullMethodName    = "/report.ReportService/GetSalesByItem"
+	ReportService_GetSalesByHour_FullMethodName    = "/report.ReportService/GetSalesByHour"
+	ReportService_GetStatusOutcomes_FullMethodName = "/report.ReportService/GetStatusOutcomes"
+	ReportService_GetPrepTime_FullMethodName       = "/report.ReportService/GetPrepTime"
+	ReportService_GetTopItems_FullMethodName       = "/report.ReportService/GetTopItems"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReportService is hosted by brewsvc. Every report covers the orders placed
+// in a DateRange; amounts are in cents of currency.
+type ReportServiceClient interface {
+	// Sales per menu item, best selling first. Cancelled orders and orders
+	// still waiting to be paid are left out, as for every sales report.
+	GetSalesByItem(ctx context.Context, in *GetSalesByItemRequest, opts ...grpc.CallOption) (*GetSalesByItemResponse, error)
+	// Sales per hour of the day in the shop's time zone.
+	GetSalesByHour(ctx context.Context, in *GetSalesByHourRequest, opts ...grpc.CallOption) (*GetSalesByHourResponse, error)
+	// How many orders were completed, cancelled or are still open.
+	GetStatusOutcomes(ctx context.Context, in *GetStatusOutcomesRequest, opts ...grpc.CallOption) (*GetStatusOutcomesResponse, error)
+	// Time from placing an order to it being READY.
+	GetPrepTime(ctx context.Context, in *GetPrepTimeRequest, opts ...grpc.CallOption) (*GetPrepTimeResponse, error)
+	// The menu items that sold the most drinks.
+	GetTopItems(ctx context.Context, in *GetTopItemsRequest, opts ...grpc.CallOption) (*GetTopItemsResponse, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) GetSalesByItem(ctx context.Context, in *GetSalesByItemRequest, opts ...grpc.CallOption) (*GetSalesByItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesByItemResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetSalesByItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetSalesByHour(ctx context.Context, in *GetSalesByHourRequest, opts ...grpc.CallOption) (*GetSalesByHourResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesByHourResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetSalesByHour_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetStatusOutcomes(ctx context.Context, in *GetStatusOutcomesRequest, opts ...grpc.CallOption) (*GetStatusOutcomesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStatusOutcomesResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetStatusOutcomes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetPrepTime(ctx context.Context, in *GetPrepTimeRequest, opts ...grpc.CallOption) (*GetPrepTimeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPrepTimeResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetPrepTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reportServiceClient) GetTopItems(ctx context.Context, in *GetTopItemsRequest, opts ...grpc.CallOption) (*GetTopItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTopItemsResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetTopItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations must embed UnimplementedReportServiceServer
+// for forward compatibility.
+//
+// ReportService is hosted by brewsvc. Every report covers the orders placed
+// in a DateRange; amounts are in cents of currency.
+type ReportServiceServer interface {
+	// Sales per menu item, best selling first. Cancelled orders and orders
+	// still waiting to be paid are left out, as for every sales report.
+	GetSalesByItem(context.Context, *GetSalesByItemRequest) (*GetSalesByItemResponse, error)
+	// Sales per hour of the day in the shop's time zone.
+	GetSalesByHour(context.Context, *GetSalesByHourRequest) (*GetSalesByHourResponse, error)
+	// How many orders were completed, cancelled or are still open.
+	GetStatusOutcomes(context.Context, *GetStatusOutcomesRequest) (*GetStatusOutcomesResponse, error)
+	// Time from placing an order to it being READY.
+	GetPrepTime(context.Context, *GetPrepTimeRequest) (*GetPrepTimeResponse, error)
+	// The menu items that sold the most drinks.
+	GetTopItems(context.Context, *GetTopItemsRequest) (*GetTopItemsResponse, error)
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+// UnimplementedReportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReportServiceServer struct{}
+
+func (UnimplementedReportServiceServer) GetSalesByItem(context.Context, *GetSalesByItemRequest) (*GetSalesByItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSalesByItem not implemented")
+}
+func (UnimplementedReportServiceServer) GetSalesByHour(context.Context, *GetSalesByHourRequest) (*GetSalesByHourResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSalesByHour not implemented")
+}
+func (UnimplementedReportServiceServer) GetStatusOutcomes(context.Context, *GetStatusOutcomesRequest) (*GetStatusOutcomesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatusOutcomes not implemented")
+}
+func (UnimplementedReportServiceServer) GetPrepTime(context.Context, *GetPrepTimeRequest) (*GetPrepTimeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPrepTime not implemented")
+}
+func (UnimplementedReportServiceServer) GetTopItems(context.Context, *GetTopItemsRequest) (*GetTopItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTopItems not implemented")
+}
+func (UnimplementedReportServiceServer) mustEmbedUnimplementedReportServiceServer() {}
+func (UnimplementedReportServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
+// result in compilation errors.
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
+}
+
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	// If the following call panics, it indicates UnimplementedReportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReportService_ServiceDesc, srv)
+}
+
+func _ReportService_GetSalesByItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesByItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetSalesByItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetSalesByItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetSalesByItem(ctx, req.(*GetSalesByItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetSalesByHour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesByHourRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetSalesByHour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetSalesByHour_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetSalesByHour(ctx, req.(*GetSalesByHourRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetStatusOutcomes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusOutcomesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetStatusOutcomes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetStatusOutcomes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetStatusOutcomes(ctx, req.(*GetStatusOutcomesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetPrepTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrepTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetPrepTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetPrepTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetPrepTime(ctx, req.(*GetPrepTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReportService_GetTopItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReportServiceServer).GetTopItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReportService_GetTopItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReportServiceServer).GetTopItems(ctx, req.(*GetTopItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "report.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSalesByItem",
+			Handler:    _ReportService_GetSalesByItem_Handler,
+		},
+		{
+			MethodName: "GetSalesByHour",
+			Handler:    _ReportService_GetSalesByHour_Handler,
+		},
+		{
+			MethodName: "GetStatusOutcomes",
+			Handler:    _ReportService_GetStatusOutcomes_Handler,
+		},
+		{
+			MethodName: "GetPrepTime",
+			Handler:    _ReportService_GetPrepTime_Handler,
+		},
+		{
+			MethodName: "GetTopItems",
+			Handler:    _ReportService_GetTopItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "report/report.proto",
+}
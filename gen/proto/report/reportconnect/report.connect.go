@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: report/report.proto
+
+package reportconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	report "github.com/jany/my-coffee/gen/proto/report"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReportServiceName is the fully-qualified name of the ReportService service.
+	ReportServiceName = "report.ReportService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReportServiceGetSalesByItemProcedure is the fully-qualified name of the ReportService's
+	// GetSalesByItem RPC.
+	ReportServiceGetSalesByItemProcedure = "/report.ReportService/GetSalesByItem"
+	// ReportServiceGetSalesByHourProcedure is the fully-qualified name of the ReportService's
+	// GetSalesByHour RPC.
+	ReportServiceGetSalesByHourProcedure = "/report.ReportService/GetSalesByHour"
+	// ReportServiceGetStatusOutcomesProcedure is the fully-qualified name of the ReportService's
+	// GetStatusOutcomes RPC.
+	ReportServiceGetStatusOutcomesProcedure = "/report.ReportService/GetStatusOutcomes"
+	// ReportServiceGetPrepTimeProcedure is the fully-qualified name of the ReportService's GetPrepTime
+	// RPC.
+	ReportServiceGetPrepTimeProcedure = "/report.ReportService/GetPrepTime"
+	// ReportServiceGetTopItemsProcedure is the fully-qualified name of the ReportService's GetTopItems
+	// RPC.
+	ReportServiceGetTopItemsProcedure = "/report.ReportService/GetTopItems"
+)
+
+// ReportServiceClient is a client for the report.ReportService service.
+type ReportServiceClient interface {
+	// Sales per menu item, best selling first. Cancelled orders and orders
+	// still waiting to be paid are left out, as for every sales report.
+	GetSalesByItem(context.Context, *connect.Request[report.GetSalesByItemRequest]) (*connect.Response[report.GetSalesByItemResponse], error)
+	// Sales per hour of the day in the shop's time zone.
+	GetSalesByHour(context.Context, *connect.Request[report.GetSalesByHourRequest]) (*connect.Response[report.GetSalesByHourResponse], error)
+	// How many orders were completed, cancelled or are still open.
+	GetStatusOutcomes(context.Context, *connect.Request[report.GetStatusOutcomesRequest]) (*connect.Response[report.GetStatusOutcomesResponse], error)
+	// Time from placing an order to it being READY.
+	GetPrepTime(context.Context, *connect.Request[report.GetPrepTimeRequest]) (*connect.Response[report.GetPrepTimeResponse], error)
+	// The menu items that sold the most drinks.
+	GetTopItems(context.Context, *connect.Request[report.GetTopItemsRequest]) (*connect.Response[report.GetTopItemsResponse], error)
+}
+
+// NewReportServiceClient constructs a client for the report.ReportService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReportServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	reportServiceMethods := report.File_report_report_proto.Services().ByName("ReportService").Methods()
+	return &reportServiceClient{
+		getSalesByItem: connect.NewClient[report.GetSalesByItemRequest, report.GetSalesByItemResponse](
+			httpClient,
+			baseURL+ReportServiceGetSalesByItemProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetSalesByItem")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getSalesByHour: connect.NewClient[report.GetSalesByHourRequest, report.GetSalesByHourResponse](
+			httpClient,
+			baseURL+ReportServiceGetSalesByHourProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetSalesByHour")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getStatusOutcomes: connect.NewClient[report.GetStatusOutcomesRequest, report.GetStatusOutcomesResponse](
+			httpClient,
+			baseURL+ReportServiceGetStatusOutcomesProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetStatusOutcomes")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPrepTime: connect.NewClient[report.GetPrepTimeRequest, report.GetPrepTimeResponse](
+			httpClient,
+			baseURL+ReportServiceGetPrepTimeProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetPrepTime")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTopItems: connect.NewClient[report.GetTopItemsRequest, report.GetTopItemsResponse](
+			httpClient,
+			baseURL+ReportServiceGetTopItemsProcedure,
+			connect.WithSchema(reportServiceMethods.ByName("GetTopItems")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// reportServiceClient implements ReportServiceClient.
+type reportServiceClient struct {
+	getSalesByItem    *connect.Client[report.GetSalesByItemRequest, report.GetSalesByItemResponse]
+	getSalesByHour    *connect.Client[report.GetSalesByHourRequest, report.GetSalesByHourResponse]
+	getStatusOutcomes *connect.Client[report.GetStatusOutcomesRequest, report.GetStatusOutcomesResponse]
+	getPrepTime       *connect.Client[report.GetPrepTimeRequest, report.GetPrepTimeResponse]
+	getTopItems       *connect.Client[report.GetTopItemsRequest, report.GetTopItemsResponse]
+}
+
+// GetSalesByItem calls report.ReportService.GetSalesByItem.
+func (c *reportServiceClient) GetSalesByItem(ctx context.Context, req *connect.Request[report.GetSalesByItemRequest]) (*connect.Response[report.GetSalesByItemResponse], error) {
+	return c.getSalesByItem.CallUnary(ctx, req)
+}
+
+// GetSalesByHour calls report.ReportService.GetSalesByHour.
+func (c *reportServiceClient) GetSalesByHour(ctx context.Context, req *connect.Request[report.GetSalesByHourRequest]) (*connect.Response[report.GetSalesByHourResponse], error) {
+	return c.getSalesByHour.CallUnary(ctx, req)
+}
+
+// GetStatusOutcomes calls report.ReportService.GetStatusOutcomes.
+func (c *reportServiceClient) GetStatusOutcomes(ctx context.Context, req *connect.Request[report.GetStatusOutcomesRequest]) (*connect.Response[report.GetStatusOutcomesResponse], error) {
+	return c.getStatusOutcomes.CallUnary(ctx, req)
+}
+
+// GetPrepTime calls report.ReportService.GetPrepTime.
+func (c *reportServiceClient) GetPrepTime(ctx context.Context, req *connect.Request[report.GetPrepTimeRequest]) (*connect.Response[report.GetPrepTimeResponse], error) {
+	return c.getPrepTime.CallUnary(ctx, req)
+}
+
+// GetTopItems calls report.ReportService.GetTopItems.
+func (c *reportServiceClient) GetTopItems(ctx context.Context, req *connect.Request[report.GetTopItemsRequest]) (*connect.Response[report.GetTopItemsResponse], error) {
+	return c.getTopItems.CallUnary(ctx, req)
+}
+
+// ReportServiceHandler is an implementation of the report.ReportService service.
+type ReportServiceHandler interface {
+	// Sales per menu item, best selling first. Cancelled orders and orders
+	// still waiting to be paid are left out, as for every sales report.
+	GetSalesByItem(context.Context, *connect.Request[report.GetSalesByItemRequest]) (*connect.Response[report.GetSalesByItemResponse], error)
+	// Sales per hour of the day in the shop's time zone.
+	GetSalesByHour(context.Context, *connect.Request[report.GetSalesByHourRequest]) (*connect.Response[report.GetSalesByHourResponse], error)
+	// How many orders were completed, cancelled or are still open.
+	GetStatusOutcomes(context.Context, *connect.Request[report.GetStatusOutcomesRequest]) (*connect.Response[report.GetStatusOutcomesResponse], error)
+	// Time from placing an order to it being READY.
+	GetPrepTime(context.Context, *connect.Request[report.GetPrepTimeRequest]) (*connect.Response[report.GetPrepTimeResponse], error)
+	// The menu items that sold the most drinks.
+	GetTopItems(context.Context, *connect.Request[report.GetTopItemsRequest]) (*connect.Response[report.GetTopItemsResponse], error)
+}
+
+// NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	reportServiceMethods := report.File_report_report_proto.Services().ByName("ReportService").Methods()
+	reportServiceGetSalesByItemHandler := connect.NewUnaryHandler(
+		ReportServiceGetSalesByItemProcedure,
+		svc.GetSalesByItem,
+		connect.WithSchema(reportServiceMethods.ByName("GetSalesByItem")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceGetSalesByHourHandler := connect.NewUnaryHandler(
+		ReportServiceGetSalesByHourProcedure,
+		svc.GetSalesByHour,
+		connect.WithSchema(reportServiceMethods.ByName("GetSalesByHour")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceGetStatusOutcomesHandler := connect.NewUnaryHandler(
+		ReportServiceGetStatusOutcomesProcedure,
+		svc.GetStatusOutcomes,
+		connect.WithSchema(reportServiceMethods.ByName("GetStatusOutcomes")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceGetPrepTimeHandler := connect.NewUnaryHandler(
+		ReportServiceGetPrepTimeProcedure,
+		svc.GetPrepTime,
+		connect.WithSchema(reportServiceMethods.ByName("GetPrepTime")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceGetTopItemsHandler := connect.NewUnaryHandler(
+		ReportServiceGetTopItemsProcedure,
+		svc.GetTopItems,
+		connect.WithSchema(reportServiceMethods.ByName("GetTopItems")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/report.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReportServiceGetSalesByItemProcedure:
+			reportServiceGetSalesByItemHandler.ServeHTTP(w, r)
+		case ReportServiceGetSalesByHourProcedure:
+			reportServiceGetSalesByHourHandler.ServeHTTP(w, r)
+		case ReportServiceGetStatusOutcomesProcedure:
+			reportServiceGetStatusOutcomesHandler.ServeHTTP(w, r)
+		case ReportServiceGetPrepTimeProcedure:
+			reportServiceGetPrepTimeHandler.ServeHTTP(w, r)
+		case ReportServiceGetTopItemsProcedure:
+			reportServiceGetTopItemsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReportServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReportServiceHandler struct{}
+
+func (UnimplementedReportServiceHandler) GetSalesByItem(context.Context, *connect.Request[report.GetSalesByItemRequest]) (*connect.Response[report.GetSalesByItemResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("report.ReportService.GetSalesByItem is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) GetSalesByHour(context.Context, *connect.Request[report.GetSalesByHourRequest]) (*connect.Response[report.GetSalesByHourResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("report.ReportService.GetSalesByHour is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) GetStatusOutcomes(context.Context, *connect.Request[report.GetStatusOutcomesRequest]) (*connect.Response[report.GetStatusOutcomesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("report.ReportService.GetStatusOutcomes is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) GetPrepTime(context.Context, *connect.Request[report.GetPrepTimeRequest]) (*connect.Response[report.GetPrepTimeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("report.ReportService.GetPrepTime is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) GetTopItems(context.Context, *connect.Request[report.GetTopItemsRequest]) (*connect.Response[report.GetTopItemsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("report.ReportService.GetTopItems is not implemented"))
+}
@@ -0,0 +1,150 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	accountpb "github.com/jany/my-coffee/gen/proto/account"
+	"github.com/jany/my-coffee/gen/proto/account/accountconnect"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ accountconnect.AccountServiceHandler = (*Server)(nil)
+
+type Server struct {
+	db           *gorm.DB
+	customerRepo *repository.CustomerRepository
+	verifier     *auth.Verifier
+}
+
+func New(db *gorm.DB, verifier *auth.Verifier) *Server {
+	return &Server{
+		db:           db,
+		customerRepo: repository.NewCustomerRepository(db),
+		verifier:     verifier,
+	}
+}
+
+func (s *Server) Register(ctx context.Context, req *connect.Request[accountpb.RegisterRequest]) (*connect.Response[accountpb.RegisterResponse], error) {
+	var customer *models.Customer
+	var tokens *Tokens
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		customer, tokens, err = Register(repository.NewCustomerRepository(tx), s.verifier, req.Msg.Email, req.Msg.Password, req.Msg.Name)
+		return err
+	})
+	if err != nil {
+		return nil, Error("register customer", err)
+	}
+
+	log.Printf("Customer %d registered", customer.ID)
+
+	return connect.NewResponse(&accountpb.RegisterResponse{
+		Customer: toCustomerProto(customer),
+		Tokens:   toTokensProto(tokens),
+	}), nil
+}
+
+func (s *Server) Login(ctx context.Context, req *connect.Request[accountpb.LoginRequest]) (*connect.Response[accountpb.LoginResponse], error) {
+	customer, tokens, err := Login(s.customerRepo, s.verifier, req.Msg.Email, req.Msg.Password)
+	if err != nil {
+		return nil, Error("log in", err)
+	}
+
+	return connect.NewResponse(&accountpb.LoginResponse{
+		Customer: toCustomerProto(customer),
+		Tokens:   toTokensProto(tokens),
+	}), nil
+}
+
+func (s *Server) RefreshToken(ctx context.Context, req *connect.Request[accountpb.RefreshTokenRequest]) (*connect.Response[accountpb.RefreshTokenResponse], error) {
+	var tokens *Tokens
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tokens, err = Refresh(repository.NewCustomerRepository(tx), s.verifier, req.Msg.RefreshToken)
+		// Commit the revocation that follows a reused token; nothing else
+		// is written for an invalid one.
+		if errors.Is(err, ErrInvalidRefreshToken) {
+			return nil
+		}
+		return err
+	})
+	if err == nil && tokens == nil {
+		err = ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, Error("refresh token", err)
+	}
+
+	return connect.NewResponse(&accountpb.RefreshTokenResponse{
+		Tokens: toTokensProto(tokens),
+	}), nil
+}
+
+func (s *Server) ChangePassword(ctx context.Context, req *connect.Request[accountpb.ChangePasswordRequest]) (*connect.Response[accountpb.ChangePasswordResponse], error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrMissingToken)
+	}
+	customerID, err := CustomerID(claims)
+	if err != nil {
+		return nil, Error("change password", err)
+	}
+
+	var tokens *Tokens
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		tokens, err = ChangePassword(repository.NewCustomerRepository(tx), s.verifier, customerID, req.Msg.CurrentPassword, req.Msg.NewPassword)
+		return err
+	})
+	if err != nil {
+		return nil, Error("change password", err)
+	}
+
+	log.Printf("Customer %d changed their password", customerID)
+
+	return connect.NewResponse(&accountpb.ChangePasswordResponse{
+		Tokens: toTokensProto(tokens),
+	}), nil
+}
+
+// Error turns an error from this package into a Connect error; action
+// describes what failed, e.g. "log in".
+func Error(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrEmailTaken):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, ErrInvalidCredentials), errors.Is(err, ErrInvalidRefreshToken):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, ErrNotCustomer):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+	log.Printf("Failed to %s: %v", action, err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s: %w", action, err))
+}
+
+func toCustomerProto(customer *models.Customer) *accountpb.Customer {
+	return &accountpb.Customer{
+		CustomerId: Subject(customer),
+		Email:      customer.Email,
+		Name:       customer.Name,
+		CreatedAt:  timestamppb.New(customer.CreatedAt),
+	}
+}
+
+func toTokensProto(tokens *Tokens) *accountpb.Tokens {
+	return &accountpb.Tokens{
+		AccessToken:           tokens.Access,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshToken:          tokens.Refresh,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+	}
+}
@@ -0,0 +1,220 @@
+// Package accounts lets customers register and sign in, and issues the
+// bearer tokens auth checks.
+package accounts
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEmailTaken = errors.New("email is already registered")
+	// ErrInvalidCredentials does not say whether the email or the password
+	// was wrong, so it cannot be used to find registered emails.
+	ErrInvalidCredentials  = errors.New("invalid email or password")
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+	ErrNotCustomer         = errors.New("not signed in as a customer")
+)
+
+// Tokens are issued on register, login and refresh.
+type Tokens struct {
+	Access           string
+	AccessExpiresAt  time.Time
+	Refresh          string
+	RefreshExpiresAt time.Time
+}
+
+// Subject is the token subject of a customer, which their orders carry as
+// customer ID.
+func Subject(customer *models.Customer) string {
+	return fmt.Sprintf("customer-%d", customer.ID)
+}
+
+// CustomerID returns the account behind the claims of a customer token.
+func CustomerID(claims *auth.Claims) (uint, error) {
+	var id uint
+	if !claims.IsCustomer() {
+		return 0, ErrNotCustomer
+	}
+	if _, err := fmt.Sscanf(claims.Subject, "customer-%d", &id); err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrNotCustomer, claims.Subject)
+	}
+	return id, nil
+}
+
+// NormalizeEmail lower-cases email so it matches however it was typed.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Register creates a customer and signs them in.
+func Register(repo *repository.CustomerRepository, verifier *auth.Verifier, email, password, name string) (*models.Customer, *Tokens, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, nil, err
+	}
+	customer := &models.Customer{
+		Email:        NormalizeEmail(email),
+		Name:         strings.TrimSpace(name),
+		PasswordHash: string(hash),
+	}
+	if err := repo.Create(customer); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, nil, ErrEmailTaken
+		}
+		return nil, nil, err
+	}
+	tokens, err := issue(repo, verifier, customer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return customer, tokens, nil
+}
+
+// dummyHash is compared against when an email is unknown, so a login
+// takes as long whether or not the account exists.
+var dummyHash = sync.OnceValue(func() []byte {
+	hash, err := bcrypt.GenerateFromPassword([]byte("not a real password"), bcrypt.DefaultCost)
+	if err != nil {
+		log.Fatalf("failed to hash dummy password: %v", err)
+	}
+	return hash
+})
+
+// Login checks a customer's password and signs them in.
+func Login(repo *repository.CustomerRepository, verifier *auth.Verifier, email, password string) (*models.Customer, *Tokens, error) {
+	customer, err := repo.FindByEmail(NormalizeEmail(email))
+	if err != nil {
+		return nil, nil, err
+	}
+	if customer == nil {
+		bcrypt.CompareHashAndPassword(dummyHash(), []byte(password))
+		return nil, nil, ErrInvalidCredentials
+	}
+	if err := checkPassword(customer, password); err != nil {
+		return nil, nil, err
+	}
+	tokens, err := issue(repo, verifier, customer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return customer, tokens, nil
+}
+
+// Refresh spends a refresh token on new tokens. Presenting a token that
+// was already spent means it was copied, so every refresh token of the
+// customer is revoked. Call it in a transaction.
+func Refresh(repo *repository.CustomerRepository, verifier *auth.Verifier, refreshToken string) (*Tokens, error) {
+	token, err := repo.LockRefreshToken(hashToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if token == nil || now.After(token.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if token.RevokedAt != nil {
+		log.Printf("Refresh token %d of customer %d was reused, revoking all", token.ID, token.CustomerID)
+		if err := repo.RevokeRefreshTokens(token.CustomerID, now); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if err := repo.RevokeRefreshToken(token, now); err != nil {
+		return nil, err
+	}
+	customer, err := repo.FindByID(token.CustomerID)
+	if err != nil {
+		return nil, err
+	}
+	return issue(repo, verifier, customer)
+}
+
+// ChangePassword replaces the customer's password after checking the
+// current one, revokes their refresh tokens and issues new ones. Access
+// tokens already issued stay valid until they expire. Call it in a
+// transaction.
+func ChangePassword(repo *repository.CustomerRepository, verifier *auth.Verifier, customerID uint, current, next string) (*Tokens, error) {
+	customer, err := repo.FindByID(customerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPassword(customer, current); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(next), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	customer.PasswordHash = string(hash)
+	if err := repo.UpdatePassword(customer); err != nil {
+		return nil, err
+	}
+	if err := repo.RevokeRefreshTokens(customer.ID, time.Now()); err != nil {
+		return nil, err
+	}
+	return issue(repo, verifier, customer)
+}
+
+func checkPassword(customer *models.Customer, password string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(customer.PasswordHash), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrInvalidCredentials
+	}
+	return err
+}
+
+// issue signs an access token for the customer and stores a new refresh
+// token.
+func issue(repo *repository.CustomerRepository, verifier *auth.Verifier, customer *models.Customer) (*Tokens, error) {
+	now := time.Now()
+	tokens := &Tokens{
+		AccessExpiresAt:  now.Add(config.AppConfig.AccessTokenTTL),
+		RefreshExpiresAt: now.Add(config.AppConfig.RefreshTokenTTL),
+	}
+
+	var err error
+	tokens.Access, err = verifier.Sign(Subject(customer), []string{string(auth.RoleCustomer)}, config.AppConfig.AccessTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("sign access token: %w", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	tokens.Refresh = base64.RawURLEncoding.EncodeToString(secret)
+	err = repo.CreateRefreshToken(&models.RefreshToken{
+		CustomerID: customer.ID,
+		TokenHash:  hashToken(tokens.Refresh),
+		ExpiresAt:  tokens.RefreshExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// hashToken is what is stored for a refresh token. The token is random,
+// so a fast hash is enough.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
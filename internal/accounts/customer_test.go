@@ -0,0 +1,166 @@
+package accounts
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+)
+
+func TestCustomerID(t *testing.T) {
+	claims := func(subject string, apiKey bool, roles ...string) *auth.Claims {
+		return &auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: subject},
+			Roles:            roles,
+			APIKey:           apiKey,
+		}
+	}
+	tests := []struct {
+		name    string
+		claims  *auth.Claims
+		want    uint
+		wantErr bool
+	}{
+		{name: "customer", claims: claims("customer-42", false, "customer"), want: 42},
+		{name: "staff", claims: claims("customer-42", false, "customer", "barista"), wantErr: true},
+		{name: "API key", claims: claims("customer-42", true, "customer"), wantErr: true},
+		{name: "other subject", claims: claims("kim", false, "customer"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CustomerID(tt.claims)
+			if tt.wantErr {
+				if !errors.Is(err, ErrNotCustomer) {
+					t.Errorf("CustomerID() error = %v, want ErrNotCustomer", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("CustomerID() = %d, %v; want %d, nil", got, err, tt.want)
+			}
+		})
+	}
+
+	if got := Subject(&models.Customer{ID: 42}); got != "customer-42" {
+		t.Errorf("Subject() = %q, want customer-42", got)
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	if got := NormalizeEmail("  Kim@Example.COM "); got != "kim@example.com" {
+		t.Errorf("NormalizeEmail() = %q, want kim@example.com", got)
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	hash := hashToken("secret")
+	if len(hash) != 64 || hash != hashToken("secret") || hash == hashToken("Secret") {
+		t.Errorf("hashToken() = %q, want a stable SHA-256 hex digest", hash)
+	}
+}
+
+func setup(t *testing.T) (*repository.CustomerRepository, *auth.Verifier) {
+	t.Helper()
+	previous := config.AppConfig
+	config.AppConfig = &config.Config{AccessTokenTTL: time.Minute, RefreshTokenTTL: time.Hour}
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	return repository.NewCustomerRepository(testdb.Open(t)), auth.NewVerifier("test-secret")
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	repo, verifier := setup(t)
+
+	customer, tokens, err := Register(repo, verifier, "Kim@Example.com", "correct horse", " Kim ")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if customer.Email != "kim@example.com" || customer.Name != "Kim" || customer.PasswordHash == "correct horse" {
+		t.Errorf("customer = %+v", customer)
+	}
+	claims, err := verifier.Verify(tokens.Access)
+	if err != nil || claims.Subject != Subject(customer) || !claims.IsCustomer() {
+		t.Errorf("access token claims = %+v, %v; want a customer token for %s", claims, err, Subject(customer))
+	}
+
+	if _, _, err := Register(repo, verifier, "KIM@example.com", "another one", "Kim"); !errors.Is(err, ErrEmailTaken) {
+		t.Errorf("Register() with a taken email error = %v, want ErrEmailTaken", err)
+	}
+
+	if _, _, err := Login(repo, verifier, " kim@EXAMPLE.com", "correct horse"); err != nil {
+		t.Errorf("Login() error = %v", err)
+	}
+	for _, login := range [][2]string{{"kim@example.com", "wrong"}, {"nobody@example.com", "correct horse"}} {
+		if _, _, err := Login(repo, verifier, login[0], login[1]); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("Login(%s) error = %v, want ErrInvalidCredentials", login[0], err)
+		}
+	}
+}
+
+func TestRefreshReuse(t *testing.T) {
+	repo, verifier := setup(t)
+	_, first, err := Register(repo, verifier, "kim@example.com", "correct horse", "Kim")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	second, err := Refresh(repo, verifier, first.Refresh)
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if second.Refresh == first.Refresh {
+		t.Error("Refresh() returned the same refresh token")
+	}
+
+	// The first token was copied and is used again: every token of the
+	// customer stops working, including the one just issued.
+	if _, err := Refresh(repo, verifier, first.Refresh); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh() with a spent token error = %v, want ErrInvalidRefreshToken", err)
+	}
+	if _, err := Refresh(repo, verifier, second.Refresh); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh() after reuse error = %v, want ErrInvalidRefreshToken", err)
+	}
+
+	if _, err := Refresh(repo, verifier, "made-up"); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh() with an unknown token error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestRefreshExpired(t *testing.T) {
+	repo, verifier := setup(t)
+	config.AppConfig.RefreshTokenTTL = -48 * time.Hour
+	_, tokens, err := Register(repo, verifier, "kim@example.com", "correct horse", "Kim")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	if _, err := Refresh(repo, verifier, tokens.Refresh); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh() with an expired token error = %v, want ErrInvalidRefreshToken", err)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	repo, verifier := setup(t)
+	customer, tokens, err := Register(repo, verifier, "kim@example.com", "correct horse", "Kim")
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	if _, err := ChangePassword(repo, verifier, customer.ID, "wrong", "new password"); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("ChangePassword() with a wrong password error = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := ChangePassword(repo, verifier, customer.ID, "correct horse", "new password"); err != nil {
+		t.Fatalf("ChangePassword() error = %v", err)
+	}
+	if _, err := Refresh(repo, verifier, tokens.Refresh); !errors.Is(err, ErrInvalidRefreshToken) {
+		t.Errorf("Refresh() after changing the password error = %v, want ErrInvalidRefreshToken", err)
+	}
+	if _, _, err := Login(repo, verifier, "kim@example.com", "new password"); err != nil {
+		t.Errorf("Login() with the new password error = %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package apikeys
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+)
+
+func TestHashKey(t *testing.T) {
+	hash := hashKey("mck_secret")
+	if len(hash) != 64 || hash != hashKey("mck_secret") || hash == hashKey("mck_Secret") {
+		t.Errorf("hashKey() = %q, want a stable SHA-256 hex digest", hash)
+	}
+	if got := Subject(&models.APIKey{ID: 3}); got != "apikey-3" {
+		t.Errorf("Subject() = %q, want apikey-3", got)
+	}
+}
+
+func TestIssueScopes(t *testing.T) {
+	// Scopes are checked before the repository is used.
+	if _, _, err := Issue(nil, "kiosk", nil, 0); !errors.Is(err, ErrNoScopes) {
+		t.Errorf("Issue() without scopes error = %v, want ErrNoScopes", err)
+	}
+	if _, _, err := Issue(nil, "kiosk", []string{"customer", "root"}, 0); !errors.Is(err, ErrUnknownScope) {
+		t.Errorf("Issue() with an unknown scope error = %v, want ErrUnknownScope", err)
+	}
+}
+
+func TestCheckKey(t *testing.T) {
+	db := testdb.Open(t)
+	repo := repository.NewAPIKeyRepository(db)
+	checker := NewChecker(repo)
+
+	key, apiKey, err := Issue(repo, "kiosk", []string{"customer", "barista"}, 0)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if !strings.HasPrefix(key, keyPrefix) || !strings.HasPrefix(key, apiKey.Prefix) || apiKey.KeyHash == key {
+		t.Errorf("Issue() = %q, %+v; want a prefixed key stored only as a hash", key, apiKey)
+	}
+
+	claims, err := checker.CheckKey(key)
+	if err != nil {
+		t.Fatalf("CheckKey() error = %v", err)
+	}
+	if !claims.APIKey || claims.Subject != Subject(apiKey) || !slices.Equal(claims.Roles, []string{"customer", "barista"}) {
+		t.Errorf("CheckKey() = %+v", claims)
+	}
+
+	_, err = checker.CheckKey(key + "x")
+	if !errors.Is(err, auth.ErrInvalidToken) || !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("CheckKey(unknown) error = %v, want ErrInvalidToken and ErrUnknownKey", err)
+	}
+
+	if err := Revoke(repo, apiKey.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if err := Revoke(repo, apiKey.ID); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("second Revoke() error = %v, want ErrUnknownKey", err)
+	}
+	_, err = checker.CheckKey(key)
+	if !errors.Is(err, auth.ErrInvalidToken) || !errors.Is(err, ErrRevoked) {
+		t.Errorf("CheckKey(revoked) error = %v, want ErrInvalidToken and ErrRevoked", err)
+	}
+}
+
+func TestCheckKeyExpired(t *testing.T) {
+	db := testdb.Open(t)
+	repo := repository.NewAPIKeyRepository(db)
+
+	key, apiKey, err := Issue(repo, "partner", []string{"customer"}, time.Hour)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if apiKey.ExpiresAt == nil {
+		t.Fatal("key with a ttl has no expiry")
+	}
+	if err := db.Model(apiKey).Update("expires_at", time.Now().Add(-48*time.Hour)).Error; err != nil {
+		t.Fatalf("Failed to expire key: %v", err)
+	}
+
+	_, err = NewChecker(repo).CheckKey(key)
+	if !errors.Is(err, auth.ErrInvalidToken) || !errors.Is(err, ErrExpired) {
+		t.Errorf("CheckKey(expired) error = %v, want ErrInvalidToken and ErrExpired", err)
+	}
+}
@@ -0,0 +1,153 @@
+// Package auth checks the HS256 bearer tokens sent to brewsvc and menusvc
+// and carries the caller's identity through the request context.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrMissingToken = errors.New("missing bearer token")
+	ErrInvalidToken = errors.New("invalid token")
+)
+
+// Claims are the parts of a token the services use. The subject is the
+// customer or staff member the token was issued to.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+	// APIKey is set when the caller sent an API key instead of a token.
+	APIKey bool `json:"-"`
+}
+
+// APIKeyHeader carries an API key, which is accepted wherever a bearer
+// token is once the verifier has a KeyChecker.
+const APIKeyHeader = "X-API-Key"
+
+// KeyChecker returns the claims an API key acts with, or an error wrapping
+// ErrInvalidToken if the key is unknown, expired or revoked.
+type KeyChecker interface {
+	CheckKey(key string) (*Claims, error)
+}
+
+type claimsKey struct{}
+
+// WithClaims returns a copy of ctx carrying claims.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext returns the claims of the caller, if the request carried a
+// valid token.
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// Subject returns who is calling, for audit trails: the staff member,
+// customer or API key the credential was issued to. It is empty when the
+// call carried no credential.
+func Subject(ctx context.Context) string {
+	if claims, ok := FromContext(ctx); ok {
+		return claims.Subject
+	}
+	return ""
+}
+
+// Verifier checks tokens signed with the shared secret.
+type Verifier struct {
+	secret []byte
+	parser *jwt.Parser
+	keys   KeyChecker
+}
+
+func NewVerifier(secret string) *Verifier {
+	return &Verifier{
+		secret: []byte(secret),
+		parser: jwt.NewParser(
+			jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+			jwt.WithExpirationRequired(),
+		),
+	}
+}
+
+// Verify parses token and checks its signature and expiry.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := v.parser.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+		return v.secret, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// WithAPIKeys makes VerifyHeader accept API keys checked by keys.
+func (v *Verifier) WithAPIKeys(keys KeyChecker) *Verifier {
+	v.keys = keys
+	return v
+}
+
+// VerifyHeader checks the API key in the X-API-Key header, if API keys are
+// enabled and one was sent, or else the bearer token in the Authorization
+// header.
+func (v *Verifier) VerifyHeader(header http.Header) (*Claims, error) {
+	if key := header.Get(APIKeyHeader); key != "" && v.keys != nil {
+		return v.keys.CheckKey(key)
+	}
+	token, ok := strings.CutPrefix(header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return nil, ErrMissingToken
+	}
+	return v.Verify(strings.TrimSpace(token))
+}
+
+// Sign issues a token for subject with roles that expires after ttl.
+func (v *Verifier) Sign(subject string, roles []string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Roles: roles,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(v.secret)
+}
+
+// Require rejects HTTP requests without a valid bearer token holding one
+// of roles, for the plain HTTP endpoints served next to the RPCs. Without
+// roles any valid token will do.
+func (v *Verifier) Require(next http.Handler, roles ...Role) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims, err := v.VerifyHeader(r.Header)
+		if err != nil && !isCredentialError(err) {
+			log.Printf("Failed to authenticate: %v", err)
+			http.Error(w, "failed to authenticate", http.StatusServiceUnavailable)
+			return
+		}
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if len(roles) > 0 && !claims.HasRole(roles...) {
+			http.Error(w, fmt.Sprintf("needs one of the roles %v", roles), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithClaims(r.Context(), claims)))
+	})
+}
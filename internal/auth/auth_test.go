@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+func signToken(t *testing.T, method jwt.SigningMethod, key any, claims jwt.Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Now()
+	valid := func() *Claims {
+		return &Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Subject:   "customer-1",
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+			},
+			Roles: []string{string(RoleCustomer)},
+		}
+	}
+	expired := valid()
+	expired.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Minute))
+	noExpiry := valid()
+	noExpiry.ExpiresAt = nil
+	noSubject := valid()
+	noSubject.Subject = ""
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "valid", token: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), valid())},
+		{name: "wrong secret", token: signToken(t, jwt.SigningMethodHS256, []byte("other-secret"), valid()), wantErr: true},
+		{name: "wrong alg", token: signToken(t, jwt.SigningMethodHS512, []byte(testSecret), valid()), wantErr: true},
+		{name: "alg none", token: signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid()), wantErr: true},
+		{name: "expired", token: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), expired), wantErr: true},
+		{name: "no expiry", token: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), noExpiry), wantErr: true},
+		{name: "missing subject", token: signToken(t, jwt.SigningMethodHS256, []byte(testSecret), noSubject), wantErr: true},
+		{name: "garbage", token: "not.a.token", wantErr: true},
+	}
+	verifier := NewVerifier(testSecret)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("Verify() error = %v, want ErrInvalidToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if claims.Subject != "customer-1" || !claims.HasRole(RoleCustomer) {
+				t.Errorf("Verify() = %+v, want customer-1 with the customer role", claims)
+			}
+		})
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	verifier := NewVerifier(testSecret)
+	token, err := verifier.Sign("staff-2", []string{string(RoleBarista)}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+	claims, err := verifier.VerifyHeader(header)
+	if err != nil {
+		t.Fatalf("VerifyHeader() error = %v", err)
+	}
+	if claims.Subject != "staff-2" || !claims.IsStaff() || claims.IsCustomer() {
+		t.Errorf("VerifyHeader() = %+v, want staff-2 as staff", claims)
+	}
+
+	if _, err := verifier.VerifyHeader(http.Header{}); !errors.Is(err, ErrMissingToken) {
+		t.Errorf("VerifyHeader() without a token error = %v, want ErrMissingToken", err)
+	}
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+// Interceptor authenticates every call to a handler with its bearer token
+// or API key and puts the claims into the context. Public procedures can be called
+// without a token; a valid one still identifies the caller.
+type Interceptor struct {
+	verifier *Verifier
+	public   map[string]bool
+}
+
+// NewInterceptor protects every procedure but public, which are full
+// procedure names such as menuconnect.MenuServiceGetMenuProcedure; see
+// Policy.Public. Pair it with an Authorizer to check roles.
+func NewInterceptor(verifier *Verifier, public ...string) *Interceptor {
+	i := &Interceptor{verifier: verifier, public: make(map[string]bool)}
+	for _, procedure := range public {
+		i.public[procedure] = true
+	}
+	return i
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	claims, err := i.verifier.VerifyHeader(header)
+	if err == nil {
+		return WithClaims(ctx, claims), nil
+	}
+	if !isCredentialError(err) {
+		log.Printf("Failed to authenticate: %v", err)
+		return ctx, connect.NewError(connect.CodeUnavailable, errors.New("failed to authenticate"))
+	}
+	if i.public[procedure] {
+		return ctx, nil
+	}
+	connectErr := connect.NewError(connect.CodeUnauthenticated, err)
+	connectErr.Meta().Set("WWW-Authenticate", "Bearer")
+	return ctx, connectErr
+}
+
+// isCredentialError tells a missing or bad credential from a failure to
+// check one, such as the API key lookup failing.
+func isCredentialError(err error) bool {
+	return errors.Is(err, ErrMissingToken) || errors.Is(err, ErrInvalidToken)
+}
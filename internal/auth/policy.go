@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/gen/proto/account/accountconnect"
+	"github.com/jany/my-coffee/gen/proto/brew/brewconnect"
+	"github.com/jany/my-coffee/gen/proto/businessday/businessdayconnect"
+	"github.com/jany/my-coffee/gen/proto/cash/cashconnect"
+	"github.com/jany/my-coffee/gen/proto/giftcard/giftcardconnect"
+	"github.com/jany/my-coffee/gen/proto/inventory/inventoryconnect"
+	"github.com/jany/my-coffee/gen/proto/loyalty/loyaltyconnect"
+	"github.com/jany/my-coffee/gen/proto/menu/menuconnect"
+	"github.com/jany/my-coffee/gen/proto/promo/promoconnect"
+	"github.com/jany/my-coffee/gen/proto/report/reportconnect"
+)
+
+// Rule says who may call a procedure.
+type Rule struct {
+	// Public procedures need no token at all.
+	Public bool
+	Roles  []Role
+}
+
+// Policy maps full procedure names to their rule. Procedures missing from
+// a policy cannot be called, so a new RPC stays closed until it is added.
+type Policy map[string]Rule
+
+var public = Rule{Public: true}
+
+func allow(roles []Role) Rule {
+	return Rule{Roles: roles}
+}
+
+// BrewPolicy covers every service hosted by brewsvc.
+var BrewPolicy = Policy{
+	accountconnect.AccountServiceRegisterProcedure:       public,
+	accountconnect.AccountServiceLoginProcedure:          public,
+	accountconnect.AccountServiceRefreshTokenProcedure:   public,
+	accountconnect.AccountServiceChangePasswordProcedure: allow(customers),
+
+	brewconnect.BrewServiceOrderDrinkProcedure:        allow(everyone),
+	brewconnect.BrewServiceListOrdersProcedure:        allow(staff),
+	brewconnect.BrewServiceGetOrderProcedure:          allow(everyone),
+	brewconnect.BrewServiceUpdateOrderStatusProcedure: allow(staff),
+	brewconnect.BrewServiceDeleteOrderProcedure:       allow(managers),
+	brewconnect.BrewServiceGetBaristaTicketProcedure:  allow(staff),
+	brewconnect.BrewServicePayOrderProcedure:          allow(everyone),
+	brewconnect.BrewServiceGetPaymentProcedure:        allow(staff),
+	brewconnect.BrewServiceCancelOrderProcedure:       allow(staff),
+	brewconnect.BrewServiceRefundOrderProcedure:       allow(managers),
+	brewconnect.BrewServiceGetReceiptProcedure:        allow(everyone),
+	brewconnect.BrewServiceVerifyPickupProcedure:      allow(staff),
+	brewconnect.BrewServiceExportOrdersProcedure:      allow(managers),
+	brewconnect.BrewServiceListMyOrdersProcedure:      allow(everyone),
+	brewconnect.BrewServiceReorderProcedure:           allow(everyone),
+
+	businessdayconnect.BusinessDayServiceCloseBusinessDayProcedure:  allow(managers),
+	businessdayconnect.BusinessDayServiceReopenBusinessDayProcedure: allow(admins),
+	businessdayconnect.BusinessDayServiceGetBusinessDayProcedure:    allow(managers),
+
+	cashconnect.CashServiceListCashDrawersProcedure:    allow(staff),
+	cashconnect.CashServiceOpenShiftProcedure:          allow(staff),
+	cashconnect.CashServiceCloseShiftProcedure:         allow(staff),
+	cashconnect.CashServiceRecordCashMovementProcedure: allow(staff),
+	cashconnect.CashServiceGetShiftReportProcedure:     allow(staff),
+
+	giftcardconnect.GiftCardServiceIssueGiftCardProcedure:       allow(staff),
+	giftcardconnect.GiftCardServiceGetGiftCardProcedure:         allow(everyone),
+	giftcardconnect.GiftCardServiceCheckGiftCardLedgerProcedure: allow(managers),
+
+	inventoryconnect.InventoryServiceListIngredientsProcedure:    allow(staff),
+	inventoryconnect.InventoryServiceRestockIngredientProcedure:  allow(staff),
+	inventoryconnect.InventoryServiceSetReorderSettingsProcedure: allow(managers),
+	inventoryconnect.InventoryServiceListLowStockProcedure:       allow(staff),
+	inventoryconnect.InventoryServiceGetPurchaseListProcedure:    allow(managers),
+
+	loyaltyconnect.LoyaltyServiceGetLoyaltyProcedure: allow(everyone),
+
+	promoconnect.PromoServiceCreatePromoCodeProcedure:       allow(managers),
+	promoconnect.PromoServiceListPromoCodesProcedure:        allow(staff),
+	promoconnect.PromoServiceSetPromoCodeActiveProcedure:    allow(managers),
+	promoconnect.PromoServiceCreateDiscountRuleProcedure:    allow(managers),
+	promoconnect.PromoServiceListDiscountRulesProcedure:     allow(staff),
+	promoconnect.PromoServiceSetDiscountRuleActiveProcedure: allow(managers),
+
+	reportconnect.ReportServiceGetSalesByItemProcedure:    allow(managers),
+	reportconnect.ReportServiceGetSalesByHourProcedure:    allow(managers),
+	reportconnect.ReportServiceGetStatusOutcomesProcedure: allow(managers),
+	reportconnect.ReportServiceGetPrepTimeProcedure:       allow(managers),
+	reportconnect.ReportServiceGetTopItemsProcedure:       allow(managers),
+}
+
+// MenuPolicy covers menusvc.
+var MenuPolicy = Policy{
+	menuconnect.MenuServiceGetMenuProcedure:    public,
+	menuconnect.MenuServiceSearchMenuProcedure: public,
+}
+
+// Public lists the procedures that need no token, for NewInterceptor.
+func (p Policy) Public() []string {
+	var procedures []string
+	for procedure, rule := range p {
+		if rule.Public {
+			procedures = append(procedures, procedure)
+		}
+	}
+	return procedures
+}
+
+// Authorizer enforces a policy on calls that NewInterceptor has already
+// authenticated.
+type Authorizer struct {
+	policy Policy
+}
+
+func NewAuthorizer(policy Policy) *Authorizer {
+	return &Authorizer{policy: policy}
+}
+
+func (a *Authorizer) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if err := a.authorize(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (a *Authorizer) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (a *Authorizer) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := a.authorize(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (a *Authorizer) authorize(ctx context.Context, procedure string) error {
+	rule, ok := a.policy[procedure]
+	if !ok {
+		log.Printf("No authorization rule for %s", procedure)
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not allowed", procedure))
+	}
+	if rule.Public {
+		return nil
+	}
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, ErrMissingToken)
+	}
+	if !claims.HasRole(rule.Roles...) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s needs one of the roles %v", procedure, rule.Roles))
+	}
+	return nil
+}
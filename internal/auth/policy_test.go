@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	accountpb "github.com/jany/my-coffee/gen/proto/account"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	businessdaypb "github.com/jany/my-coffee/gen/proto/businessday"
+	cashpb "github.com/jany/my-coffee/gen/proto/cash"
+	giftcardpb "github.com/jany/my-coffee/gen/proto/giftcard"
+	inventorypb "github.com/jany/my-coffee/gen/proto/inventory"
+	loyaltypb "github.com/jany/my-coffee/gen/proto/loyalty"
+	menupb "github.com/jany/my-coffee/gen/proto/menu"
+	promopb "github.com/jany/my-coffee/gen/proto/promo"
+	reportpb "github.com/jany/my-coffee/gen/proto/report"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestPolicyCoverage checks that every procedure a service hosts has a
+// rule, so none is closed by accident, and that every rule names a
+// procedure that exists.
+func TestPolicyCoverage(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy Policy
+		files  []protoreflect.FileDescriptor
+	}{
+		{
+			name:   "brewsvc",
+			policy: BrewPolicy,
+			files: []protoreflect.FileDescriptor{
+				accountpb.File_account_account_proto,
+				brewpb.File_brew_brew_proto,
+				businessdaypb.File_businessday_businessday_proto,
+				cashpb.File_cash_cash_proto,
+				giftcardpb.File_giftcard_giftcard_proto,
+				inventorypb.File_inventory_inventory_proto,
+				loyaltypb.File_loyalty_loyalty_proto,
+				promopb.File_promo_promo_proto,
+				reportpb.File_report_report_proto,
+			},
+		},
+		{
+			name:   "menusvc",
+			policy: MenuPolicy,
+			files:  []protoreflect.FileDescriptor{menupb.File_menu_menu_proto},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			procedures := map[string]bool{}
+			for _, file := range tt.files {
+				services := file.Services()
+				for i := range services.Len() {
+					methods := services.Get(i).Methods()
+					for j := range methods.Len() {
+						procedure := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())
+						procedures[procedure] = true
+						if _, ok := tt.policy[procedure]; !ok {
+							t.Errorf("no rule for %s", procedure)
+						}
+					}
+				}
+			}
+			for procedure, rule := range tt.policy {
+				if !procedures[procedure] {
+					t.Errorf("rule for unknown procedure %s", procedure)
+				}
+				if !rule.Public && len(rule.Roles) == 0 {
+					t.Errorf("%s is neither public nor open to any role", procedure)
+				}
+			}
+		})
+	}
+}
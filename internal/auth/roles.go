@@ -0,0 +1,48 @@
+package auth
+
+import "slices"
+
+// Role is what a token allows its holder to do. Roles are not ranked: a
+// policy lists every role it lets in.
+type Role string
+
+const (
+	RoleCustomer Role = "customer"
+	RoleBarista  Role = "barista"
+	RoleManager  Role = "manager"
+	RoleAdmin    Role = "admin"
+)
+
+// Roles lists every role, for the tools that issue tokens and keys.
+var Roles = []Role{RoleCustomer, RoleBarista, RoleManager, RoleAdmin}
+
+// Role sets used by the policies.
+var (
+	everyone  = []Role{RoleCustomer, RoleBarista, RoleManager, RoleAdmin}
+	customers = []Role{RoleCustomer}
+	staff     = []Role{RoleBarista, RoleManager, RoleAdmin}
+	managers  = []Role{RoleManager, RoleAdmin}
+	admins    = []Role{RoleAdmin}
+)
+
+// HasRole reports whether the claims hold any of roles.
+func (c *Claims) HasRole(roles ...Role) bool {
+	for _, role := range c.Roles {
+		if slices.Contains(roles, Role(role)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsStaff reports whether the claims hold a staff role, which may act on
+// any order or customer.
+func (c *Claims) IsStaff() bool {
+	return c.HasRole(staff...)
+}
+
+// IsCustomer reports whether the claims belong to a customer rather than
+// staff or an API key. Customers can only act for themselves.
+func (c *Claims) IsCustomer() bool {
+	return !c.APIKey && c.HasRole(RoleCustomer) && !c.IsStaff()
+}
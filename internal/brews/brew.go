@@ -2,14 +2,27 @@ package brews
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
 	brewpb "github.com/jany/my-coffee/gen/proto/brew"
 	"github.com/jany/my-coffee/gen/proto/brew/brewconnect"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/businessdays"
+	"github.com/jany/my-coffee/internal/loyalty"
+	"github.com/jany/my-coffee/internal/menus"
 	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/payments"
+	"github.com/jany/my-coffee/internal/printer"
+	"github.com/jany/my-coffee/internal/promos"
+	"github.com/jany/my-coffee/internal/receipts"
 	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
@@ -17,30 +30,138 @@ import (
 var _ brewconnect.BrewServiceHandler = (*Server)(nil)
 
 type Server struct {
-	orderRepo *repository.OrderRepository
+	db          *gorm.DB
+	orderRepo   *repository.OrderRepository
+	paymentRepo *repository.PaymentRepository
+	provider    payments.PaymentProvider
+	receipts    *receipts.Renderer
+	// printQueue receives cup tickets; nil when no printer is set up.
+	printQueue *printer.Queue
 }
 
-func New(db *gorm.DB) *Server {
+func New(db *gorm.DB, provider payments.PaymentProvider, receipts *receipts.Renderer, printQueue *printer.Queue) *Server {
 	return &Server{
-		orderRepo: repository.NewOrderRepository(db),
+		db:          db,
+		orderRepo:   repository.NewOrderRepository(db),
+		paymentRepo: repository.NewPaymentRepository(db),
+		provider:    provider,
+		receipts:    receipts,
+		printQueue:  printQueue,
 	}
 }
 
 func (s *Server) OrderDrink(ctx context.Context, req *connect.Request[brewpb.OrderRequest]) (*connect.Response[brewpb.OrderResponse], error) {
 	log.Printf("OrderDrink brew go: %v", req.Msg)
 
+	resp, err := s.placeOrder(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// placeOrder prices and records an order and takes the stock it needs.
+func (s *Server) placeOrder(ctx context.Context, msg *brewpb.OrderRequest) (*brewpb.OrderResponse, error) {
+	name, ok := menus.LookupName(msg.MenuItemName)
+	if !ok {
+		return nil, unknownMenuItemError(msg.MenuItemName)
+	}
+
+	if err := menus.ValidateModifiers(name, msg.ModifierIds); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	quantity := int(msg.Quantity)
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	customerID, err := orderCustomerID(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if msg.RedeemLoyalty && customerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required to redeem loyalty stamps"))
+	}
+
+	pickupCode, err := newPickupCode()
+	if err != nil {
+		log.Printf("Failed to create pickup code: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create pickup code: %w", err))
+	}
+
 	order := &models.Order{
-		MenuItemName: req.Msg.MenuItemName,
+		MenuItemName: name,
+		Quantity:     quantity,
+		Status:       models.StatusQueued,
+		CustomerID:   customerID,
+		CustomerName: msg.CustomerName,
+		PickupCode:   pickupCode,
 	}
+	for _, id := range msg.ModifierIds {
+		order.Modifiers = append(order.Modifiers, models.OrderModifier{
+			ModifierID: id,
+			Name:       menus.ModifierName(id),
+		})
+	}
+
+	// The order, its discounts and the stock it uses are written together:
+	// if an ingredient has run out or the promo code is used up, no order
+	// is created.
+	var low []models.Ingredient
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := businessdays.CheckTime(repository.NewBusinessDayRepository(tx), time.Now()); err != nil {
+			return businessdays.Error("check business day", err)
+		}
+		now := time.Now().In(config.AppConfig.Location)
+		discounts, err := orderDiscounts(tx, order, msg.ModifierIds, promos.NormalizeCode(msg.PromoCode), msg.RedeemLoyalty, now)
+		if err != nil {
+			return err
+		}
+		applyQuote(order, quoteOrder(name, msg.ModifierIds, quantity, discounts))
+		// A free drink, e.g. a loyalty reward, has nothing to wait for.
+		if config.AppConfig.RequirePayment && order.TotalCents > 0 {
+			order.Status = models.StatusPendingPayment
+		}
+		order.StatusChanges = []models.OrderStatusChange{{Status: order.Status}}
 
-	if err := s.orderRepo.Create(order); err != nil {
+		if err := repository.NewOrderRepository(tx).Create(order); err != nil {
+			return err
+		}
+		if msg.RedeemLoyalty {
+			if err := loyalty.Redeem(repository.NewLoyaltyRepository(tx), order); err != nil {
+				return err
+			}
+		}
+		usage := menus.Consumption(name, msg.ModifierIds, quantity)
+		low, err = repository.NewInventoryRepository(tx).Consume(order.ID, usage)
+		return err
+	})
+	if errors.Is(err, repository.ErrOutOfStock) {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("cannot make %s: %w", name, err))
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return nil, connectErr
+	}
+	if err != nil {
 		log.Printf("Failed to create order: %v", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create order: %w", err))
 	}
 
-	return connect.NewResponse(&brewpb.OrderResponse{
-		OrderId: fmt.Sprintf("order-%d", order.ID),
-	}), nil
+	for _, ingredient := range low {
+		log.Printf("Low stock: %s is down to %d %s (reorder at %d)", ingredient.Name, ingredient.Stock, ingredient.Unit, ingredient.ReorderThreshold)
+	}
+
+	if order.Status == models.StatusQueued {
+		s.printTickets(order)
+	}
+
+	return &brewpb.OrderResponse{
+		OrderId:    fmt.Sprintf("order-%d", order.ID),
+		Price:      priceBreakdown(order),
+		PickupCode: order.PickupCode,
+	}, nil
 }
 
 func (s *Server) ListOrders(ctx context.Context, req *connect.Request[brewpb.ListOrdersRequest]) (*connect.Response[brewpb.ListOrdersResponse], error) {
@@ -52,11 +173,7 @@ func (s *Server) ListOrders(ctx context.Context, req *connect.Request[brewpb.Lis
 
 	var orderpbs []*brewpb.Order
 	for _, order := range orders {
-		orderpbs = append(orderpbs, &brewpb.Order{
-			OrderId:      fmt.Sprintf("order-%d", order.ID),
-			MenuItemName: order.MenuItemName,
-			Status:       string(order.Status),
-		})
+		orderpbs = append(orderpbs, toProto(&order))
 	}
 
 	return connect.NewResponse(&brewpb.ListOrdersResponse{
@@ -75,13 +192,12 @@ func (s *Server) GetOrder(ctx context.Context, req *connect.Request[brewpb.GetOr
 		log.Printf("Failed to get order: %v", err)
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
 	}
+	if !canAccess(ctx, order) {
+		return nil, errOrderNotFound(req.Msg.OrderId)
+	}
 
 	return connect.NewResponse(&brewpb.GetOrderResponse{
-		Order: &brewpb.Order{
-			OrderId:      fmt.Sprintf("order-%d", order.ID),
-			MenuItemName: order.MenuItemName,
-			Status:       string(order.Status),
-		},
+		Order: orderProto(ctx, order),
 	}), nil
 }
 
@@ -98,29 +214,114 @@ func (s *Server) UpdateOrderStatus(ctx context.Context, req *connect.Request[bre
 	}
 
 	// Convert proto status to model status
-	order.Status = models.OrderStatus(req.Msg.Status.String())
+	status := models.OrderStatus(req.Msg.Status.String())
 
-	if err := s.orderRepo.Update(order); err != nil {
+	// The transition is checked on the locked row, so an order cancelled or
+	// paid meanwhile is not moved on from what was read above.
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, orderID); err != nil {
+			return err
+		}
+		orderRepo := repository.NewOrderRepository(tx)
+		locked, err := orderRepo.Lock(orderID)
+		if err != nil {
+			return err
+		}
+		if err := checkTransition(locked, status); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		from := locked.Status
+		locked.Status = status
+		if status == models.StatusReady {
+			now := time.Now()
+			locked.ReadyAt = &now
+		}
+		ok, err := orderRepo.SetStatus(locked, from)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errStatusChanged
+		}
+		if err := orderRepo.RecordStatus(locked); err != nil {
+			return err
+		}
+		order.Status, order.ReadyAt = locked.Status, locked.ReadyAt
+		if status != models.StatusReady {
+			return nil
+		}
+		// The drink is handed over, so the customer's loyalty card is
+		// stamped with it.
+		_, err = loyalty.Earn(repository.NewLoyaltyRepository(tx), locked)
+		return err
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errOrderNotFound(req.Msg.OrderId)
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return nil, connectErr
+	}
+	if err != nil {
 		log.Printf("Failed to update order status: %v", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update order status: %w", err))
 	}
 
+	// The drink is handed over, so collect the money that was held.
+	if order.Status == models.StatusReady {
+		if err := s.captureOrderPayment(ctx, order); err != nil {
+			log.Printf("Failed to capture payment for order %d: %v", order.ID, err)
+		}
+	}
+
 	return connect.NewResponse(&brewpb.UpdateOrderStatusResponse{
-		Order: &brewpb.Order{
-			OrderId:      fmt.Sprintf("order-%d", order.ID),
-			MenuItemName: order.MenuItemName,
-			Status:       string(order.Status),
-		},
+		Order: toProto(order),
 	}), nil
 }
 
+// errStatusChanged is returned when an order's status changed between
+// locking it and writing the new one, which the lock should rule out.
+var errStatusChanged = errors.New("order status changed meanwhile")
+
+// errHasPayments is returned when deleting an order that has payments.
+var errHasPayments = errors.New("order has payments")
+
 func (s *Server) DeleteOrder(ctx context.Context, req *connect.Request[brewpb.DeleteOrderRequest]) (*connect.Response[brewpb.DeleteOrderResponse], error) {
 	var orderID uint
 	if _, err := fmt.Sscanf(req.Msg.OrderId, "order-%d", &orderID); err != nil {
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid order ID format: %w", err))
 	}
 
-	if err := s.orderRepo.Delete(orderID); err != nil {
+	// Payments keep their order, so a paid order is cancelled or refunded
+	// instead. Locking the order stops a payment arriving meanwhile.
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, orderID); err != nil {
+			return err
+		}
+		orderRepo := repository.NewOrderRepository(tx)
+		if _, err := orderRepo.Lock(orderID); err != nil {
+			return err
+		}
+		payments, err := repository.NewPaymentRepository(tx).FindByOrderID(orderID)
+		if err != nil {
+			return err
+		}
+		if len(payments) > 0 {
+			return errHasPayments
+		}
+		return orderRepo.Delete(orderID)
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("order %s not found", req.Msg.OrderId))
+	}
+	if errors.Is(err, errHasPayments) || errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("order %s has payments, cancel or refund it instead", req.Msg.OrderId))
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return nil, connectErr
+	}
+	if err != nil {
 		log.Printf("Failed to delete order: %v", err)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete order: %w", err))
 	}
@@ -128,4 +329,148 @@ func (s *Server) DeleteOrder(ctx context.Context, req *connect.Request[brewpb.De
 	return connect.NewResponse(&brewpb.DeleteOrderResponse{
 		Success: true,
 	}), nil
-}
\ No newline at end of file
+}
+
+func (s *Server) GetBaristaTicket(ctx context.Context, req *connect.Request[brewpb.GetBaristaTicketRequest]) (*connect.Response[brewpb.GetBaristaTicketResponse], error) {
+	var orderID uint
+	if _, err := fmt.Sscanf(req.Msg.OrderId, "order-%d", &orderID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid order ID format: %w", err))
+	}
+
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+
+	return connect.NewResponse(&brewpb.GetBaristaTicketResponse{
+		Ticket: baristaTicket(order),
+	}), nil
+}
+
+// parseOrderID turns "order-42" into 42.
+func parseOrderID(id string) (uint, error) {
+	var orderID uint
+	if _, err := fmt.Sscanf(id, "order-%d", &orderID); err != nil {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid order ID format: %w", err))
+	}
+	return orderID, nil
+}
+
+// checkDayOpen refuses changes to an order of a closed business day. It is
+// called first in the transaction that changes the order, so the day is
+// not closed before the change is committed.
+func checkDayOpen(tx *gorm.DB, orderID uint) error {
+	if err := businessdays.CheckOrder(repository.NewBusinessDayRepository(tx), orderID); err != nil {
+		return businessdays.Error("check business day", err)
+	}
+	return nil
+}
+
+// orderCustomerID is who an order is placed for. Staff can name any
+// customer and customers order as themselves. An API key such as a
+// kiosk's serves many people, so its orders belong to no customer: they
+// are not listed back to the key and collect no loyalty stamps.
+func orderCustomerID(ctx context.Context, msg *brewpb.OrderRequest) (string, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return "", connect.NewError(connect.CodeUnauthenticated, auth.ErrMissingToken)
+	}
+	switch {
+	case claims.IsStaff():
+		return msg.CustomerId, nil
+	case claims.IsCustomer():
+		if msg.CustomerId != "" && msg.CustomerId != claims.Subject {
+			return "", connect.NewError(connect.CodePermissionDenied, errors.New("only staff can order for another customer"))
+		}
+		return claims.Subject, nil
+	}
+	if msg.CustomerId != "" || msg.RedeemLoyalty {
+		return "", connect.NewError(connect.CodePermissionDenied, errors.New("API keys cannot order for a customer or redeem loyalty stamps"))
+	}
+	return "", nil
+}
+
+// canAccess reports whether the caller may see order. Staff see every
+// order, anyone else only the orders placed as them.
+func canAccess(ctx context.Context, order *models.Order) bool {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	return claims.IsStaff() || (order.CustomerID != "" && order.CustomerID == claims.Subject)
+}
+
+// orderProto is toProto for the caller: only the customer who placed the
+// order gets its pickup code back.
+func orderProto(ctx context.Context, order *models.Order) *brewpb.Order {
+	orderpb := toProto(order)
+	if claims, ok := auth.FromContext(ctx); ok && order.CustomerID != "" && order.CustomerID == claims.Subject {
+		orderpb.PickupCode = order.PickupCode
+	}
+	return orderpb
+}
+
+// errOrderNotFound hides from a caller whether an order they may not see
+// exists.
+func errOrderNotFound(orderID string) error {
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("order %s not found", orderID))
+}
+
+// toProto leaves out the pickup code; see orderProto.
+func toProto(order *models.Order) *brewpb.Order {
+	var modifierIDs []string
+	for _, modifier := range order.Modifiers {
+		modifierIDs = append(modifierIDs, modifier.ModifierID)
+	}
+
+	var refunds []*brewpb.Refund
+	var refundedCents int64
+	for _, refund := range order.Refunds {
+		refunds = append(refunds, toRefundProto(&refund))
+		refundedCents += refund.AmountCents
+	}
+
+	return &brewpb.Order{
+		OrderId:       fmt.Sprintf("order-%d", order.ID),
+		MenuItemName:  order.MenuItemName,
+		Status:        string(order.Status),
+		ModifierIds:   modifierIDs,
+		Quantity:      int32(order.Quantity),
+		Price:         priceBreakdown(order),
+		Refunds:       refunds,
+		RefundedCents: refundedCents,
+		CustomerId:    order.CustomerID,
+		PromoCode:     order.PromoCode,
+		CustomerName:  order.CustomerName,
+		PickedUp:      order.PickedUpAt != nil,
+		CreatedAt:     timestamppb.New(order.CreatedAt),
+	}
+}
+
+// unknownMenuItemError rejects an order for something that is not on the
+// menu. Close matches are attached as a BadRequest detail so clients can
+// offer "did you mean Latte?".
+func unknownMenuItemError(name string) error {
+	suggestions := menus.Suggest(name, 3)
+
+	msg := fmt.Sprintf("unknown menu item %q", name)
+	if len(suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean %q?", suggestions[0])
+	}
+	connectErr := connect.NewError(connect.CodeInvalidArgument, errors.New(msg))
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, suggestion := range suggestions {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       "menu_item_name",
+			Description: fmt.Sprintf("did you mean %q?", suggestion),
+		})
+	}
+	if len(violations) > 0 {
+		if detail, err := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations}); err == nil {
+			connectErr.AddDetail(detail)
+		}
+	}
+	return connectErr
+}
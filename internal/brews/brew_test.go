@@ -0,0 +1,61 @@
+package brews
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/golang-jwt/jwt/v5"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/auth"
+)
+
+func TestOrderCustomerID(t *testing.T) {
+	claims := func(subject string, apiKey bool, roles ...string) *auth.Claims {
+		return &auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: subject},
+			Roles:            roles,
+			APIKey:           apiKey,
+		}
+	}
+	customer := claims("customer-7", false, "customer")
+	kiosk := claims("apikey-1", true, "customer")
+
+	tests := []struct {
+		name     string
+		claims   *auth.Claims
+		msg      *brewpb.OrderRequest
+		want     string
+		wantCode connect.Code
+	}{
+		{name: "customer", claims: customer, msg: &brewpb.OrderRequest{}, want: "customer-7"},
+		{name: "customer naming themselves", claims: customer, msg: &brewpb.OrderRequest{CustomerId: "customer-7", RedeemLoyalty: true}, want: "customer-7"},
+		{name: "customer naming someone else", claims: customer, msg: &brewpb.OrderRequest{CustomerId: "customer-8"}, wantCode: connect.CodePermissionDenied},
+		{name: "staff for a customer", claims: claims("sam", false, "barista"), msg: &brewpb.OrderRequest{CustomerId: "customer-8"}, want: "customer-8"},
+		{name: "staff walk-in", claims: claims("sam", false, "barista"), msg: &brewpb.OrderRequest{}, want: ""},
+		{name: "kiosk", claims: kiosk, msg: &brewpb.OrderRequest{}, want: ""},
+		{name: "kiosk naming a customer", claims: kiosk, msg: &brewpb.OrderRequest{CustomerId: "customer-7"}, wantCode: connect.CodePermissionDenied},
+		{name: "kiosk redeeming stamps", claims: kiosk, msg: &brewpb.OrderRequest{RedeemLoyalty: true}, wantCode: connect.CodePermissionDenied},
+		{name: "no credentials", msg: &brewpb.OrderRequest{}, wantCode: connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = auth.WithClaims(ctx, tt.claims)
+			}
+			got, err := orderCustomerID(ctx, tt.msg)
+			if tt.wantCode != 0 {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode {
+					t.Errorf("orderCustomerID() error = %v, want %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("orderCustomerID() = %q, %v; want %q, nil", got, err, tt.want)
+			}
+		})
+	}
+}
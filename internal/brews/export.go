@@ -0,0 +1,126 @@
+package brews
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/exports"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+// exportChunkSize is the most data sent in one ExportOrdersResponse.
+const exportChunkSize = 32 << 10
+
+func (s *Server) ExportOrders(ctx context.Context, req *connect.Request[brewpb.ExportOrdersRequest], stream *connect.ServerStream[brewpb.ExportOrdersResponse]) error {
+	start, end := req.Msg.Start.AsTime(), req.Msg.End.AsTime()
+	if !end.After(start) {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("end must be after start"))
+	}
+
+	chunks := bufio.NewWriterSize(chunkWriter{stream}, exportChunkSize)
+	if err := s.exportOrders(ctx, chunks, strings.ToLower(req.Msg.Format.String()), start, end); err != nil {
+		log.Printf("Failed to export orders: %v", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export orders: %w", err))
+	}
+	if err := chunks.Flush(); err != nil {
+		log.Printf("Failed to export orders: %v", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export orders: %w", err))
+	}
+	return nil
+}
+
+// ServeExport downloads the orders placed in [start, end) from
+// GET /orders/export?format=csv&start=2025-01-01&end=2025-02-01. start and
+// end are dates in the shop's time zone or RFC 3339 times; format is csv
+// (the default) or ndjson.
+func (s *Server) ServeExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := strings.ToLower(query.Get("format"))
+	if format == "" {
+		format = exports.FormatCSV
+	}
+	contentType, ok := exports.ContentTypes[format]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown format %q, use csv or ndjson", format), http.StatusBadRequest)
+		return
+	}
+	start, err := parseExportTime(query.Get("start"))
+	if err != nil {
+		http.Error(w, "start: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	end, err := parseExportTime(query.Get("end"))
+	if err != nil {
+		http.Error(w, "end: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !end.After(start) {
+		http.Error(w, "end must be after start", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="orders-%s-%s.%s"`,
+		start.In(config.AppConfig.Location).Format("20060102"), end.In(config.AppConfig.Location).Format("20060102"), format))
+	if err := s.exportOrders(r.Context(), w, format, start, end); err != nil {
+		// The status line has gone out already; a truncated file is all
+		// the client will see.
+		log.Printf("Failed to export orders: %v", err)
+	}
+}
+
+// exportOrders writes the orders created in [start, end) to w in format.
+func (s *Server) exportOrders(ctx context.Context, w io.Writer, format string, start, end time.Time) error {
+	writer, err := exports.NewWriter(w, format, config.AppConfig.Location)
+	if err != nil {
+		return err
+	}
+	// Order times are stored as local wall clock time.
+	err = s.orderRepo.ForEachCreated(start.Local(), end.Local(), func(order *models.Order) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return writer.Write(order)
+	})
+	if err != nil {
+		return err
+	}
+	return writer.Flush()
+}
+
+// parseExportTime reads a date in the shop's time zone or an RFC 3339 time.
+func parseExportTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("is required")
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, value, config.AppConfig.Location); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.New("must be a date like 2025-01-31 or an RFC 3339 time")
+	}
+	return t, nil
+}
+
+// chunkWriter sends everything written to it as one ExportOrdersResponse.
+type chunkWriter struct {
+	stream *connect.ServerStream[brewpb.ExportOrdersResponse]
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&brewpb.ExportOrdersResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,384 @@
+package brews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/cash"
+	"github.com/jany/my-coffee/internal/giftcards"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/payments"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	// errPaidMeanwhile is returned when another payment for the order was
+	// recorded while this one was being prepared.
+	errPaidMeanwhile  = errors.New("order was paid by another payment in the meantime")
+	errOrderCancelled = errors.New("order was cancelled in the meantime")
+)
+
+// PayOrder pays what is still due on an order. A gift card pays first, then
+// cash, both settled straight away; a card token pays the rest, authorized
+// with the payment provider and captured when the drink is READY. An order waiting
+// in PENDING_PAYMENT moves to QUEUED once nothing is due.
+func (s *Server) PayOrder(ctx context.Context, req *connect.Request[brewpb.PayOrderRequest]) (*connect.Response[brewpb.PayOrderResponse], error) {
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	// Checked again when the payment is written; checking first keeps a
+	// card from being held for a closed day.
+	if err := checkDayOpen(s.db, orderID); err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+	if !canPay(ctx, order) {
+		return nil, errOrderNotFound(req.Msg.OrderId)
+	}
+	if order.Status == models.StatusCancelled {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("order %s is cancelled", req.Msg.OrderId))
+	}
+
+	existing, err := s.paymentRepo.FindByOrderID(order.ID)
+	if err != nil {
+		log.Printf("Failed to look up payments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up payments: %w", err))
+	}
+	due := amountDue(order, existing)
+	// Free orders placed before they were queued straight away still
+	// wait in PENDING_PAYMENT; paying nothing queues them.
+	if due == 0 && order.Status != models.StatusPendingPayment {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("order %s is already paid", req.Msg.OrderId))
+	}
+
+	// Split the amount before charging anything, so a declined card does
+	// not leave the gift card spent.
+	var giftCents int64
+	if req.Msg.GiftCardCode != "" {
+		card, err := giftcards.Find(repository.NewGiftCardRepository(s.db), req.Msg.GiftCardCode, order.Currency)
+		if err != nil {
+			return nil, giftCardError(err)
+		}
+		if card.BalanceCents == 0 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("gift card is empty"))
+		}
+		giftCents = min(card.BalanceCents, due)
+	}
+
+	var cashCents, changeCents int64
+	if tender := req.Msg.Cash; tender != nil {
+		cashCents = min(tender.AmountGivenCents, due-giftCents)
+		changeCents = tender.AmountGivenCents - cashCents
+	}
+
+	var cardPayment *models.Payment
+	if cardCents := due - giftCents - cashCents; cardCents > 0 && req.Msg.PaymentToken != "" {
+		cardPayment, err = s.authorize(ctx, order, cardCents, req.Msg.PaymentToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var giftPayment, cashPayment *models.Payment
+	var queued bool
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, order.ID); err != nil {
+			return err
+		}
+		orderRepo := repository.NewOrderRepository(tx)
+		paymentRepo := repository.NewPaymentRepository(tx)
+
+		// A concurrent payment for the order waits here until this one
+		// commits, then sees what is left to pay.
+		locked, err := orderRepo.Lock(order.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status == models.StatusCancelled {
+			return errOrderCancelled
+		}
+		existing, err := paymentRepo.FindByOrderID(order.ID)
+		if err != nil {
+			return err
+		}
+		due = amountDue(locked, existing)
+		planned := giftCents + cashCents
+		if cardPayment != nil {
+			planned += cardPayment.AmountCents
+		}
+		if planned > due {
+			return errPaidMeanwhile
+		}
+		order.Status = locked.Status
+
+		if giftCents > 0 {
+			giftCardRepo := repository.NewGiftCardRepository(tx)
+			card, err := giftcards.Lock(giftCardRepo, req.Msg.GiftCardCode, order.Currency)
+			if err != nil {
+				return err
+			}
+			// Fails if the card was spent elsewhere since it was read.
+			if err := giftcards.Redeem(giftCardRepo, card, order.ID, giftCents); err != nil {
+				return err
+			}
+			giftPayment = &models.Payment{
+				OrderID:       order.ID,
+				Provider:      giftcards.ProviderName,
+				ProviderRef:   card.Code,
+				Method:        models.PaymentMethodGiftCard,
+				Status:        models.PaymentCaptured,
+				AmountCents:   giftCents,
+				CapturedCents: giftCents,
+				Currency:      order.Currency,
+			}
+			if err := paymentRepo.Create(giftPayment); err != nil {
+				return err
+			}
+			due -= giftCents
+		}
+		if cashCents > 0 {
+			cashPayment = &models.Payment{
+				OrderID:       order.ID,
+				Provider:      cash.ProviderName,
+				ProviderRef:   req.Msg.Cash.Register,
+				Method:        models.PaymentMethodCash,
+				Status:        models.PaymentCaptured,
+				AmountCents:   cashCents,
+				CapturedCents: cashCents,
+				Currency:      order.Currency,
+			}
+			if err := paymentRepo.Create(cashPayment); err != nil {
+				return err
+			}
+			// Fails unless the register has an open shift.
+			_, err := cash.Record(repository.NewCashRepository(tx), req.Msg.Cash.Register, &models.CashMovement{
+				Kind:        models.CashSale,
+				AmountCents: cashCents,
+				OrderID:     &order.ID,
+				PaymentID:   &cashPayment.ID,
+			})
+			if err != nil {
+				return err
+			}
+			due -= cashCents
+		}
+		if cardPayment != nil {
+			if err := paymentRepo.Create(cardPayment); err != nil {
+				return err
+			}
+			due -= cardPayment.AmountCents
+		}
+
+		if due > 0 || locked.Status != models.StatusPendingPayment {
+			return nil
+		}
+		locked.Status = models.StatusQueued
+		ok, err := orderRepo.SetStatus(locked, models.StatusPendingPayment)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errStatusChanged
+		}
+		if err := orderRepo.RecordStatus(locked); err != nil {
+			return err
+		}
+		order.Status = locked.Status
+		queued = true
+		return nil
+	})
+	if err != nil {
+		if cardPayment != nil {
+			// Release the hold so the customer is not charged for an order
+			// we could not record as paid.
+			if _, refundErr := s.provider.Refund(ctx, cardPayment.ProviderRef, cardPayment.AmountCents); refundErr != nil {
+				log.Printf("Failed to release authorization %s: %v", cardPayment.ProviderRef, refundErr)
+			}
+		}
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			return nil, connectErr
+		}
+		if isGiftCardError(err) {
+			return nil, giftCardError(err)
+		}
+		if errors.Is(err, errPaidMeanwhile) || errors.Is(err, errOrderCancelled) {
+			return nil, connect.NewError(connect.CodeAborted, fmt.Errorf("order %s: %w", req.Msg.OrderId, err))
+		}
+		return nil, cash.Error("save payment", err)
+	}
+
+	if queued {
+		s.printTickets(order)
+	}
+
+	// Pay-at-counter orders can be paid after the drink is already done.
+	if cardPayment != nil && order.Status == models.StatusReady {
+		if err := s.capturePayment(ctx, cardPayment); err != nil {
+			log.Printf("Failed to capture payment: %v", err)
+		}
+	}
+
+	resp := &brewpb.PayOrderResponse{
+		Order:          orderProto(ctx, order),
+		AmountDueCents: due,
+		ChangeDueCents: changeCents,
+	}
+	for _, payment := range []*models.Payment{giftPayment, cashPayment, cardPayment} {
+		if payment == nil {
+			continue
+		}
+		resp.Payment = toPaymentProto(payment)
+		resp.Payments = append(resp.Payments, resp.Payment)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// authorize places a hold of amountCents on the customer's card. Declined
+// attempts are recorded before the error is returned.
+func (s *Server) authorize(ctx context.Context, order *models.Order, amountCents int64, token string) (*models.Payment, error) {
+	payment := &models.Payment{
+		OrderID:     order.ID,
+		Provider:    s.provider.Name(),
+		Method:      models.PaymentMethodCard,
+		AmountCents: amountCents,
+		Currency:    order.Currency,
+	}
+
+	authorization, err := s.provider.Authorize(ctx, payments.AuthorizeRequest{
+		OrderID:     order.ID,
+		AmountCents: amountCents,
+		Currency:    order.Currency,
+		Token:       token,
+	})
+	if errors.Is(err, payments.ErrDeclined) {
+		payment.Status = models.PaymentDeclined
+		payment.FailureReason = err.Error()
+		if err := s.paymentRepo.Create(payment); err != nil {
+			log.Printf("Failed to record declined payment: %v", err)
+		}
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		log.Printf("Failed to authorize payment: %v", err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to authorize payment: %w", err))
+	}
+
+	payment.Status = models.PaymentAuthorized
+	payment.ProviderRef = authorization.Reference
+	return payment, nil
+}
+
+// canPay reports whether the caller may pay order: whoever may see it, and
+// API keys such as a kiosk's for the orders that belong to no customer,
+// which are the ones they place.
+func canPay(ctx context.Context, order *models.Order) bool {
+	if canAccess(ctx, order) {
+		return true
+	}
+	claims, ok := auth.FromContext(ctx)
+	return ok && claims.APIKey && order.CustomerID == ""
+}
+
+// amountDue is what is left to pay on an order. Refunded payments still
+// count: the order was paid and the money given back.
+func amountDue(order *models.Order, payments []models.Payment) int64 {
+	due := order.TotalCents
+	for _, payment := range payments {
+		if payment.IsSettled() || payment.Status == models.PaymentRefunded {
+			due -= payment.AmountCents
+		}
+	}
+	return max(due, 0)
+}
+
+func isGiftCardError(err error) bool {
+	return errors.Is(err, giftcards.ErrUnknownCard) ||
+		errors.Is(err, giftcards.ErrInactive) ||
+		errors.Is(err, giftcards.ErrInsufficientBalance) ||
+		errors.Is(err, giftcards.ErrCurrencyMismatch)
+}
+
+// giftCardError turns an error from the giftcards package into a Connect
+// error.
+func giftCardError(err error) error {
+	if errors.Is(err, giftcards.ErrUnknownCard) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if isGiftCardError(err) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	log.Printf("Failed to look up gift card: %v", err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up gift card: %w", err))
+}
+
+func (s *Server) GetPayment(ctx context.Context, req *connect.Request[brewpb.GetPaymentRequest]) (*connect.Response[brewpb.GetPaymentResponse], error) {
+	var paymentID uint
+	if _, err := fmt.Sscanf(req.Msg.PaymentId, "payment-%d", &paymentID); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid payment ID format: %w", err))
+	}
+
+	payment, err := s.paymentRepo.FindByID(paymentID)
+	if err != nil {
+		log.Printf("Failed to get payment: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get payment: %w", err))
+	}
+
+	return connect.NewResponse(&brewpb.GetPaymentResponse{
+		Payment: toPaymentProto(payment),
+	}), nil
+}
+
+// captureOrderPayment collects the authorized card payments of an order.
+func (s *Server) captureOrderPayment(ctx context.Context, order *models.Order) error {
+	payments, err := s.paymentRepo.FindByOrderID(order.ID)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i := range payments {
+		if payments[i].Status == models.PaymentAuthorized {
+			errs = append(errs, s.capturePayment(ctx, &payments[i]))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// capturePayment collects what is left of an authorization after any
+// refunds made before the drink was ready.
+func (s *Server) capturePayment(ctx context.Context, payment *models.Payment) error {
+	amount := payment.AmountCents - payment.RefundedCents
+	if err := s.provider.Capture(ctx, payment.ProviderRef, amount); err != nil {
+		return fmt.Errorf("capture payment-%d: %w", payment.ID, err)
+	}
+
+	payment.Status = models.PaymentCaptured
+	payment.CapturedCents = amount
+	return s.paymentRepo.MarkCaptured(payment)
+}
+
+func toPaymentProto(payment *models.Payment) *brewpb.Payment {
+	return &brewpb.Payment{
+		PaymentId:     fmt.Sprintf("payment-%d", payment.ID),
+		OrderId:       fmt.Sprintf("order-%d", payment.OrderID),
+		Status:        string(payment.Status),
+		Method:        payment.Method,
+		AmountCents:   payment.AmountCents,
+		CapturedCents: payment.CapturedCents,
+		Currency:      payment.Currency,
+		Provider:      payment.Provider,
+		RefundedCents: payment.RefundedCents,
+	}
+}
@@ -0,0 +1,99 @@
+package brews
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestAmountDue(t *testing.T) {
+	payment := func(status models.PaymentStatus, amountCents int64) models.Payment {
+		return models.Payment{Status: status, AmountCents: amountCents}
+	}
+	tests := []struct {
+		name     string
+		total    int64
+		payments []models.Payment
+		want     int64
+	}{
+		{name: "nothing paid", total: 450, want: 450},
+		{name: "free order", total: 0, want: 0},
+		{
+			name:     "card hold",
+			total:    450,
+			payments: []models.Payment{payment(models.PaymentAuthorized, 450)},
+			want:     0,
+		},
+		{
+			name:     "gift card then cash",
+			total:    450,
+			payments: []models.Payment{payment(models.PaymentCaptured, 200), payment(models.PaymentCaptured, 100)},
+			want:     150,
+		},
+		{
+			name:     "declined card",
+			total:    450,
+			payments: []models.Payment{payment(models.PaymentDeclined, 450)},
+			want:     450,
+		},
+		{
+			name:     "refunded payment still counts",
+			total:    450,
+			payments: []models.Payment{payment(models.PaymentRefunded, 450)},
+			want:     0,
+		},
+		{
+			name:     "overpaid",
+			total:    450,
+			payments: []models.Payment{payment(models.PaymentCaptured, 300), payment(models.PaymentAuthorized, 300)},
+			want:     0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &models.Order{TotalCents: tt.total}
+			if got := amountDue(order, tt.payments); got != tt.want {
+				t.Errorf("amountDue() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanPay(t *testing.T) {
+	claims := func(subject string, apiKey bool, roles ...string) *auth.Claims {
+		return &auth.Claims{
+			RegisteredClaims: jwt.RegisteredClaims{Subject: subject},
+			Roles:            roles,
+			APIKey:           apiKey,
+		}
+	}
+	tests := []struct {
+		name     string
+		claims   *auth.Claims
+		customer string
+		want     bool
+	}{
+		{name: "owner", claims: claims("alice", false, "customer"), customer: "alice", want: true},
+		{name: "other customer", claims: claims("bob", false, "customer"), customer: "alice"},
+		{name: "staff", claims: claims("sam", false, "barista"), customer: "alice", want: true},
+		{name: "kiosk key pays its own order", claims: claims("kiosk", true, "customer"), want: true},
+		{name: "kiosk key and a customer's order", claims: claims("kiosk", true, "customer"), customer: "alice"},
+		{name: "customer and an anonymous order", claims: claims("bob", false, "customer")},
+		{name: "no credentials", customer: "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.claims != nil {
+				ctx = auth.WithClaims(ctx, tt.claims)
+			}
+			order := &models.Order{CustomerID: tt.customer}
+			if got := canPay(ctx, order); got != tt.want {
+				t.Errorf("canPay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
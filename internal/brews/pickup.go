@@ -0,0 +1,139 @@
+package brews
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/qrcodes"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// qrSize is the width and height of QR code PNGs in pixels.
+const qrSize = 256
+
+var errWrongPickupCode = errors.New("pickup code does not match the order")
+
+func (s *Server) VerifyPickup(ctx context.Context, req *connect.Request[brewpb.VerifyPickupRequest]) (*connect.Response[brewpb.VerifyPickupResponse], error) {
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+
+	if !pickupCodeMatches(order, req.Msg.PickupCode) {
+		return nil, connect.NewError(connect.CodePermissionDenied, errWrongPickupCode)
+	}
+	if order.PickedUpAt != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("order was already picked up at %s", order.PickedUpAt.In(config.AppConfig.Location).Format(time.Kitchen)))
+	}
+	if order.Status != models.StatusReady {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("order is %s, not READY", order.Status))
+	}
+
+	now := time.Now()
+	var ok bool
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, order.ID); err != nil {
+			return err
+		}
+		ok, err = repository.NewOrderRepository(tx).MarkPickedUp(order.ID, now)
+		return err
+	})
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return nil, connectErr
+	}
+	if err != nil {
+		log.Printf("Failed to record pickup: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to record pickup: %w", err))
+	}
+	if !ok {
+		// Someone else scanned it between the lookup and the update.
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("order was already picked up"))
+	}
+	order.PickedUpAt = &now
+
+	return connect.NewResponse(&brewpb.VerifyPickupResponse{
+		Order: toProto(order),
+	}), nil
+}
+
+// ServeQRCode serves the QR code of an order as GET /orders/{id}/qr.png or
+// /orders/{id}/qr.svg. It carries the pickup code, so it is only served
+// behind auth.Verifier.Require and, like GetReceipt, only to staff and the
+// customer who placed the order. Anyone else gets 404 Not Found.
+func (s *Server) ServeQRCode(w http.ResponseWriter, r *http.Request) {
+	orderID, err := parseOrderID(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid order ID", http.StatusBadRequest)
+		return
+	}
+
+	order, err := s.orderRepo.FindByID(orderID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && !canAccess(r.Context(), order)) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		http.Error(w, "failed to get order", http.StatusInternalServerError)
+		return
+	}
+
+	content := trackingURL(order)
+	if strings.HasSuffix(r.URL.Path, ".svg") {
+		svg, err := qrcodes.SVG(content)
+		if err != nil {
+			log.Printf("Failed to draw QR code: %v", err)
+			http.Error(w, "failed to draw QR code", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "image/svg+xml")
+		w.Write([]byte(svg))
+		return
+	}
+
+	png, err := qrcodes.PNG(content, qrSize)
+	if err != nil {
+		log.Printf("Failed to draw QR code: %v", err)
+		http.Error(w, "failed to draw QR code", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Write(png)
+}
+
+// trackingURL is what an order's QR code encodes: the tracking page with
+// the order ID and pickup code, which the counter scans to call
+// VerifyPickup.
+func trackingURL(order *models.Order) string {
+	query := url.Values{}
+	query.Set("order", fmt.Sprintf("order-%d", order.ID))
+	query.Set("code", order.PickupCode)
+	return config.AppConfig.TrackingURL + "?" + query.Encode()
+}
+
+// pickupCodeMatches compares codes case-insensitively and in constant
+// time. Orders from before pickup codes never match.
+func pickupCodeMatches(order *models.Order, code string) bool {
+	if order.PickupCode == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(order.PickupCode), []byte(strings.ToUpper(strings.TrimSpace(code)))) == 1
+}
@@ -0,0 +1,173 @@
+package brews
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/loyalty"
+	"github.com/jany/my-coffee/internal/menus"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/pricing"
+	"github.com/jany/my-coffee/internal/promos"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// quoteOrder prices quantity drinks called name with the given (validated)
+// modifiers, using the current menu prices and tax settings.
+func quoteOrder(name string, modifierIDs []string, quantity int, discounts []pricing.Discount) pricing.Quote {
+	price, _ := menus.Price(name)
+	item := pricing.Item{
+		Name:      name,
+		UnitCents: pricing.Cents(price),
+		Quantity:  int64(quantity),
+	}
+	for _, id := range modifierIDs {
+		item.Modifiers = append(item.Modifiers, pricing.Modifier{
+			Name:      menus.ModifierName(id),
+			UnitCents: pricing.Cents(menus.ModifierPrice(id)),
+		})
+	}
+
+	tax := pricing.Tax{
+		Rate:      config.AppConfig.TaxRate,
+		Inclusive: config.AppConfig.TaxInclusive,
+	}
+	return pricing.Calculate(item, discounts, tax)
+}
+
+// orderDiscounts collects the discount rules that match the order at now,
+// the promo code if one was given, and a free drink when the customer
+// redeems loyalty stamps. It runs inside the order transaction so that usage
+// limits and stamp balances hold when orders come in at the same time.
+func orderDiscounts(tx *gorm.DB, order *models.Order, modifierIDs []string, promoCode string, redeemLoyalty bool, now time.Time) ([]pricing.Discount, error) {
+	undiscounted := quoteOrder(order.MenuItemName, modifierIDs, order.Quantity, nil)
+	cart := promos.Cart{
+		MenuItemName:  order.MenuItemName,
+		Tags:          menus.Tags(order.MenuItemName),
+		Quantity:      int64(order.Quantity),
+		DrinkCents:    undiscounted.SubtotalCents / int64(order.Quantity),
+		SubtotalCents: undiscounted.SubtotalCents,
+		CustomerID:    order.CustomerID,
+	}
+
+	promoRepo := repository.NewPromoRepository(tx)
+	rules, err := promoRepo.FindActiveRules(now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load discount rules: %w", err)
+	}
+	discounts := promos.RuleDiscounts(rules, cart, now)
+
+	if redeemLoyalty {
+		err := loyalty.Reserve(repository.NewLoyaltyRepository(tx), order.CustomerID)
+		if errors.Is(err, loyalty.ErrInsufficientStamps) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to check loyalty stamps: %w", err)
+		}
+		discounts = append(discounts, pricing.Discount{
+			Description: "Loyalty reward: free " + order.MenuItemName,
+			AmountCents: cart.DrinkCents,
+		})
+	}
+
+	if promoCode == "" {
+		return discounts, nil
+	}
+	promo, err := promoRepo.FindCodeForUpdate(promoCode)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w %q", promos.ErrUnknownCode, promoCode))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load promo code: %w", err)
+	}
+	var usage promos.Usage
+	usage.Total, usage.ByCustomer, err = promoRepo.CountUses(promo.ID, order.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count promo code uses: %w", err)
+	}
+	discount, err := promos.CodeDiscount(promo, cart, usage, now)
+	if errors.Is(err, promos.ErrNotApplicable) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	order.PromoCode = promo.Code
+	return append(discounts, discount), nil
+}
+
+// applyQuote copies the price breakdown onto the order so it is persisted
+// with it; later menu or tax changes do not alter what was charged.
+func applyQuote(order *models.Order, quote pricing.Quote) {
+	order.UnitPriceCents = quote.Lines[0].UnitCents
+	for i := range order.Modifiers {
+		// Lines after the first are the modifiers, in the same order.
+		order.Modifiers[i].PriceCents = quote.Lines[i+1].UnitCents
+	}
+	order.Discounts = nil
+	for _, discount := range quote.Discounts {
+		applied := models.OrderDiscount{
+			Description: discount.Description,
+			AmountCents: discount.AmountCents,
+		}
+		switch ref := discount.Ref.(type) {
+		case *models.PromoCode:
+			applied.PromoCodeID = &ref.ID
+		case *models.DiscountRule:
+			applied.DiscountRuleID = &ref.ID
+		}
+		order.Discounts = append(order.Discounts, applied)
+	}
+	order.SubtotalCents = quote.SubtotalCents
+	order.DiscountCents = quote.DiscountCents
+	order.TaxCents = quote.TaxCents
+	order.TotalCents = quote.TotalCents
+	order.TaxRate = quote.Tax.Rate
+	order.TaxInclusive = quote.Tax.Inclusive
+	order.Currency = config.AppConfig.Currency
+}
+
+// priceBreakdown renders the stored prices of an order.
+func priceBreakdown(order *models.Order) *brewpb.PriceBreakdown {
+	quantity := int32(order.Quantity)
+	lines := []*brewpb.PriceLine{{
+		Description:    order.MenuItemName,
+		Quantity:       quantity,
+		UnitPriceCents: order.UnitPriceCents,
+		TotalCents:     int64(quantity) * order.UnitPriceCents,
+	}}
+	for _, modifier := range order.Modifiers {
+		lines = append(lines, &brewpb.PriceLine{
+			Description:    "+ " + modifier.Name,
+			Quantity:       quantity,
+			UnitPriceCents: modifier.PriceCents,
+			TotalCents:     int64(quantity) * modifier.PriceCents,
+		})
+	}
+
+	var discounts []*brewpb.DiscountLine
+	for _, discount := range order.Discounts {
+		discounts = append(discounts, &brewpb.DiscountLine{
+			Description: discount.Description,
+			AmountCents: discount.AmountCents,
+		})
+	}
+
+	return &brewpb.PriceBreakdown{
+		Lines:         lines,
+		SubtotalCents: order.SubtotalCents,
+		DiscountCents: order.DiscountCents,
+		TaxCents:      order.TaxCents,
+		TotalCents:    order.TotalCents,
+		TaxRate:       order.TaxRate,
+		TaxInclusive:  order.TaxInclusive,
+		Currency:      order.Currency,
+		Discounts:     discounts,
+	}
+}
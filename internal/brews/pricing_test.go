@@ -0,0 +1,135 @@
+package brews
+
+import (
+	"testing"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/pricing"
+)
+
+// setConfig replaces the app config for the rest of the test.
+func setConfig(t *testing.T, cfg *config.Config) {
+	t.Helper()
+	previous := config.AppConfig
+	config.AppConfig = cfg
+	t.Cleanup(func() { config.AppConfig = previous })
+}
+
+func TestQuoteOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		drink        string
+		modifierIDs  []string
+		quantity     int
+		discounts    []pricing.Discount
+		taxRate      float64
+		taxInclusive bool
+		wantSubtotal int64
+		wantDiscount int64
+		wantTax      int64
+		wantTotal    int64
+	}{
+		{
+			name:  "one drink",
+			drink: "Latte", quantity: 1,
+			wantSubtotal: 350, wantTotal: 350,
+		},
+		{
+			name:  "modifiers and quantity with tax on top",
+			drink: "Latte", modifierIDs: []string{"oat-milk", "extra-shot"}, quantity: 2,
+			taxRate:      0.1,
+			wantSubtotal: 980, wantTax: 98, wantTotal: 1078,
+		},
+		{
+			name:  "tax included in the price",
+			drink: "Latte", quantity: 1,
+			taxRate: 0.1, taxInclusive: true,
+			wantSubtotal: 350, wantTax: 32, wantTotal: 350,
+		},
+		{
+			name:  "discount before tax",
+			drink: "Espresso", quantity: 1,
+			discounts:    []pricing.Discount{{Description: "Happy hour", AmountCents: 100}},
+			taxRate:      0.1,
+			wantSubtotal: 250, wantDiscount: 100, wantTax: 15, wantTotal: 165,
+		},
+		{
+			name:  "discounts stop at zero",
+			drink: "Espresso", quantity: 1,
+			discounts: []pricing.Discount{
+				{Description: "Loyalty reward", AmountCents: 250},
+				{Description: "Promo", AmountCents: 50},
+			},
+			wantSubtotal: 250, wantDiscount: 250, wantTotal: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setConfig(t, &config.Config{TaxRate: tt.taxRate, TaxInclusive: tt.taxInclusive})
+
+			quote := quoteOrder(tt.drink, tt.modifierIDs, tt.quantity, tt.discounts)
+			if quote.SubtotalCents != tt.wantSubtotal || quote.DiscountCents != tt.wantDiscount ||
+				quote.TaxCents != tt.wantTax || quote.TotalCents != tt.wantTotal {
+				t.Errorf("quote = subtotal %d, discount %d, tax %d, total %d; want %d, %d, %d, %d",
+					quote.SubtotalCents, quote.DiscountCents, quote.TaxCents, quote.TotalCents,
+					tt.wantSubtotal, tt.wantDiscount, tt.wantTax, tt.wantTotal)
+			}
+			if want := 1 + len(tt.modifierIDs); len(quote.Lines) != want {
+				t.Errorf("got %d lines, want %d", len(quote.Lines), want)
+			}
+		})
+	}
+}
+
+func TestApplyQuote(t *testing.T) {
+	setConfig(t, &config.Config{Currency: "EUR", TaxRate: 0.1})
+
+	order := &models.Order{
+		MenuItemName: "Latte",
+		Quantity:     1,
+		Modifiers: []models.OrderModifier{
+			{ModifierID: "oat-milk", Name: "Oat milk"},
+			{ModifierID: "vanilla-syrup", Name: "Vanilla syrup"},
+		},
+		// Left over from an earlier quote.
+		Discounts: []models.OrderDiscount{{Description: "stale", AmountCents: 999}},
+	}
+	promo := &models.PromoCode{ID: 7}
+	rule := &models.DiscountRule{ID: 3}
+	quote := quoteOrder("Latte", []string{"oat-milk", "vanilla-syrup"}, 1, []pricing.Discount{
+		{Description: "Morning rule", AmountCents: 50, Ref: rule},
+		{Description: "WELCOME", AmountCents: 100, Ref: promo},
+		{Description: "Loyalty reward", AmountCents: 10},
+	})
+	applyQuote(order, quote)
+
+	if order.UnitPriceCents != 350 {
+		t.Errorf("UnitPriceCents = %d, want 350", order.UnitPriceCents)
+	}
+	for i, want := range []int64{60, 50} {
+		if got := order.Modifiers[i].PriceCents; got != want {
+			t.Errorf("modifier %d PriceCents = %d, want %d", i, got, want)
+		}
+	}
+	if order.SubtotalCents != 460 || order.DiscountCents != 160 || order.TaxCents != 30 || order.TotalCents != 330 {
+		t.Errorf("order = subtotal %d, discount %d, tax %d, total %d; want 460, 160, 30, 330",
+			order.SubtotalCents, order.DiscountCents, order.TaxCents, order.TotalCents)
+	}
+	if order.TaxRate != 0.1 || order.TaxInclusive || order.Currency != "EUR" {
+		t.Errorf("order tax = %v inclusive %v in %s, want 0.1 exclusive in EUR", order.TaxRate, order.TaxInclusive, order.Currency)
+	}
+
+	if len(order.Discounts) != 3 {
+		t.Fatalf("got %d discounts, want 3", len(order.Discounts))
+	}
+	if d := order.Discounts[0]; d.DiscountRuleID == nil || *d.DiscountRuleID != 3 || d.PromoCodeID != nil {
+		t.Errorf("rule discount = %+v, want rule 3", d)
+	}
+	if d := order.Discounts[1]; d.PromoCodeID == nil || *d.PromoCodeID != 7 || d.DiscountRuleID != nil {
+		t.Errorf("promo discount = %+v, want promo code 7", d)
+	}
+	if d := order.Discounts[2]; d.PromoCodeID != nil || d.DiscountRuleID != nil || d.AmountCents != 10 {
+		t.Errorf("loyalty discount = %+v, want 10 cents without a reference", d)
+	}
+}
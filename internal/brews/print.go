@@ -0,0 +1,36 @@
+package brews
+
+import (
+	"fmt"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/printer"
+)
+
+// printTickets queues a sticker for every cup of an order that has just
+// been QUEUED. It does nothing when no printer is configured.
+func (s *Server) printTickets(order *models.Order) {
+	if s.printQueue == nil {
+		return
+	}
+
+	var modifiers []string
+	for _, modifier := range order.Modifiers {
+		modifiers = append(modifiers, modifier.Name)
+	}
+	ticket := printer.Ticket{
+		OrderID:      fmt.Sprintf("order-%d", order.ID),
+		PickupCode:   order.PickupCode,
+		CustomerName: order.CustomerName,
+		Drink:        order.MenuItemName,
+		Modifiers:    modifiers,
+		CreatedAt:    order.CreatedAt.In(config.AppConfig.Location),
+	}
+	for _, cup := range printer.Tickets(ticket, order.Quantity) {
+		s.printQueue.Enqueue(printer.Job{
+			Name: fmt.Sprintf("%s cup %d/%d", cup.OrderID, cup.Cup, cup.Cups),
+			Data: printer.Render(cup),
+		})
+	}
+}
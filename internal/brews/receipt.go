@@ -0,0 +1,132 @@
+package brews
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/receipts"
+)
+
+// pickupAlphabet leaves out letters and digits that sound or look alike
+// when a code is called out or shown on a screen.
+const pickupAlphabet = "ACDEFHJKMNPRTUVWXY34679"
+
+// paymentLabels are how payment methods read on a receipt.
+var paymentLabels = map[string]string{
+	models.PaymentMethodCard:     "Card",
+	models.PaymentMethodGiftCard: "Gift card",
+	models.PaymentMethodCash:     "Cash",
+}
+
+func (s *Server) GetReceipt(ctx context.Context, req *connect.Request[brewpb.GetReceiptRequest]) (*connect.Response[brewpb.GetReceiptResponse], error) {
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+	// The receipt carries the pickup code.
+	if !canAccess(ctx, order) {
+		return nil, errOrderNotFound(req.Msg.OrderId)
+	}
+
+	payments, err := s.paymentRepo.FindByOrderID(order.ID)
+	if err != nil {
+		log.Printf("Failed to look up payments: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to look up payments: %w", err))
+	}
+
+	receipt := buildReceipt(order, payments)
+	text, err := s.receipts.Text(receipt)
+	if err != nil {
+		log.Printf("Failed to render receipt: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to render receipt: %w", err))
+	}
+	html, err := s.receipts.HTML(receipt)
+	if err != nil {
+		log.Printf("Failed to render receipt: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to render receipt: %w", err))
+	}
+
+	return connect.NewResponse(&brewpb.GetReceiptResponse{
+		Text: text,
+		Html: html,
+	}), nil
+}
+
+// buildReceipt collects what the receipt templates show from the stored
+// order, so a receipt printed later matches what was charged.
+func buildReceipt(order *models.Order, payments []models.Payment) *receipts.Receipt {
+	breakdown := priceBreakdown(order)
+	receipt := &receipts.Receipt{
+		Store: receipts.Store{
+			Name:    config.AppConfig.StoreName,
+			Address: config.AppConfig.StoreAddress,
+			Phone:   config.AppConfig.StorePhone,
+			Footer:  config.AppConfig.ReceiptFooter,
+		},
+		OrderID:        fmt.Sprintf("order-%d", order.ID),
+		PickupCode:     order.PickupCode,
+		TrackingURL:    trackingURL(order),
+		CreatedAt:      order.CreatedAt.In(config.AppConfig.Location),
+		SubtotalCents:  order.SubtotalCents,
+		DiscountCents:  order.DiscountCents,
+		TaxCents:       order.TaxCents,
+		TotalCents:     order.TotalCents,
+		TaxRate:        order.TaxRate,
+		TaxInclusive:   order.TaxInclusive,
+		Currency:       order.Currency,
+		AmountDueCents: amountDue(order, payments),
+	}
+	for _, line := range breakdown.Lines {
+		receipt.Lines = append(receipt.Lines, receipts.Line{
+			Description: line.Description,
+			Quantity:    int64(line.Quantity),
+			UnitCents:   line.UnitPriceCents,
+			TotalCents:  line.TotalCents,
+		})
+	}
+	for _, discount := range order.Discounts {
+		receipt.Discounts = append(receipt.Discounts, receipts.Discount{
+			Description: discount.Description,
+			AmountCents: discount.AmountCents,
+		})
+	}
+	for _, payment := range payments {
+		if !payment.IsSettled() && payment.Status != models.PaymentRefunded {
+			continue
+		}
+		receipt.Payments = append(receipt.Payments, receipts.Payment{
+			Method:      paymentLabels[payment.Method],
+			AmountCents: payment.AmountCents,
+		})
+		receipt.RefundedCents += payment.RefundedCents
+	}
+	return receipt
+}
+
+// newPickupCode returns a short random code for the customer to collect
+// their order with. Codes are not unique over time, only unlikely to clash
+// among the orders waiting at the counter. Every letter is equally likely.
+func newPickupCode() (string, error) {
+	b := make([]byte, 4)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(pickupAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = pickupAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,23 @@
+package brews
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewPickupCode(t *testing.T) {
+	for range 200 {
+		code, err := newPickupCode()
+		if err != nil {
+			t.Fatalf("newPickupCode() error = %v", err)
+		}
+		if len(code) != 4 {
+			t.Errorf("newPickupCode() = %q, want 4 characters", code)
+		}
+		for _, r := range code {
+			if !strings.ContainsRune(pickupAlphabet, r) {
+				t.Errorf("newPickupCode() = %q has %q, which is not in the alphabet", code, r)
+			}
+		}
+	}
+}
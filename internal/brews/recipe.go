@@ -0,0 +1,91 @@
+package brews
+
+import (
+	"fmt"
+	"slices"
+
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/menus"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+// defaultRecipe is used for orders whose item is no longer on the menu.
+var defaultRecipe = []menus.RecipeStage{
+	{Stage: string(models.StatusGrinding)},
+	{Stage: string(models.StatusBrewing)},
+	{Stage: string(models.StatusFrothing)},
+}
+
+func recipeFor(menuItemName string) []menus.RecipeStage {
+	recipe, ok := menus.Recipe(menuItemName)
+	if !ok {
+		return defaultRecipe
+	}
+	return recipe
+}
+
+// statusSequence lists every status an order goes through, from QUEUED to
+// READY, following the recipe of its menu item.
+func statusSequence(menuItemName string) []models.OrderStatus {
+	sequence := []models.OrderStatus{models.StatusQueued}
+	for _, stage := range recipeFor(menuItemName) {
+		sequence = append(sequence, models.OrderStatus(stage.Stage))
+	}
+	return append(sequence, models.StatusReady)
+}
+
+// nextStatus returns the status that follows the current one, or "" once the
+// order is READY.
+func nextStatus(order *models.Order) models.OrderStatus {
+	sequence := statusSequence(order.MenuItemName)
+	i := slices.Index(sequence, order.Status)
+	if i < 0 || i == len(sequence)-1 {
+		return ""
+	}
+	return sequence[i+1]
+}
+
+// checkTransition only lets an order move one stage forward in its recipe,
+// so an espresso can never be sent to FROTHING.
+func checkTransition(order *models.Order, to models.OrderStatus) error {
+	if order.Status == models.StatusPendingPayment {
+		return fmt.Errorf("order is waiting for payment")
+	}
+	if !slices.Contains(statusSequence(order.MenuItemName), to) {
+		return fmt.Errorf("%s does not go through %s", order.MenuItemName, to)
+	}
+
+	next := nextStatus(order)
+	if next == "" {
+		return fmt.Errorf("order is already %s", order.Status)
+	}
+	if to != next {
+		return fmt.Errorf("order is %s, next status is %s", order.Status, next)
+	}
+	return nil
+}
+
+// baristaTicket renders the recipe of an order with the stages done so far.
+func baristaTicket(order *models.Order) *brewpb.BaristaTicket {
+	sequence := statusSequence(order.MenuItemName)
+	current := slices.Index(sequence, order.Status)
+
+	var steps []*brewpb.TicketStep
+	for i, stage := range recipeFor(order.MenuItemName) {
+		steps = append(steps, &brewpb.TicketStep{
+			Status:        brewpb.DrinkStatus(brewpb.DrinkStatus_value[stage.Stage]),
+			TargetSeconds: stage.TargetSeconds,
+			Parameters:    stage.Parameters,
+			// sequence[0] is QUEUED, so the recipe stage i sits at i+1.
+			Done: current >= i+1,
+		})
+	}
+
+	return &brewpb.BaristaTicket{
+		OrderId:      fmt.Sprintf("order-%d", order.ID),
+		MenuItemName: order.MenuItemName,
+		Status:       string(order.Status),
+		NextStatus:   brewpb.DrinkStatus(brewpb.DrinkStatus_value[string(nextStatus(order))]),
+		Steps:        steps,
+	}
+}
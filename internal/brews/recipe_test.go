@@ -0,0 +1,81 @@
+package brews
+
+import (
+	"slices"
+	"testing"
+
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestStatusSequence(t *testing.T) {
+	tests := []struct {
+		item string
+		want []models.OrderStatus
+	}{
+		{"Espresso", []models.OrderStatus{models.StatusQueued, models.StatusGrinding, models.StatusBrewing, models.StatusReady}},
+		{"Latte", []models.OrderStatus{models.StatusQueued, models.StatusGrinding, models.StatusBrewing, models.StatusFrothing, models.StatusReady}},
+		{"Mocha", []models.OrderStatus{models.StatusQueued, models.StatusGrinding, models.StatusBrewing, models.StatusFrothing, models.StatusReady}},
+	}
+	for _, tt := range tests {
+		if got := statusSequence(tt.item); !slices.Equal(got, tt.want) {
+			t.Errorf("statusSequence(%q) = %v, want %v", tt.item, got, tt.want)
+		}
+	}
+}
+
+func TestCheckTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		item    string
+		from    models.OrderStatus
+		to      models.OrderStatus
+		wantErr bool
+	}{
+		{name: "next stage", item: "Latte", from: models.StatusBrewing, to: models.StatusFrothing},
+		{name: "espresso skips frothing", item: "Espresso", from: models.StatusBrewing, to: models.StatusReady},
+		{name: "espresso never froths", item: "Espresso", from: models.StatusBrewing, to: models.StatusFrothing, wantErr: true},
+		{name: "skipping a stage", item: "Latte", from: models.StatusQueued, to: models.StatusBrewing, wantErr: true},
+		{name: "going back", item: "Latte", from: models.StatusFrothing, to: models.StatusBrewing, wantErr: true},
+		{name: "already ready", item: "Latte", from: models.StatusReady, to: models.StatusReady, wantErr: true},
+		{name: "waiting for payment", item: "Latte", from: models.StatusPendingPayment, to: models.StatusQueued, wantErr: true},
+		{name: "cancelled", item: "Latte", from: models.StatusCancelled, to: models.StatusGrinding, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := &models.Order{MenuItemName: tt.item, Status: tt.from}
+			err := checkTransition(order, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkTransition(%s -> %s) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBaristaTicket(t *testing.T) {
+	order := &models.Order{ID: 7, MenuItemName: "Latte", Status: models.StatusBrewing}
+	ticket := baristaTicket(order)
+
+	if ticket.OrderId != "order-7" {
+		t.Errorf("OrderId = %q, want order-7", ticket.OrderId)
+	}
+	if ticket.NextStatus != brewpb.DrinkStatus_FROTHING {
+		t.Errorf("NextStatus = %v, want FROTHING", ticket.NextStatus)
+	}
+
+	var done []bool
+	for _, step := range ticket.Steps {
+		done = append(done, step.Done)
+	}
+	if want := []bool{true, true, false}; !slices.Equal(done, want) {
+		t.Errorf("steps done = %v, want %v", done, want)
+	}
+	if got := ticket.Steps[0].Parameters["grind_size"]; got != "fine" {
+		t.Errorf("grind_size = %q, want fine", got)
+	}
+
+	order.Status = models.StatusReady
+	if ticket := baristaTicket(order); ticket.NextStatus != brewpb.DrinkStatus_DRINK_STATUS_UNSPECIFIED {
+		t.Errorf("NextStatus once READY = %v, want unspecified", ticket.NextStatus)
+	}
+}
@@ -0,0 +1,308 @@
+package brews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/cash"
+	"github.com/jany/my-coffee/internal/giftcards"
+	"github.com/jany/my-coffee/internal/loyalty"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+var (
+	// errNoPayment is returned when refunding an order nobody has paid for.
+	errNoPayment      = errors.New("order has no payment to refund")
+	errRefundTooLarge = errors.New("refund is more than what was paid")
+	errCannotCancel   = errors.New("cannot cancel order")
+)
+
+func (s *Server) CancelOrder(ctx context.Context, req *connect.Request[brewpb.CancelOrderRequest]) (*connect.Response[brewpb.CancelOrderResponse], error) {
+	operator := auth.Subject(ctx)
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+
+	// The refund and the cancellation are written together, with the order
+	// locked so a payment or another cancellation waits for them.
+	var refunds []models.Refund
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, order.ID); err != nil {
+			return err
+		}
+		orderRepo := repository.NewOrderRepository(tx)
+		locked, err := orderRepo.Lock(order.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status == models.StatusReady || locked.Status == models.StatusCancelled {
+			return fmt.Errorf("%w: order is %s, refund it instead", errCannotCancel, locked.Status)
+		}
+
+		refunds, _, err = s.refund(ctx, tx, order, 0, req.Msg.Reason, operator)
+		if err != nil && !errors.Is(err, errNoPayment) {
+			return err
+		}
+
+		// Only the status is written, so nothing changed since order was
+		// read is overwritten.
+		from := locked.Status
+		locked.Status = models.StatusCancelled
+		ok, err := orderRepo.SetStatus(locked, from)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errStatusChanged
+		}
+		if err := orderRepo.RecordStatus(locked); err != nil {
+			return err
+		}
+		order.Status = locked.Status
+		// Stamps spent on the order are given back.
+		_, err = loyalty.Reverse(repository.NewLoyaltyRepository(tx), order)
+		return err
+	})
+	if err != nil {
+		logUnrecorded(order, refunds, err)
+		return nil, refundError("cancel order", err)
+	}
+	order.Refunds = append(order.Refunds, refunds...)
+
+	return connect.NewResponse(&brewpb.CancelOrderResponse{
+		Order:   toProto(order),
+		Refunds: toRefundProtos(refunds),
+	}), nil
+}
+
+func (s *Server) RefundOrder(ctx context.Context, req *connect.Request[brewpb.RefundOrderRequest]) (*connect.Response[brewpb.RefundOrderResponse], error) {
+	operator := auth.Subject(ctx)
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+
+	var refunds []models.Refund
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := checkDayOpen(tx, order.ID); err != nil {
+			return err
+		}
+		if _, err := repository.NewOrderRepository(tx).Lock(order.ID); err != nil {
+			return err
+		}
+		var remaining int64
+		refunds, remaining, err = s.refund(ctx, tx, order, req.Msg.AmountCents, req.Msg.Reason, operator)
+		if err != nil {
+			return err
+		}
+		// A partly refunded order keeps its stamps; they are taken back
+		// once all of it is refunded.
+		if remaining > 0 {
+			return nil
+		}
+		_, err = loyalty.Reverse(repository.NewLoyaltyRepository(tx), order)
+		return err
+	})
+	if err != nil {
+		logUnrecorded(order, refunds, err)
+		return nil, refundError("refund order", err)
+	}
+	order.Refunds = append(order.Refunds, refunds...)
+
+	return connect.NewResponse(&brewpb.RefundOrderResponse{
+		Refunds: toRefundProtos(refunds),
+		Order:   toProto(order),
+	}), nil
+}
+
+// refund gives amountCents of what was paid for the order back, or
+// everything still refundable when amountCents is zero, and returns what
+// is still refundable afterwards. The newest payment is refunded first, so
+// a card that paid the rest of a gift card order gets its money back
+// before the gift card does.
+//
+// It runs in tx, locking the order's payments until tx ends. Refunds made
+// before an error are returned with it, so card refunds the provider has
+// already made can be reconciled if tx is rolled back.
+func (s *Server) refund(ctx context.Context, tx *gorm.DB, order *models.Order, amountCents int64, reason, operator string) ([]models.Refund, int64, error) {
+	payments, err := repository.NewPaymentRepository(tx).LockByOrderID(order.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	allocation, refundable, err := allocateRefund(payments, amountCents)
+	if err != nil {
+		return nil, refundable, err
+	}
+
+	var refunds []models.Refund
+	for _, part := range allocation {
+		refund, err := s.refundPayment(ctx, tx, order, part.payment, part.amountCents, reason, operator)
+		if refund != nil {
+			refunds = append(refunds, *refund)
+		}
+		if err != nil {
+			return refunds, refundable, err
+		}
+		refundable -= part.amountCents
+	}
+	return refunds, refundable, nil
+}
+
+// refundPart is how much of one payment a refund gives back.
+type refundPart struct {
+	payment     *models.Payment
+	amountCents int64
+}
+
+// allocateRefund splits amountCents over payments, newest first, and
+// returns what was refundable before. Zero means everything refundable.
+// It returns errNoPayment when nothing can be refunded and errRefundTooLarge
+// when amountCents is more than that.
+func allocateRefund(payments []models.Payment, amountCents int64) ([]refundPart, int64, error) {
+	var refundable int64
+	for _, payment := range payments {
+		refundable += payment.RefundableCents()
+	}
+	if refundable == 0 {
+		return nil, 0, errNoPayment
+	}
+	if amountCents == 0 {
+		amountCents = refundable
+	}
+	if amountCents > refundable {
+		return nil, refundable, fmt.Errorf("%w: cannot refund %d, only %d is refundable", errRefundTooLarge, amountCents, refundable)
+	}
+
+	var parts []refundPart
+	for i := len(payments) - 1; i >= 0 && amountCents > 0; i-- {
+		amount := min(amountCents, payments[i].RefundableCents())
+		if amount == 0 {
+			continue
+		}
+		parts = append(parts, refundPart{payment: &payments[i], amountCents: amount})
+		amountCents -= amount
+	}
+	return parts, refundable, nil
+}
+
+// refundPayment gives amountCents of one payment back: onto the card's
+// balance for gift cards, out of the register's drawer for cash and, once
+// that is recorded, through the payment provider for cards. It returns the
+// refund with an error only if the provider has already refunded it.
+func (s *Server) refundPayment(ctx context.Context, tx *gorm.DB, order *models.Order, payment *models.Payment, amountCents int64, reason, operator string) (*models.Refund, error) {
+	paymentRepo := repository.NewPaymentRepository(tx)
+
+	// Guarded, so the refunds of a payment never add up to more than it.
+	ok, err := paymentRepo.AddRefunded(payment.ID, amountCents)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: payment-%d", errRefundTooLarge, payment.ID)
+	}
+	payment.RefundedCents += amountCents
+	if payment.RefundedCents == payment.AmountCents {
+		payment.Status = models.PaymentRefunded
+	}
+
+	refund := &models.Refund{
+		PaymentID:   payment.ID,
+		OrderID:     order.ID,
+		AmountCents: amountCents,
+		Reason:      reason,
+		Operator:    operator,
+	}
+	if err := paymentRepo.CreateRefund(refund); err != nil {
+		return nil, err
+	}
+
+	switch payment.Method {
+	case models.PaymentMethodGiftCard:
+		return refund, giftcards.Refund(repository.NewGiftCardRepository(tx), payment.ProviderRef, order.ID, amountCents)
+	case models.PaymentMethodCash:
+		// Paid out of the drawer that took the money.
+		_, err := cash.Record(repository.NewCashRepository(tx), payment.ProviderRef, &models.CashMovement{
+			Kind:        models.CashRefund,
+			AmountCents: -amountCents,
+			OrderID:     &order.ID,
+			PaymentID:   &payment.ID,
+			Reason:      reason,
+			Operator:    operator,
+		})
+		return refund, err
+	case models.PaymentMethodCard:
+		providerRef, err := s.provider.Refund(ctx, payment.ProviderRef, amountCents)
+		if err != nil {
+			log.Printf("Failed to refund payment-%d: %v", payment.ID, err)
+			return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("failed to refund payment: %w", err))
+		}
+		refund.ProviderRef = providerRef
+		return refund, paymentRepo.UpdateRefund(refund)
+	}
+	return refund, nil
+}
+
+// logUnrecorded reports card refunds the provider made for a transaction
+// that was then rolled back: the money has moved, so someone has to
+// reconcile it.
+func logUnrecorded(order *models.Order, refunds []models.Refund, err error) {
+	for _, refund := range refunds {
+		if refund.ProviderRef != "" {
+			log.Printf("Refund %s of %d for order %d succeeded but was not recorded: %v", refund.ProviderRef, refund.AmountCents, order.ID, err)
+		}
+	}
+}
+
+// refundError turns an error from a refund transaction into a Connect
+// error; action describes what failed, e.g. "refund order".
+func refundError(action string, err error) error {
+	var connectErr *connect.Error
+	switch {
+	case errors.As(err, &connectErr):
+		return connectErr
+	case errors.Is(err, errRefundTooLarge):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, errNoPayment), errors.Is(err, errCannotCancel):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case isGiftCardError(err):
+		return giftCardError(err)
+	}
+	return cash.Error(action, err)
+}
+
+func toRefundProto(refund *models.Refund) *brewpb.Refund {
+	return &brewpb.Refund{
+		RefundId:    fmt.Sprintf("refund-%d", refund.ID),
+		PaymentId:   fmt.Sprintf("payment-%d", refund.PaymentID),
+		AmountCents: refund.AmountCents,
+		Reason:      refund.Reason,
+		Operator:    refund.Operator,
+	}
+}
+
+func toRefundProtos(refunds []models.Refund) []*brewpb.Refund {
+	var refundpbs []*brewpb.Refund
+	for _, refund := range refunds {
+		refundpbs = append(refundpbs, toRefundProto(&refund))
+	}
+	return refundpbs
+}
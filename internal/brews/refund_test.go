@@ -0,0 +1,100 @@
+package brews
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestAllocateRefund(t *testing.T) {
+	// A gift card paid 200, then a card the other 250, of which 50 was
+	// already refunded.
+	payments := func() []models.Payment {
+		return []models.Payment{
+			{ID: 1, Status: models.PaymentCaptured, AmountCents: 200},
+			{ID: 2, Status: models.PaymentCaptured, AmountCents: 250, RefundedCents: 50},
+		}
+	}
+	type part struct {
+		paymentID   uint
+		amountCents int64
+	}
+	tests := []struct {
+		name           string
+		payments       []models.Payment
+		amountCents    int64
+		wantParts      []part
+		wantRefundable int64
+		wantErr        error
+	}{
+		{
+			name:           "everything",
+			payments:       payments(),
+			wantParts:      []part{{2, 200}, {1, 200}},
+			wantRefundable: 400,
+		},
+		{
+			name:           "newest payment first",
+			payments:       payments(),
+			amountCents:    150,
+			wantParts:      []part{{2, 150}},
+			wantRefundable: 400,
+		},
+		{
+			name:           "spills over to the older payment",
+			payments:       payments(),
+			amountCents:    250,
+			wantParts:      []part{{2, 200}, {1, 50}},
+			wantRefundable: 400,
+		},
+		{
+			name: "skips declined and refunded payments",
+			payments: []models.Payment{
+				{ID: 1, Status: models.PaymentCaptured, AmountCents: 450},
+				{ID: 2, Status: models.PaymentDeclined, AmountCents: 450},
+				{ID: 3, Status: models.PaymentRefunded, AmountCents: 100, RefundedCents: 100},
+			},
+			amountCents:    100,
+			wantParts:      []part{{1, 100}},
+			wantRefundable: 450,
+		},
+		{
+			name:           "more than was paid",
+			payments:       payments(),
+			amountCents:    401,
+			wantRefundable: 400,
+			wantErr:        errRefundTooLarge,
+		},
+		{
+			name:    "nothing paid",
+			wantErr: errNoPayment,
+		},
+		{
+			name: "already refunded",
+			payments: []models.Payment{
+				{ID: 1, Status: models.PaymentRefunded, AmountCents: 450, RefundedCents: 450},
+			},
+			wantErr: errNoPayment,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, refundable, err := allocateRefund(tt.payments, tt.amountCents)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("allocateRefund() error = %v, want %v", err, tt.wantErr)
+			}
+			if refundable != tt.wantRefundable {
+				t.Errorf("refundable = %d, want %d", refundable, tt.wantRefundable)
+			}
+			var got []part
+			for _, p := range parts {
+				got = append(got, part{p.payment.ID, p.amountCents})
+			}
+			if !slices.Equal(got, tt.wantParts) {
+				t.Errorf("parts = %v, want %v", got, tt.wantParts)
+			}
+		})
+	}
+}
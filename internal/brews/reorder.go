@@ -0,0 +1,108 @@
+package brews
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"connectrpc.com/connect"
+	brewpb "github.com/jany/my-coffee/gen/proto/brew"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/menus"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+// defaultMyOrdersLimit is how many orders ListMyOrders returns when the
+// request does not say.
+const defaultMyOrdersLimit = 20
+
+func (s *Server) ListMyOrders(ctx context.Context, req *connect.Request[brewpb.ListMyOrdersRequest]) (*connect.Response[brewpb.ListMyOrdersResponse], error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrMissingToken)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultMyOrdersLimit
+	}
+
+	orders, err := s.orderRepo.FindByCustomer(claims.Subject, limit)
+	if err != nil {
+		log.Printf("Failed to list orders: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list orders: %w", err))
+	}
+
+	var orderpbs []*brewpb.Order
+	for _, order := range orders {
+		orderpbs = append(orderpbs, orderProto(ctx, &order))
+	}
+
+	return connect.NewResponse(&brewpb.ListMyOrdersResponse{
+		Orders: orderpbs,
+	}), nil
+}
+
+// Reorder places the drink of an earlier order again. The drink and its
+// modifiers must still be on the menu; prices, discounts and stock are
+// those of today, as for OrderDrink.
+func (s *Server) Reorder(ctx context.Context, req *connect.Request[brewpb.ReorderRequest]) (*connect.Response[brewpb.OrderResponse], error) {
+	orderID, err := parseOrderID(req.Msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := s.orderRepo.FindByID(orderID)
+	if err != nil {
+		log.Printf("Failed to get order: %v", err)
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("failed to get order: %w", err))
+	}
+	// Customers only repeat their own orders; staff can repeat any.
+	if !canAccess(ctx, previous) {
+		return nil, errOrderNotFound(req.Msg.OrderId)
+	}
+
+	msg, err := reorderRequest(previous)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cannot reorder %s: %w", req.Msg.OrderId, err))
+	}
+
+	resp, err := s.placeOrder(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Order %s reordered as %s", req.Msg.OrderId, resp.OrderId)
+	return connect.NewResponse(resp), nil
+}
+
+// reorderRequest turns an earlier order back into the request that placed
+// it, checked against the current menu.
+func reorderRequest(order *models.Order) (*brewpb.OrderRequest, error) {
+	name, ok := menus.LookupName(order.MenuItemName)
+	if !ok {
+		return nil, fmt.Errorf("%s is no longer on the menu", order.MenuItemName)
+	}
+
+	// Modifiers are applied in the order they were chosen.
+	modifiers := slices.Clone(order.Modifiers)
+	slices.SortFunc(modifiers, func(a, b models.OrderModifier) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	var modifierIDs []string
+	for _, modifier := range modifiers {
+		modifierIDs = append(modifierIDs, modifier.ModifierID)
+	}
+	if err := menus.ValidateModifiers(name, modifierIDs); err != nil {
+		return nil, err
+	}
+
+	return &brewpb.OrderRequest{
+		MenuItemName: name,
+		ModifierIds:  modifierIDs,
+		Quantity:     int32(order.Quantity),
+		CustomerId:   order.CustomerID,
+		CustomerName: order.CustomerName,
+	}, nil
+}
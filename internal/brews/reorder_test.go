@@ -0,0 +1,54 @@
+package brews
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestReorderRequest(t *testing.T) {
+	order := &models.Order{
+		MenuItemName: "Latte",
+		Quantity:     2,
+		CustomerID:   "customer-7",
+		CustomerName: "Kim",
+		PromoCode:    "SUMMER10",
+		// Loaded in no particular order.
+		Modifiers: []models.OrderModifier{
+			{ID: 12, ModifierID: "vanilla-syrup"},
+			{ID: 11, ModifierID: "oat-milk"},
+		},
+	}
+
+	msg, err := reorderRequest(order)
+	if err != nil {
+		t.Fatalf("reorderRequest() error = %v", err)
+	}
+	if msg.MenuItemName != "Latte" || msg.Quantity != 2 || msg.CustomerId != "customer-7" || msg.CustomerName != "Kim" {
+		t.Errorf("reorderRequest() = %+v", msg)
+	}
+	if want := []string{"oat-milk", "vanilla-syrup"}; !slices.Equal(msg.ModifierIds, want) {
+		t.Errorf("ModifierIds = %v, want %v", msg.ModifierIds, want)
+	}
+	if msg.PromoCode != "" || msg.RedeemLoyalty {
+		t.Errorf("reorderRequest() carried over promo %q, loyalty %v", msg.PromoCode, msg.RedeemLoyalty)
+	}
+}
+
+func TestReorderRequestOffMenu(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *models.Order
+	}{
+		{name: "item", order: &models.Order{MenuItemName: "Pumpkin Spice Latte", Quantity: 1}},
+		{name: "modifier", order: &models.Order{MenuItemName: "Espresso", Quantity: 1, Modifiers: []models.OrderModifier{{ID: 1, ModifierID: "oat-milk"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := reorderRequest(tt.order); err == nil {
+				t.Error("reorderRequest() succeeded")
+			}
+		})
+	}
+}
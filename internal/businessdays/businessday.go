@@ -0,0 +1,179 @@
+package businessdays
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	businessdaypb "github.com/jany/my-coffee/gen/proto/businessday"
+	"github.com/jany/my-coffee/gen/proto/businessday/businessdayconnect"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ businessdayconnect.BusinessDayServiceHandler = (*Server)(nil)
+
+type Server struct {
+	db      *gorm.DB
+	dayRepo *repository.BusinessDayRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		db:      db,
+		dayRepo: repository.NewBusinessDayRepository(db),
+	}
+}
+
+func (s *Server) CloseBusinessDay(ctx context.Context, req *connect.Request[businessdaypb.CloseBusinessDayRequest]) (*connect.Response[businessdaypb.CloseBusinessDayResponse], error) {
+	operator := auth.Subject(ctx)
+	date, err := parseDate(req.Msg.BusinessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var report *models.ZReport
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		report, err = Close(repository.NewBusinessDayRepository(tx), date, operator)
+		return err
+	})
+	if err != nil {
+		return nil, Error("close business day", err)
+	}
+
+	log.Printf("Business day %s closed by %s with Z-report %d", req.Msg.BusinessDate, operator, report.ID)
+
+	return connect.NewResponse(&businessdaypb.CloseBusinessDayResponse{
+		Report: toReportProto(report),
+	}), nil
+}
+
+func (s *Server) ReopenBusinessDay(ctx context.Context, req *connect.Request[businessdaypb.ReopenBusinessDayRequest]) (*connect.Response[businessdaypb.ReopenBusinessDayResponse], error) {
+	operator := auth.Subject(ctx)
+	date, err := parseDate(req.Msg.BusinessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var day *models.BusinessDay
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		day, err = Reopen(repository.NewBusinessDayRepository(tx), date, operator, req.Msg.Reason)
+		return err
+	})
+	if err != nil {
+		return nil, Error("reopen business day", err)
+	}
+
+	log.Printf("Business day %s reopened by %s: %s", req.Msg.BusinessDate, operator, req.Msg.Reason)
+
+	return connect.NewResponse(&businessdaypb.ReopenBusinessDayResponse{
+		BusinessDate: req.Msg.BusinessDate,
+		Status:       string(day.Status),
+	}), nil
+}
+
+func (s *Server) GetBusinessDay(ctx context.Context, req *connect.Request[businessdaypb.GetBusinessDayRequest]) (*connect.Response[businessdaypb.GetBusinessDayResponse], error) {
+	date, err := parseDate(req.Msg.BusinessDate)
+	if err != nil {
+		return nil, err
+	}
+
+	day, err := s.dayRepo.FindDay(date)
+	if err != nil {
+		return nil, Error("get business day", err)
+	}
+	reports, err := s.dayRepo.FindReports(date)
+	if err != nil {
+		return nil, Error("get Z-reports", err)
+	}
+	events, err := s.dayRepo.FindEvents(date)
+	if err != nil {
+		return nil, Error("get business day events", err)
+	}
+
+	resp := &businessdaypb.GetBusinessDayResponse{
+		BusinessDate: req.Msg.BusinessDate,
+		Status:       string(models.BusinessDayOpen),
+	}
+	if day != nil {
+		resp.Status = string(day.Status)
+	}
+	for _, report := range reports {
+		resp.Reports = append(resp.Reports, toReportProto(&report))
+	}
+	for _, event := range events {
+		eventpb := &businessdaypb.BusinessDayEvent{
+			Action:   string(event.Action),
+			Operator: event.Operator,
+			Reason:   event.Reason,
+			At:       timestamppb.New(event.CreatedAt),
+		}
+		if event.ZReportID != nil {
+			eventpb.ZReportNumber = uint32(*event.ZReportID)
+		}
+		resp.Events = append(resp.Events, eventpb)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// Error turns an error from this package into a Connect error; action
+// describes what failed, e.g. "close business day".
+func Error(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrDayClosed), errors.Is(err, ErrDayOpen), errors.Is(err, ErrOpenOrders):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	log.Printf("Failed to %s: %v", action, err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s: %w", action, err))
+}
+
+// parseDate reads a YYYY-MM-DD business date. The pattern has been
+// validated, but not that the date exists.
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid business date: %w", err))
+	}
+	return date, nil
+}
+
+func toReportProto(report *models.ZReport) *businessdaypb.ZReport {
+	var tenderpbs []*businessdaypb.Tender
+	for _, tender := range report.Tenders {
+		tenderpbs = append(tenderpbs, &businessdaypb.Tender{
+			Method:        tender.Method,
+			Payments:      tender.Payments,
+			AmountCents:   tender.AmountCents,
+			RefundedCents: tender.RefundedCents,
+		})
+	}
+
+	return &businessdaypb.ZReport{
+		Number:          uint32(report.ID),
+		BusinessDate:    report.BusinessDate.Format(time.DateOnly),
+		Orders:          report.Orders,
+		Drinks:          report.Drinks,
+		SubtotalCents:   report.SubtotalCents,
+		DiscountCents:   report.DiscountCents,
+		TaxCents:        report.TaxCents,
+		TotalCents:      report.TotalCents,
+		CancelledOrders: report.CancelledOrders,
+		CancelledCents:  report.CancelledCents,
+		Refunds:         report.Refunds,
+		RefundedCents:   report.RefundedCents,
+		Tenders:         tenderpbs,
+		Currency:        report.Currency,
+		ClosedBy:        report.ClosedBy,
+		ClosedAt:        timestamppb.New(report.CreatedAt),
+	}
+}
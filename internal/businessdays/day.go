@@ -0,0 +1,163 @@
+package businessdays
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+)
+
+var (
+	// ErrDayClosed is returned when changing an order of a closed day.
+	ErrDayClosed = errors.New("business day is closed")
+	ErrDayOpen   = errors.New("business day is not closed")
+	// ErrOpenOrders is returned when closing a day with orders that are
+	// still being made or waiting for payment.
+	ErrOpenOrders = errors.New("business day still has open orders")
+)
+
+// Date is the business date t falls on in the shop's time zone, as
+// midnight UTC the way DATE columns are read back.
+func Date(t time.Time) time.Time {
+	year, month, day := t.In(config.AppConfig.Location).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// Bounds returns the start and end of date in the shop's time zone,
+// converted to the local wall clock time order times are stored in.
+func Bounds(date time.Time) (start, end time.Time) {
+	start = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, config.AppConfig.Location)
+	return start.Local(), start.AddDate(0, 0, 1).Local()
+}
+
+// Close totals date into a new Z-report and locks the day's orders.
+func Close(repo *repository.BusinessDayRepository, date time.Time, operator string) (*models.ZReport, error) {
+	if err := repo.LockDays(); err != nil {
+		return nil, err
+	}
+	day, err := repo.FindDay(date)
+	if err != nil {
+		return nil, err
+	}
+	if day != nil && day.Status == models.BusinessDayClosed {
+		return nil, fmt.Errorf("%w: %s", ErrDayClosed, date.Format(time.DateOnly))
+	}
+
+	start, end := Bounds(date)
+	open, err := repo.CountOpenOrders(start, end)
+	if err != nil {
+		return nil, err
+	}
+	if open > 0 {
+		return nil, fmt.Errorf("%w: %d not READY or cancelled", ErrOpenOrders, open)
+	}
+
+	report, err := repo.OrderTotals(start, end)
+	if err != nil {
+		return nil, err
+	}
+	report.Refunds, report.RefundedCents, err = repo.RefundTotals(start, end)
+	if err != nil {
+		return nil, err
+	}
+	report.Tenders, err = repo.Tenders(start, end)
+	if err != nil {
+		return nil, err
+	}
+	report.BusinessDate = date
+	report.Currency = config.AppConfig.Currency
+	report.ClosedBy = operator
+	if err := repo.CreateReport(report); err != nil {
+		return nil, err
+	}
+
+	if day == nil {
+		day = &models.BusinessDay{BusinessDate: date}
+	}
+	day.Status = models.BusinessDayClosed
+	day.StartsAt, day.EndsAt = start, end
+	day.ZReportID = &report.ID
+	if err := repo.SaveDay(day); err != nil {
+		return nil, err
+	}
+
+	err = repo.AddEvent(&models.BusinessDayEvent{
+		BusinessDate: date,
+		Action:       models.BusinessDayClose,
+		ZReportID:    &report.ID,
+		Operator:     operator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// Reopen unlocks the orders of a closed day. Its Z-reports stay; closing
+// it again writes a new one.
+func Reopen(repo *repository.BusinessDayRepository, date time.Time, operator, reason string) (*models.BusinessDay, error) {
+	if err := repo.LockDays(); err != nil {
+		return nil, err
+	}
+	day, err := repo.FindDay(date)
+	if err != nil {
+		return nil, err
+	}
+	if day == nil || day.Status != models.BusinessDayClosed {
+		return nil, fmt.Errorf("%w: %s", ErrDayOpen, date.Format(time.DateOnly))
+	}
+
+	day.Status = models.BusinessDayOpen
+	if err := repo.SaveDay(day); err != nil {
+		return nil, err
+	}
+	err = repo.AddEvent(&models.BusinessDayEvent{
+		BusinessDate: date,
+		Action:       models.BusinessDayReopen,
+		ZReportID:    day.ZReportID,
+		Operator:     operator,
+		Reason:       reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return day, nil
+}
+
+// CheckOrder returns ErrDayClosed if the order belongs to a closed day.
+// Call it in the transaction that changes the order: the day cannot be
+// closed until the transaction ends.
+func CheckOrder(repo *repository.BusinessDayRepository, orderID uint) error {
+	if err := repo.ShareDays(); err != nil {
+		return err
+	}
+	day, err := repo.ClosedDayOfOrder(orderID)
+	if err != nil {
+		return err
+	}
+	return closedError(day)
+}
+
+// CheckTime returns ErrDayClosed if t, in local wall clock time, falls on
+// a closed day. New orders are checked with the current time, in the
+// transaction that creates them, as for CheckOrder.
+func CheckTime(repo *repository.BusinessDayRepository, t time.Time) error {
+	if err := repo.ShareDays(); err != nil {
+		return err
+	}
+	day, err := repo.ClosedDayAt(t)
+	if err != nil {
+		return err
+	}
+	return closedError(day)
+}
+
+func closedError(day *models.BusinessDay) error {
+	if day == nil {
+		return nil
+	}
+	return fmt.Errorf("%w: %s, reopen it to make changes", ErrDayClosed, day.BusinessDate.Format(time.DateOnly))
+}
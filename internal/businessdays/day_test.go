@@ -0,0 +1,191 @@
+package businessdays
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+	"gorm.io/gorm"
+)
+
+// setLocation runs the rest of the test with the shop in loc.
+func setLocation(t *testing.T, loc *time.Location) {
+	t.Helper()
+	previous := config.AppConfig
+	config.AppConfig = &config.Config{Location: loc, Currency: "USD"}
+	t.Cleanup(func() { config.AppConfig = previous })
+}
+
+func TestDate(t *testing.T) {
+	setLocation(t, time.FixedZone("UTC+7", 7*3600))
+
+	tests := []struct {
+		at   time.Time
+		want time.Time
+	}{
+		{at: time.Date(2026, 3, 14, 16, 59, 0, 0, time.UTC), want: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{at: time.Date(2026, 3, 14, 17, 0, 0, 0, time.UTC), want: time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := Date(tt.at); !got.Equal(tt.want) {
+			t.Errorf("Date(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestBounds(t *testing.T) {
+	setLocation(t, time.FixedZone("UTC+7", 7*3600))
+
+	start, end := Bounds(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, 3, 14, 17, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("start = %v, want %v", start, want)
+	}
+	if want := time.Date(2026, 3, 15, 17, 0, 0, 0, time.UTC); !end.Equal(want) {
+		t.Errorf("end = %v, want %v", end, want)
+	}
+	if start.Location() != time.Local {
+		t.Errorf("start is in %v, want local time", start.Location())
+	}
+}
+
+func TestClosedError(t *testing.T) {
+	if err := closedError(nil); err != nil {
+		t.Errorf("closedError(nil) = %v, want nil", err)
+	}
+	day := &models.BusinessDay{BusinessDate: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)}
+	if err := closedError(day); !errors.Is(err, ErrDayClosed) {
+		t.Errorf("closedError() = %v, want ErrDayClosed", err)
+	}
+}
+
+func TestCloseAndReopen(t *testing.T) {
+	setLocation(t, time.UTC)
+	db := testdb.Open(t)
+	repo := repository.NewBusinessDayRepository(db)
+
+	date := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	start, _ := Bounds(date)
+	orders := []models.Order{
+		{MenuItemName: "Latte", Status: models.StatusReady, Quantity: 2, SubtotalCents: 700, TaxCents: 56, TotalCents: 756, CreatedAt: start.Add(8 * time.Hour)},
+		{MenuItemName: "Espresso", Status: models.StatusCancelled, Quantity: 1, SubtotalCents: 250, TotalCents: 250, CreatedAt: start.Add(9 * time.Hour)},
+		{MenuItemName: "Cortado", Status: models.StatusBrewing, Quantity: 1, SubtotalCents: 325, TotalCents: 325, CreatedAt: start.Add(10 * time.Hour)},
+		{MenuItemName: "Latte", Status: models.StatusQueued, Quantity: 1, SubtotalCents: 350, TotalCents: 350, CreatedAt: start.Add(30 * time.Hour)},
+	}
+	if err := db.Create(&orders).Error; err != nil {
+		t.Fatalf("Failed to create orders: %v", err)
+	}
+	payments := []models.Payment{
+		{OrderID: orders[0].ID, Provider: "fake", Method: models.PaymentMethodCard, Status: models.PaymentCaptured, AmountCents: 756, CreatedAt: start.Add(8 * time.Hour)},
+		{OrderID: orders[1].ID, Provider: "fake", Method: models.PaymentMethodCard, Status: models.PaymentDeclined, AmountCents: 250, CreatedAt: start.Add(9 * time.Hour)},
+	}
+	if err := db.Create(&payments).Error; err != nil {
+		t.Fatalf("Failed to create payments: %v", err)
+	}
+	refund := &models.Refund{PaymentID: payments[0].ID, OrderID: orders[0].ID, AmountCents: 100, Reason: "spilt", Operator: "sam", CreatedAt: start.Add(11 * time.Hour)}
+	if err := db.Create(refund).Error; err != nil {
+		t.Fatalf("Failed to create refund: %v", err)
+	}
+
+	inTx := func(fn func(repo *repository.BusinessDayRepository) error) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			return fn(repository.NewBusinessDayRepository(tx))
+		})
+	}
+	closeDay := func() (*models.ZReport, error) {
+		var report *models.ZReport
+		err := inTx(func(repo *repository.BusinessDayRepository) error {
+			var err error
+			report, err = Close(repo, date, "manager")
+			return err
+		})
+		return report, err
+	}
+
+	if _, err := closeDay(); !errors.Is(err, ErrOpenOrders) {
+		t.Fatalf("Close() with a drink being made error = %v, want ErrOpenOrders", err)
+	}
+	if err := db.Model(&orders[2]).Update("status", models.StatusReady).Error; err != nil {
+		t.Fatalf("Failed to finish order: %v", err)
+	}
+
+	report, err := closeDay()
+	if err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if report.Orders != 2 || report.Drinks != 3 || report.TotalCents != 1081 || report.TaxCents != 56 {
+		t.Errorf("report orders %d, drinks %d, total %d, tax %d; want 2, 3, 1081, 56", report.Orders, report.Drinks, report.TotalCents, report.TaxCents)
+	}
+	if report.CancelledOrders != 1 || report.CancelledCents != 250 {
+		t.Errorf("report cancelled %d for %d; want 1 for 250", report.CancelledOrders, report.CancelledCents)
+	}
+	if report.Refunds != 1 || report.RefundedCents != 100 {
+		t.Errorf("report refunds %d for %d; want 1 for 100", report.Refunds, report.RefundedCents)
+	}
+	if len(report.Tenders) != 1 {
+		t.Fatalf("report tenders = %+v, want only card", report.Tenders)
+	}
+	if tender := report.Tenders[0]; tender.Method != models.PaymentMethodCard || tender.Payments != 1 || tender.AmountCents != 756 || tender.RefundedCents != 100 {
+		t.Errorf("card tender = %+v, want 1 payment of 756 with 100 refunded", tender)
+	}
+
+	if _, err := closeDay(); !errors.Is(err, ErrDayClosed) {
+		t.Errorf("second Close() error = %v, want ErrDayClosed", err)
+	}
+	if err := inTx(func(repo *repository.BusinessDayRepository) error { return CheckOrder(repo, orders[0].ID) }); !errors.Is(err, ErrDayClosed) {
+		t.Errorf("CheckOrder() on a closed day = %v, want ErrDayClosed", err)
+	}
+	if err := inTx(func(repo *repository.BusinessDayRepository) error { return CheckOrder(repo, orders[3].ID) }); err != nil {
+		t.Errorf("CheckOrder() on the next day = %v, want nil", err)
+	}
+	if err := inTx(func(repo *repository.BusinessDayRepository) error { return CheckTime(repo, start.Add(23*time.Hour)) }); !errors.Is(err, ErrDayClosed) {
+		t.Errorf("CheckTime() on a closed day = %v, want ErrDayClosed", err)
+	}
+
+	err = inTx(func(repo *repository.BusinessDayRepository) error {
+		_, err := Reopen(repo, date, "admin", "late refund")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Reopen() error = %v", err)
+	}
+	err = inTx(func(repo *repository.BusinessDayRepository) error {
+		_, err := Reopen(repo, date, "admin", "again")
+		return err
+	})
+	if !errors.Is(err, ErrDayOpen) {
+		t.Errorf("second Reopen() error = %v, want ErrDayOpen", err)
+	}
+	if err := inTx(func(repo *repository.BusinessDayRepository) error { return CheckOrder(repo, orders[0].ID) }); err != nil {
+		t.Errorf("CheckOrder() after reopening = %v, want nil", err)
+	}
+
+	second, err := closeDay()
+	if err != nil {
+		t.Fatalf("Close() after reopening error = %v", err)
+	}
+	if second.ID <= report.ID {
+		t.Errorf("second Z-report number %d, want more than %d", second.ID, report.ID)
+	}
+
+	reports, err := repo.FindReports(date)
+	if err != nil || len(reports) != 2 {
+		t.Errorf("FindReports() = %d reports, %v; want 2", len(reports), err)
+	}
+	events, err := repo.FindEvents(date)
+	if err != nil {
+		t.Fatalf("FindEvents() error = %v", err)
+	}
+	var actions []models.BusinessDayAction
+	for _, event := range events {
+		actions = append(actions, event.Action)
+	}
+	want := []models.BusinessDayAction{models.BusinessDayClose, models.BusinessDayReopen, models.BusinessDayClose}
+	if !slices.Equal(actions, want) {
+		t.Errorf("events = %v, want %v", actions, want)
+	}
+}
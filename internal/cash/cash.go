@@ -0,0 +1,224 @@
+package cash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	cashpb "github.com/jany/my-coffee/gen/proto/cash"
+	"github.com/jany/my-coffee/gen/proto/cash/cashconnect"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ cashconnect.CashServiceHandler = (*Server)(nil)
+
+type Server struct {
+	db       *gorm.DB
+	cashRepo *repository.CashRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		db:       db,
+		cashRepo: repository.NewCashRepository(db),
+	}
+}
+
+func (s *Server) ListCashDrawers(ctx context.Context, req *connect.Request[cashpb.ListCashDrawersRequest]) (*connect.Response[cashpb.ListCashDrawersResponse], error) {
+	drawers, err := s.cashRepo.FindDrawers()
+	if err != nil {
+		log.Printf("Failed to list cash drawers: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list cash drawers: %w", err))
+	}
+
+	var drawerpbs []*cashpb.CashDrawer
+	for _, drawer := range drawers {
+		shift, err := s.cashRepo.FindOpenShift(drawer.ID, false)
+		if err != nil {
+			log.Printf("Failed to get open shift: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get open shift: %w", err))
+		}
+		drawerpb := &cashpb.CashDrawer{Register: drawer.Register, Name: drawer.Name}
+		if shift != nil {
+			drawerpb.OpenShift = toShiftProto(shift)
+		}
+		drawerpbs = append(drawerpbs, drawerpb)
+	}
+
+	return connect.NewResponse(&cashpb.ListCashDrawersResponse{
+		Drawers: drawerpbs,
+	}), nil
+}
+
+func (s *Server) OpenShift(ctx context.Context, req *connect.Request[cashpb.OpenShiftRequest]) (*connect.Response[cashpb.OpenShiftResponse], error) {
+	operator := auth.Subject(ctx)
+	var shift *models.CashShift
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		shift, err = OpenShift(repository.NewCashRepository(tx), req.Msg.Register, operator, req.Msg.OpeningFloatCents)
+		return err
+	})
+	if err != nil {
+		return nil, Error("open shift", err)
+	}
+
+	return connect.NewResponse(&cashpb.OpenShiftResponse{
+		Shift: toShiftProto(shift),
+	}), nil
+}
+
+func (s *Server) CloseShift(ctx context.Context, req *connect.Request[cashpb.CloseShiftRequest]) (*connect.Response[cashpb.CloseShiftResponse], error) {
+	operator := auth.Subject(ctx)
+	var report *Report
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		report, err = CloseShift(repository.NewCashRepository(tx), req.Msg.Register, operator, req.Msg.CountedCents, req.Msg.Note)
+		return err
+	})
+	if err != nil {
+		return nil, Error("close shift", err)
+	}
+
+	if report.OverShortCents != 0 {
+		log.Printf("Register %s closed %+d cents off by %s: %s", req.Msg.Register, report.OverShortCents, operator, req.Msg.Note)
+	}
+
+	return connect.NewResponse(&cashpb.CloseShiftResponse{
+		Report: toReportProto(report),
+	}), nil
+}
+
+func (s *Server) RecordCashMovement(ctx context.Context, req *connect.Request[cashpb.RecordCashMovementRequest]) (*connect.Response[cashpb.RecordCashMovementResponse], error) {
+	operator := auth.Subject(ctx)
+	movement := &models.CashMovement{
+		Kind:        models.CashPaidIn,
+		AmountCents: req.Msg.AmountCents,
+		Reason:      req.Msg.Reason,
+		Operator:    operator,
+	}
+	if req.Msg.Kind == cashpb.CashMovementKind_PAID_OUT {
+		movement.Kind = models.CashPaidOut
+		movement.AmountCents = -req.Msg.AmountCents
+	}
+
+	var report *Report
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		report, err = Record(repository.NewCashRepository(tx), req.Msg.Register, movement)
+		return err
+	})
+	if err != nil {
+		return nil, Error("record cash movement", err)
+	}
+
+	return connect.NewResponse(&cashpb.RecordCashMovementResponse{
+		Movement:      toMovementProto(movement),
+		ExpectedCents: report.ExpectedCents,
+	}), nil
+}
+
+func (s *Server) GetShiftReport(ctx context.Context, req *connect.Request[cashpb.GetShiftReportRequest]) (*connect.Response[cashpb.GetShiftReportResponse], error) {
+	var shift *models.CashShift
+	var err error
+	switch which := req.Msg.Shift.(type) {
+	case *cashpb.GetShiftReportRequest_ShiftId:
+		shift, err = s.cashRepo.FindShift(uint(which.ShiftId))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown shift %d", which.ShiftId))
+		}
+	case *cashpb.GetShiftReportRequest_Register:
+		var drawer *models.CashDrawer
+		drawer, err = findDrawer(s.cashRepo, which.Register)
+		if err == nil {
+			shift, err = s.cashRepo.FindOpenShift(drawer.ID, false)
+		}
+		if err == nil && shift == nil {
+			err = fmt.Errorf("%w: %s", ErrNoOpenShift, which.Register)
+		}
+	}
+	if err != nil {
+		return nil, Error("get shift", err)
+	}
+
+	report, err := Summarize(s.cashRepo, shift)
+	if err != nil {
+		return nil, Error("summarize shift", err)
+	}
+
+	return connect.NewResponse(&cashpb.GetShiftReportResponse{
+		Report: toReportProto(report),
+	}), nil
+}
+
+// Error turns an error from this package into a Connect error; action
+// describes what failed, e.g. "open shift".
+func Error(action string, err error) error {
+	switch {
+	case errors.Is(err, ErrUnknownRegister):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrNoOpenShift), errors.Is(err, ErrShiftOpen), errors.Is(err, ErrInsufficientCash):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	log.Printf("Failed to %s: %v", action, err)
+	return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to %s: %w", action, err))
+}
+
+func toShiftProto(shift *models.CashShift) *cashpb.Shift {
+	shiftpb := &cashpb.Shift{
+		ShiftId:           uint32(shift.ID),
+		Register:          shift.Drawer.Register,
+		Status:            string(shift.Status),
+		OpenedBy:          shift.OpenedBy,
+		OpeningFloatCents: shift.OpeningFloatCents,
+		OpenedAt:          timestamppb.New(shift.OpenedAt),
+		ClosedBy:          shift.ClosedBy,
+	}
+	if shift.ClosedAt != nil {
+		shiftpb.ClosedAt = timestamppb.New(*shift.ClosedAt)
+	}
+	return shiftpb
+}
+
+func toMovementProto(movement *models.CashMovement) *cashpb.CashMovement {
+	movementpb := &cashpb.CashMovement{
+		Kind:        string(movement.Kind),
+		AmountCents: movement.AmountCents,
+		Reason:      movement.Reason,
+		Operator:    movement.Operator,
+		CreatedAt:   timestamppb.New(movement.CreatedAt),
+	}
+	if movement.OrderID != nil {
+		movementpb.OrderId = fmt.Sprintf("order-%d", *movement.OrderID)
+	}
+	return movementpb
+}
+
+func toReportProto(report *Report) *cashpb.ShiftReport {
+	var movementpbs []*cashpb.CashMovement
+	for _, movement := range report.Movements {
+		movementpbs = append(movementpbs, toMovementProto(&movement))
+	}
+
+	return &cashpb.ShiftReport{
+		Shift:             toShiftProto(report.Shift),
+		OpeningFloatCents: report.Shift.OpeningFloatCents,
+		SalesCents:        report.SalesCents,
+		RefundsCents:      report.RefundsCents,
+		PaidInCents:       report.PaidInCents,
+		PaidOutCents:      report.PaidOutCents,
+		ExpectedCents:     report.ExpectedCents,
+		CountedCents:      report.CountedCents,
+		OverShortCents:    report.OverShortCents,
+		Note:              report.Shift.Note,
+		Movements:         movementpbs,
+		Currency:          config.AppConfig.Currency,
+	}
+}
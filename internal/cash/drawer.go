@@ -0,0 +1,174 @@
+package cash
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// ProviderName is recorded as the provider of cash payments.
+const ProviderName = "cash"
+
+var (
+	ErrUnknownRegister = errors.New("unknown register")
+	ErrNoOpenShift     = errors.New("register has no open shift")
+	ErrShiftOpen       = errors.New("register already has an open shift")
+	// ErrInsufficientCash is returned when more cash would leave the drawer
+	// than should be in it.
+	ErrInsufficientCash = errors.New("not enough cash in the drawer")
+)
+
+// Report reconciles one shift. CountedCents and OverShortCents are only
+// meaningful once the shift is closed.
+type Report struct {
+	Shift          *models.CashShift
+	Movements      []models.CashMovement
+	SalesCents     int64
+	RefundsCents   int64
+	PaidInCents    int64
+	PaidOutCents   int64
+	ExpectedCents  int64
+	CountedCents   int64
+	OverShortCents int64
+}
+
+// OpenShift counts floatCents into the drawer of register.
+func OpenShift(repo *repository.CashRepository, register, operator string, floatCents int64) (*models.CashShift, error) {
+	drawer, err := findDrawer(repo, register)
+	if err != nil {
+		return nil, err
+	}
+	open, err := repo.FindOpenShift(drawer.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, fmt.Errorf("%w since %s", ErrShiftOpen, open.OpenedAt.Format(time.Kitchen))
+	}
+
+	shift := &models.CashShift{
+		DrawerID:          drawer.ID,
+		Drawer:            *drawer,
+		Status:            models.ShiftOpen,
+		OpenedBy:          operator,
+		OpeningFloatCents: floatCents,
+		OpenedAt:          time.Now(),
+	}
+	if err := repo.CreateShift(shift); err != nil {
+		return nil, err
+	}
+	return shift, nil
+}
+
+// LockShift returns the open shift of register, locked until the
+// transaction ends.
+func LockShift(repo *repository.CashRepository, register string) (*models.CashShift, error) {
+	drawer, err := findDrawer(repo, register)
+	if err != nil {
+		return nil, err
+	}
+	shift, err := repo.FindOpenShift(drawer.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	if shift == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNoOpenShift, register)
+	}
+	return shift, nil
+}
+
+// Record adds movement to the open shift of register. Cash can only leave
+// the drawer if it should be there.
+func Record(repo *repository.CashRepository, register string, movement *models.CashMovement) (*Report, error) {
+	shift, err := LockShift(repo, register)
+	if err != nil {
+		return nil, err
+	}
+	report, err := Summarize(repo, shift)
+	if err != nil {
+		return nil, err
+	}
+	if report.ExpectedCents+movement.AmountCents < 0 {
+		return nil, fmt.Errorf("%w: %d expected", ErrInsufficientCash, report.ExpectedCents)
+	}
+
+	movement.ShiftID = shift.ID
+	if err := repo.AddMovement(movement); err != nil {
+		return nil, err
+	}
+	report.add(*movement)
+	return report, nil
+}
+
+// CloseShift counts the drawer of register out and returns the
+// reconciliation.
+func CloseShift(repo *repository.CashRepository, register, operator string, countedCents int64, note string) (*Report, error) {
+	shift, err := LockShift(repo, register)
+	if err != nil {
+		return nil, err
+	}
+	report, err := Summarize(repo, shift)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	shift.Status = models.ShiftClosed
+	shift.ClosedBy = operator
+	shift.ClosedAt = &now
+	shift.ExpectedCents = &report.ExpectedCents
+	shift.CountedCents = &countedCents
+	shift.Note = note
+	if err := repo.UpdateShift(shift); err != nil {
+		return nil, err
+	}
+
+	report.CountedCents = countedCents
+	report.OverShortCents = countedCents - report.ExpectedCents
+	return report, nil
+}
+
+// Summarize adds up the movements of shift.
+func Summarize(repo *repository.CashRepository, shift *models.CashShift) (*Report, error) {
+	movements, err := repo.FindMovements(shift.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Shift: shift, ExpectedCents: shift.OpeningFloatCents}
+	for _, movement := range movements {
+		report.add(movement)
+	}
+	if shift.CountedCents != nil {
+		report.CountedCents = *shift.CountedCents
+		report.OverShortCents = report.CountedCents - report.ExpectedCents
+	}
+	return report, nil
+}
+
+func (r *Report) add(movement models.CashMovement) {
+	r.Movements = append(r.Movements, movement)
+	r.ExpectedCents += movement.AmountCents
+	switch movement.Kind {
+	case models.CashSale:
+		r.SalesCents += movement.AmountCents
+	case models.CashRefund:
+		r.RefundsCents -= movement.AmountCents
+	case models.CashPaidIn:
+		r.PaidInCents += movement.AmountCents
+	case models.CashPaidOut:
+		r.PaidOutCents -= movement.AmountCents
+	}
+}
+
+func findDrawer(repo *repository.CashRepository, register string) (*models.CashDrawer, error) {
+	drawer, err := repo.FindDrawerByRegister(register)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w %q", ErrUnknownRegister, register)
+	}
+	return drawer, err
+}
@@ -0,0 +1,94 @@
+package cash
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+)
+
+func TestReportAdd(t *testing.T) {
+	report := &Report{ExpectedCents: 10000}
+	for _, movement := range []models.CashMovement{
+		{Kind: models.CashSale, AmountCents: 450},
+		{Kind: models.CashSale, AmountCents: 350},
+		{Kind: models.CashRefund, AmountCents: -350},
+		{Kind: models.CashPaidIn, AmountCents: 2000},
+		{Kind: models.CashPaidOut, AmountCents: -1500},
+	} {
+		report.add(movement)
+	}
+
+	want := Report{
+		SalesCents:    800,
+		RefundsCents:  350,
+		PaidInCents:   2000,
+		PaidOutCents:  1500,
+		ExpectedCents: 10950,
+	}
+	if report.SalesCents != want.SalesCents || report.RefundsCents != want.RefundsCents ||
+		report.PaidInCents != want.PaidInCents || report.PaidOutCents != want.PaidOutCents ||
+		report.ExpectedCents != want.ExpectedCents {
+		t.Errorf("report = %+v, want %+v", *report, want)
+	}
+	if len(report.Movements) != 5 {
+		t.Errorf("got %d movements, want 5", len(report.Movements))
+	}
+}
+
+func TestShift(t *testing.T) {
+	repo := repository.NewCashRepository(testdb.Open(t))
+
+	if _, err := OpenShift(repo, "back", "sam", 10000); !errors.Is(err, ErrUnknownRegister) {
+		t.Errorf("OpenShift(back) error = %v, want ErrUnknownRegister", err)
+	}
+	if _, err := Record(repo, "front", &models.CashMovement{Kind: models.CashSale, AmountCents: 450}); !errors.Is(err, ErrNoOpenShift) {
+		t.Errorf("Record() before opening error = %v, want ErrNoOpenShift", err)
+	}
+
+	if _, err := OpenShift(repo, "front", "sam", 10000); err != nil {
+		t.Fatalf("OpenShift() error = %v", err)
+	}
+	if _, err := OpenShift(repo, "front", "kim", 5000); !errors.Is(err, ErrShiftOpen) {
+		t.Errorf("second OpenShift() error = %v, want ErrShiftOpen", err)
+	}
+
+	for _, movement := range []models.CashMovement{
+		{Kind: models.CashSale, AmountCents: 450, Operator: "sam"},
+		{Kind: models.CashPaidOut, AmountCents: -2000, Reason: "milk delivery", Operator: "sam"},
+	} {
+		if _, err := Record(repo, "front", &movement); err != nil {
+			t.Fatalf("Record(%s) error = %v", movement.Kind, err)
+		}
+	}
+	_, err := Record(repo, "front", &models.CashMovement{Kind: models.CashPaidOut, AmountCents: -9000, Operator: "sam"})
+	if !errors.Is(err, ErrInsufficientCash) {
+		t.Errorf("Record() paying out more than the drawer holds error = %v, want ErrInsufficientCash", err)
+	}
+
+	report, err := CloseShift(repo, "front", "sam", 8400, "")
+	if err != nil {
+		t.Fatalf("CloseShift() error = %v", err)
+	}
+	if report.ExpectedCents != 8450 || report.OverShortCents != -50 {
+		t.Errorf("expected %d, over/short %d; want 8450, -50", report.ExpectedCents, report.OverShortCents)
+	}
+
+	shift, err := repo.FindShift(report.Shift.ID)
+	if err != nil {
+		t.Fatalf("FindShift() error = %v", err)
+	}
+	stored, err := Summarize(repo, shift)
+	if err != nil {
+		t.Fatalf("Summarize() error = %v", err)
+	}
+	if stored.Shift.Status != models.ShiftClosed || stored.CountedCents != 8400 || stored.OverShortCents != -50 {
+		t.Errorf("stored shift %s counted %d over/short %d; want CLOSED, 8400, -50", stored.Shift.Status, stored.CountedCents, stored.OverShortCents)
+	}
+
+	if _, err := OpenShift(repo, "front", "kim", 8400); err != nil {
+		t.Errorf("OpenShift() after closing error = %v", err)
+	}
+}
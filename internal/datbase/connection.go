@@ -26,6 +26,8 @@ func Connect() *gorm.DB {
 
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		// Map unique violations and the like to gorm.ErrDuplicatedKey.
+		TranslateError: true,
 	})
 
 	if err != nil {
@@ -0,0 +1,223 @@
+// Package exports writes orders out as CSV or NDJSON, one order at a time,
+// for the owner's spreadsheets and for loading into other tools.
+package exports
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// Writer writes orders in one format. Flush must be called after the last
+// order.
+type Writer interface {
+	Write(order *models.Order) error
+	Flush() error
+}
+
+// ContentTypes are the MIME types of the formats, for HTTP downloads.
+var ContentTypes = map[string]string{
+	FormatCSV:    "text/csv; charset=utf-8",
+	FormatNDJSON: "application/x-ndjson",
+}
+
+// NewWriter returns a Writer for format that writes to w. Times are shown
+// in loc.
+func NewWriter(w io.Writer, format string, loc *time.Location) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w), loc: loc}, nil
+	case FormatNDJSON:
+		return &ndjsonWriter{enc: json.NewEncoder(w), loc: loc}, nil
+	}
+	return nil, fmt.Errorf("unknown export format %q", format)
+}
+
+// csvHeader names the columns of a CSV export. Modifiers and the status
+// history are packed into one column each, separated by semicolons.
+var csvHeader = []string{
+	"order_id", "created_at", "menu_item_name", "quantity", "modifiers",
+	"status", "customer_id", "customer_name", "promo_code",
+	"subtotal_cents", "discount_cents", "tax_cents", "total_cents", "refunded_cents", "currency",
+	"ready_at", "picked_up_at", "status_history",
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	loc         *time.Location
+	wroteHeader bool
+}
+
+func (c *csvWriter) Write(order *models.Order) error {
+	if !c.wroteHeader {
+		if err := c.w.Write(csvHeader); err != nil {
+			return err
+		}
+		c.wroteHeader = true
+	}
+
+	var modifiers []string
+	for _, modifier := range order.Modifiers {
+		modifiers = append(modifiers, modifier.Name)
+	}
+	var history []string
+	for _, change := range order.StatusChanges {
+		history = append(history, string(change.Status)+" "+formatTime(&change.CreatedAt, c.loc))
+	}
+
+	record := []string{
+		orderID(order),
+		formatTime(&order.CreatedAt, c.loc),
+		order.MenuItemName,
+		strconv.Itoa(order.Quantity),
+		strings.Join(modifiers, ";"),
+		string(order.Status),
+		order.CustomerID,
+		order.CustomerName,
+		order.PromoCode,
+		strconv.FormatInt(order.SubtotalCents, 10),
+		strconv.FormatInt(order.DiscountCents, 10),
+		strconv.FormatInt(order.TaxCents, 10),
+		strconv.FormatInt(order.TotalCents, 10),
+		strconv.FormatInt(refundedCents(order), 10),
+		order.Currency,
+		formatTime(order.ReadyAt, c.loc),
+		formatTime(order.PickedUpAt, c.loc),
+		strings.Join(history, ";"),
+	}
+	for i, cell := range record {
+		record[i] = escapeFormula(cell)
+	}
+	return c.w.Write(record)
+}
+
+// escapeFormula keeps spreadsheets from running a cell as a formula, e.g. a
+// customer name of "=HYPERLINK(...)", by prefixing it with a quote.
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// Flush writes out the header even when there were no orders, so an empty
+// export is still a valid CSV file.
+func (c *csvWriter) Flush() error {
+	if !c.wroteHeader {
+		if err := c.w.Write(csvHeader); err != nil {
+			return err
+		}
+		c.wroteHeader = true
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+type ndjsonWriter struct {
+	enc *json.Encoder
+	loc *time.Location
+}
+
+type jsonOrder struct {
+	OrderID       string           `json:"order_id"`
+	CreatedAt     string           `json:"created_at"`
+	MenuItemName  string           `json:"menu_item_name"`
+	Quantity      int              `json:"quantity"`
+	Modifiers     []jsonModifier   `json:"modifiers"`
+	Status        string           `json:"status"`
+	CustomerID    string           `json:"customer_id,omitempty"`
+	CustomerName  string           `json:"customer_name,omitempty"`
+	PromoCode     string           `json:"promo_code,omitempty"`
+	SubtotalCents int64            `json:"subtotal_cents"`
+	DiscountCents int64            `json:"discount_cents"`
+	TaxCents      int64            `json:"tax_cents"`
+	TotalCents    int64            `json:"total_cents"`
+	RefundedCents int64            `json:"refunded_cents"`
+	Currency      string           `json:"currency"`
+	ReadyAt       string           `json:"ready_at,omitempty"`
+	PickedUpAt    string           `json:"picked_up_at,omitempty"`
+	StatusHistory []jsonTransition `json:"status_history"`
+}
+
+type jsonModifier struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	PriceCents int64  `json:"price_cents"`
+}
+
+type jsonTransition struct {
+	Status string `json:"status"`
+	At     string `json:"at"`
+}
+
+func (n *ndjsonWriter) Write(order *models.Order) error {
+	record := jsonOrder{
+		OrderID:       orderID(order),
+		CreatedAt:     formatTime(&order.CreatedAt, n.loc),
+		MenuItemName:  order.MenuItemName,
+		Quantity:      order.Quantity,
+		Modifiers:     []jsonModifier{},
+		Status:        string(order.Status),
+		CustomerID:    order.CustomerID,
+		CustomerName:  order.CustomerName,
+		PromoCode:     order.PromoCode,
+		SubtotalCents: order.SubtotalCents,
+		DiscountCents: order.DiscountCents,
+		TaxCents:      order.TaxCents,
+		TotalCents:    order.TotalCents,
+		RefundedCents: refundedCents(order),
+		Currency:      order.Currency,
+		ReadyAt:       formatTime(order.ReadyAt, n.loc),
+		PickedUpAt:    formatTime(order.PickedUpAt, n.loc),
+		StatusHistory: []jsonTransition{},
+	}
+	for _, modifier := range order.Modifiers {
+		record.Modifiers = append(record.Modifiers, jsonModifier{
+			ID:         modifier.ModifierID,
+			Name:       modifier.Name,
+			PriceCents: modifier.PriceCents,
+		})
+	}
+	for _, change := range order.StatusChanges {
+		record.StatusHistory = append(record.StatusHistory, jsonTransition{
+			Status: string(change.Status),
+			At:     formatTime(&change.CreatedAt, n.loc),
+		})
+	}
+	// Encode ends every record with a newline.
+	return n.enc.Encode(record)
+}
+
+func (n *ndjsonWriter) Flush() error {
+	return nil
+}
+
+func orderID(order *models.Order) string {
+	return fmt.Sprintf("order-%d", order.ID)
+}
+
+func refundedCents(order *models.Order) int64 {
+	var cents int64
+	for _, refund := range order.Refunds {
+		cents += refund.AmountCents
+	}
+	return cents
+}
+
+func formatTime(t *time.Time, loc *time.Location) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
@@ -0,0 +1,174 @@
+package exports
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func testOrder() *models.Order {
+	created := time.Date(2026, 3, 14, 8, 5, 0, 0, time.UTC)
+	ready := created.Add(3 * time.Minute)
+	return &models.Order{
+		ID:           42,
+		MenuItemName: "Latte",
+		Status:       models.StatusReady,
+		Quantity:     2,
+		Modifiers: []models.OrderModifier{
+			{ModifierID: "oat-milk", Name: "Oat milk", PriceCents: 60},
+			{ModifierID: "extra-shot", Name: "Extra shot", PriceCents: 80},
+		},
+		Refunds:       []models.Refund{{AmountCents: 100}, {AmountCents: 50}},
+		CustomerName:  "=HYPERLINK(\"http://evil.example\")",
+		SubtotalCents: 980,
+		TotalCents:    980,
+		Currency:      "USD",
+		ReadyAt:       &ready,
+		StatusChanges: []models.OrderStatusChange{
+			{Status: models.StatusQueued, CreatedAt: created},
+			{Status: models.StatusReady, CreatedAt: ready},
+		},
+		CreatedAt: created,
+	}
+}
+
+func TestCSV(t *testing.T) {
+	loc := time.FixedZone("UTC+7", 7*3600)
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatCSV, loc)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err := w.Write(testOrder()); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("export is not valid CSV: %v", err)
+	}
+	if len(records) != 2 || !slices.Equal(records[0], csvHeader) {
+		t.Fatalf("export = %q, want the header and one order", records)
+	}
+	row := make(map[string]string)
+	for i, column := range csvHeader {
+		row[column] = records[1][i]
+	}
+	want := map[string]string{
+		"order_id":       "order-42",
+		"created_at":     "2026-03-14T15:05:00+07:00",
+		"modifiers":      "Oat milk;Extra shot",
+		"customer_name":  "'=HYPERLINK(\"http://evil.example\")",
+		"refunded_cents": "150",
+		"picked_up_at":   "",
+		"status_history": "QUEUED 2026-03-14T15:05:00+07:00;READY 2026-03-14T15:08:00+07:00",
+	}
+	for column, value := range want {
+		if row[column] != value {
+			t.Errorf("%s = %q, want %q", column, row[column], value)
+		}
+	}
+}
+
+func TestCSVEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatCSV, time.UTC)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil || len(records) != 1 || !slices.Equal(records[0], csvHeader) {
+		t.Errorf("empty export = %q, %v; want only the header", records, err)
+	}
+}
+
+func TestEscapeFormula(t *testing.T) {
+	tests := map[string]string{
+		"=1+1":   "'=1+1",
+		"+33 6":  "'+33 6",
+		"-5":     "'-5",
+		"@SUM()": "'@SUM()",
+		"\tx":    "'\tx",
+		"Kim":    "Kim",
+		"":       "",
+		"a=b":    "a=b",
+	}
+	for cell, want := range tests {
+		if got := escapeFormula(cell); got != want {
+			t.Errorf("escapeFormula(%q) = %q, want %q", cell, got, want)
+		}
+	}
+}
+
+func TestNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatNDJSON, time.UTC)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	bare := &models.Order{ID: 43, MenuItemName: "Espresso", Status: models.StatusQueued, Quantity: 1}
+	for _, order := range []*models.Order{testOrder(), bare} {
+		if err := w.Write(order); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	var lines []string
+	var records []jsonOrder
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		var record jsonOrder
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line %q is not JSON: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("got %d lines, want 2", len(records))
+	}
+
+	first := records[0]
+	if first.OrderID != "order-42" || first.RefundedCents != 150 || first.ReadyAt != "2026-03-14T08:08:00Z" {
+		t.Errorf("first record = %+v", first)
+	}
+	if len(first.Modifiers) != 2 || first.Modifiers[0].ID != "oat-milk" || len(first.StatusHistory) != 2 {
+		t.Errorf("first record modifiers %+v, history %+v", first.Modifiers, first.StatusHistory)
+	}
+	// The customer name is data here, not a spreadsheet cell.
+	if first.CustomerName != testOrder().CustomerName {
+		t.Errorf("CustomerName = %q, want it unchanged", first.CustomerName)
+	}
+
+	// Empty lists stay lists and empty optional fields are left out.
+	for _, want := range []string{`"modifiers":[]`, `"status_history":[]`} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("bare order %s does not contain %s", lines[1], want)
+		}
+	}
+	if strings.Contains(lines[1], "customer_id") || strings.Contains(lines[1], "ready_at") {
+		t.Errorf("bare order %s has empty optional fields", lines[1])
+	}
+}
+
+func TestNewWriterUnknownFormat(t *testing.T) {
+	if _, err := NewWriter(&bytes.Buffer{}, "xlsx", time.UTC); err == nil {
+		t.Error("NewWriter(xlsx) succeeded")
+	}
+}
@@ -0,0 +1,123 @@
+package giftcards
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	giftcardpb "github.com/jany/my-coffee/gen/proto/giftcard"
+	"github.com/jany/my-coffee/gen/proto/giftcard/giftcardconnect"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ giftcardconnect.GiftCardServiceHandler = (*Server)(nil)
+
+type Server struct {
+	db           *gorm.DB
+	giftCardRepo *repository.GiftCardRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		db:           db,
+		giftCardRepo: repository.NewGiftCardRepository(db),
+	}
+}
+
+func (s *Server) IssueGiftCard(ctx context.Context, req *connect.Request[giftcardpb.IssueGiftCardRequest]) (*connect.Response[giftcardpb.IssueGiftCardResponse], error) {
+	currency := req.Msg.Currency
+	if currency == "" {
+		currency = config.AppConfig.Currency
+	}
+
+	var card *models.GiftCard
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		card, err = Issue(repository.NewGiftCardRepository(tx), req.Msg.AmountCents, currency)
+		return err
+	})
+	if err != nil {
+		log.Printf("Failed to issue gift card: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to issue gift card: %w", err))
+	}
+
+	return connect.NewResponse(&giftcardpb.IssueGiftCardResponse{
+		GiftCard: toProto(card),
+	}), nil
+}
+
+func (s *Server) GetGiftCard(ctx context.Context, req *connect.Request[giftcardpb.GetGiftCardRequest]) (*connect.Response[giftcardpb.GetGiftCardResponse], error) {
+	card, err := s.giftCardRepo.FindByCode(NormalizeCode(req.Msg.Code))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, ErrUnknownCard)
+	}
+	if err != nil {
+		log.Printf("Failed to get gift card: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get gift card: %w", err))
+	}
+
+	txns, err := s.giftCardRepo.FindTransactions(card.ID)
+	if err != nil {
+		log.Printf("Failed to list gift card transactions: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list gift card transactions: %w", err))
+	}
+
+	var txnpbs []*giftcardpb.GiftCardTransaction
+	for _, txn := range txns {
+		txnpbs = append(txnpbs, toTransactionProto(&txn))
+	}
+
+	return connect.NewResponse(&giftcardpb.GetGiftCardResponse{
+		GiftCard:     toProto(card),
+		Transactions: txnpbs,
+	}), nil
+}
+
+func (s *Server) CheckGiftCardLedger(ctx context.Context, req *connect.Request[giftcardpb.CheckGiftCardLedgerRequest]) (*connect.Response[giftcardpb.CheckGiftCardLedgerResponse], error) {
+	problems, outstanding, err := Check(s.giftCardRepo)
+	if err != nil {
+		log.Printf("Failed to check gift card ledger: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check gift card ledger: %w", err))
+	}
+
+	for _, problem := range problems {
+		log.Printf("Gift card ledger: %s", problem)
+	}
+
+	return connect.NewResponse(&giftcardpb.CheckGiftCardLedgerResponse{
+		Consistent:       len(problems) == 0,
+		Problems:         problems,
+		OutstandingCents: outstanding,
+	}), nil
+}
+
+func toProto(card *models.GiftCard) *giftcardpb.GiftCard {
+	return &giftcardpb.GiftCard{
+		Code:         FormatCode(card.Code),
+		Currency:     card.Currency,
+		BalanceCents: card.BalanceCents,
+		Active:       card.Active,
+		CreatedAt:    timestamppb.New(card.CreatedAt),
+	}
+}
+
+func toTransactionProto(txn *models.GiftCardTransaction) *giftcardpb.GiftCardTransaction {
+	txnpb := &giftcardpb.GiftCardTransaction{
+		Kind:          string(txn.Kind),
+		AmountCents:   txn.AmountCents,
+		DebitAccount:  txn.DebitAccount,
+		CreditAccount: txn.CreditAccount,
+		CreatedAt:     timestamppb.New(txn.CreatedAt),
+	}
+	if txn.OrderID != nil {
+		txnpb.OrderId = fmt.Sprintf("order-%d", *txn.OrderID)
+	}
+	return txnpb
+}
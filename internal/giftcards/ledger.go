@@ -0,0 +1,205 @@
+package giftcards
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Ledger accounts besides the cards' own. Money enters through issued and
+// leaves through sales; a card's account holds what it can still spend.
+const (
+	AccountIssued = "issued"
+	AccountSales  = "sales"
+)
+
+var (
+	ErrUnknownCard         = errors.New("unknown gift card")
+	ErrInactive            = errors.New("gift card is not active")
+	ErrInsufficientBalance = errors.New("gift card balance is too low")
+	ErrCurrencyMismatch    = errors.New("gift card is in a different currency")
+)
+
+// codeAlphabet leaves out letters and digits that are easy to confuse when
+// a code is read out or typed from a receipt.
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// Account is the ledger account of the card with the given code.
+func Account(code string) string {
+	return "giftcard:" + code
+}
+
+// NormalizeCode uppercases a code and drops the dashes and spaces people
+// type between its groups.
+func NormalizeCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
+
+// FormatCode groups a code in fours for printing.
+func FormatCode(code string) string {
+	var groups []string
+	for len(code) > 4 {
+		groups = append(groups, code[:4])
+		code = code[4:]
+	}
+	return strings.Join(append(groups, code), "-")
+}
+
+// newCode returns 16 random characters from codeAlphabet.
+func newCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = codeAlphabet[int(b[i])%len(codeAlphabet)]
+	}
+	return string(b), nil
+}
+
+// Issue creates a card holding amountCents.
+func Issue(repo *repository.GiftCardRepository, amountCents int64, currency string) (*models.GiftCard, error) {
+	code, err := newCode()
+	if err != nil {
+		return nil, fmt.Errorf("generate code: %w", err)
+	}
+
+	card := &models.GiftCard{
+		Code:         code,
+		Currency:     currency,
+		BalanceCents: amountCents,
+		Active:       true,
+	}
+	if err := repo.Create(card); err != nil {
+		return nil, err
+	}
+	err = repo.AppendTransaction(&models.GiftCardTransaction{
+		GiftCardID:    card.ID,
+		Kind:          models.GiftCardIssue,
+		AmountCents:   amountCents,
+		DebitAccount:  AccountIssued,
+		CreditAccount: Account(card.Code),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// ProviderName is recorded as the provider of gift card payments.
+const ProviderName = "giftcard"
+
+// Find returns the card with the given code if it can pay for an order in
+// currency.
+func Find(repo *repository.GiftCardRepository, code, currency string) (*models.GiftCard, error) {
+	card, err := repo.FindByCode(NormalizeCode(code))
+	return usable(card, err, currency)
+}
+
+// Lock is Find for spending: the card stays locked until the transaction
+// ends.
+func Lock(repo *repository.GiftCardRepository, code, currency string) (*models.GiftCard, error) {
+	card, err := repo.FindByCodeForUpdate(NormalizeCode(code))
+	return usable(card, err, currency)
+}
+
+func usable(card *models.GiftCard, err error, currency string) (*models.GiftCard, error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrUnknownCard
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !card.Active {
+		return nil, ErrInactive
+	}
+	if card.Currency != currency {
+		return nil, fmt.Errorf("%w: %s, the order is in %s", ErrCurrencyMismatch, card.Currency, currency)
+	}
+	return card, nil
+}
+
+// Redeem spends amountCents of a card locked with Lock on an order.
+func Redeem(repo *repository.GiftCardRepository, card *models.GiftCard, orderID uint, amountCents int64) error {
+	if amountCents > card.BalanceCents {
+		return fmt.Errorf("%w: %d left", ErrInsufficientBalance, card.BalanceCents)
+	}
+	return move(repo, card, models.GiftCardRedeem, orderID, -amountCents, Account(card.Code), AccountSales)
+}
+
+// Refund puts amountCents of a refunded order back on the card.
+func Refund(repo *repository.GiftCardRepository, code string, orderID uint, amountCents int64) error {
+	card, err := repo.FindByCodeForUpdate(code)
+	if err != nil {
+		return err
+	}
+	return move(repo, card, models.GiftCardRefund, orderID, amountCents, AccountSales, Account(card.Code))
+}
+
+// move records one ledger transaction and applies delta to the card's
+// cached balance in the same database transaction.
+func move(repo *repository.GiftCardRepository, card *models.GiftCard, kind models.GiftCardTransactionKind, orderID uint, delta int64, debit, credit string) error {
+	err := repo.AppendTransaction(&models.GiftCardTransaction{
+		GiftCardID:    card.ID,
+		Kind:          kind,
+		AmountCents:   max(delta, -delta),
+		DebitAccount:  debit,
+		CreditAccount: credit,
+		OrderID:       &orderID,
+	})
+	if err != nil {
+		return err
+	}
+	card.BalanceCents += delta
+	return repo.Update(card)
+}
+
+// Check verifies the ledger: the balances of all accounts must sum to zero,
+// every card's cached balance must equal its account, and no account may
+// hold money that does not belong to a card. It returns one line per
+// problem and what the cards hold in total.
+func Check(repo *repository.GiftCardRepository) (problems []string, outstandingCents int64, err error) {
+	balances, err := repo.AccountBalances()
+	if err != nil {
+		return nil, 0, err
+	}
+	cards, err := repo.FindAll()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ledger := make(map[string]int64)
+	var sum int64
+	for _, balance := range balances {
+		ledger[balance.Account] = balance.BalanceCents
+		sum += balance.BalanceCents
+	}
+	if sum != 0 {
+		problems = append(problems, fmt.Sprintf("ledger does not balance: accounts sum to %d", sum))
+	}
+
+	known := map[string]bool{AccountIssued: true, AccountSales: true}
+	for _, card := range cards {
+		account := Account(card.Code)
+		known[account] = true
+		if ledger[account] != card.BalanceCents {
+			problems = append(problems, fmt.Sprintf("%s: balance %d, ledger %d", FormatCode(card.Code), card.BalanceCents, ledger[account]))
+		}
+		if ledger[account] < 0 {
+			problems = append(problems, fmt.Sprintf("%s: ledger is overdrawn", FormatCode(card.Code)))
+		}
+		outstandingCents += ledger[account]
+	}
+	for _, balance := range balances {
+		if !known[balance.Account] {
+			problems = append(problems, fmt.Sprintf("ledger has unknown account %q", balance.Account))
+		}
+	}
+	return problems, outstandingCents, nil
+}
@@ -0,0 +1,135 @@
+package giftcards
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+)
+
+func TestNormalizeCode(t *testing.T) {
+	if got := NormalizeCode("abcd-efgh jkmn-2345"); got != "ABCDEFGHJKMN2345" {
+		t.Errorf("NormalizeCode() = %q, want ABCDEFGHJKMN2345", got)
+	}
+}
+
+func TestFormatCode(t *testing.T) {
+	tests := map[string]string{
+		"ABCDEFGHJKMN2345": "ABCD-EFGH-JKMN-2345",
+		"ABCDEF":           "ABCD-EF",
+		"ABCD":             "ABCD",
+		"":                 "",
+	}
+	for code, want := range tests {
+		if got := FormatCode(code); got != want {
+			t.Errorf("FormatCode(%q) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestNewCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 100 {
+		code, err := newCode()
+		if err != nil {
+			t.Fatalf("newCode() error = %v", err)
+		}
+		if len(code) != 16 {
+			t.Errorf("newCode() = %q, want 16 characters", code)
+		}
+		if i := strings.IndexFunc(code, func(r rune) bool { return !strings.ContainsRune(codeAlphabet, r) }); i >= 0 {
+			t.Errorf("newCode() = %q has %q, which is not in the alphabet", code, code[i])
+		}
+		if NormalizeCode(FormatCode(code)) != code {
+			t.Errorf("%q does not survive formatting", code)
+		}
+		if seen[code] {
+			t.Errorf("newCode() returned %q twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestLedger(t *testing.T) {
+	db := testdb.Open(t)
+	repo := repository.NewGiftCardRepository(db)
+
+	card, err := Issue(repo, 2500, "USD")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	other, err := Issue(repo, 1000, "USD")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	locked, err := Lock(repo, strings.ToLower(FormatCode(card.Code)), "USD")
+	if err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if err := Redeem(repo, locked, 1, 2000); err != nil {
+		t.Fatalf("Redeem() error = %v", err)
+	}
+	if err := Redeem(repo, locked, 2, 600); !errors.Is(err, ErrInsufficientBalance) {
+		t.Errorf("Redeem() over the balance error = %v, want ErrInsufficientBalance", err)
+	}
+	if err := Refund(repo, card.Code, 1, 800); err != nil {
+		t.Fatalf("Refund() error = %v", err)
+	}
+
+	found, err := Find(repo, card.Code, "USD")
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if found.BalanceCents != 1300 {
+		t.Errorf("balance = %d, want 1300", found.BalanceCents)
+	}
+
+	problems, outstanding, err := Check(repo)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(problems) != 0 {
+		t.Errorf("Check() problems = %v, want none", problems)
+	}
+	if outstanding != 1300+other.BalanceCents {
+		t.Errorf("outstanding = %d, want %d", outstanding, 1300+other.BalanceCents)
+	}
+
+	// A balance changed behind the ledger's back is reported.
+	if err := db.Model(other).Update("balance_cents", 5000).Error; err != nil {
+		t.Fatalf("Failed to change balance: %v", err)
+	}
+	problems, _, err = Check(repo)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(problems) != 1 || !strings.Contains(problems[0], FormatCode(other.Code)) {
+		t.Errorf("Check() problems = %v, want one about %s", problems, FormatCode(other.Code))
+	}
+}
+
+func TestFindUnusable(t *testing.T) {
+	db := testdb.Open(t)
+	repo := repository.NewGiftCardRepository(db)
+
+	card, err := Issue(repo, 1000, "EUR")
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+	if _, err := Find(repo, "NOPE", "EUR"); !errors.Is(err, ErrUnknownCard) {
+		t.Errorf("Find(unknown) error = %v, want ErrUnknownCard", err)
+	}
+	if _, err := Find(repo, card.Code, "USD"); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Find(other currency) error = %v, want ErrCurrencyMismatch", err)
+	}
+
+	if err := db.Model(card).Update("active", false).Error; err != nil {
+		t.Fatalf("Failed to deactivate card: %v", err)
+	}
+	if _, err := Lock(repo, card.Code, "EUR"); !errors.Is(err, ErrInactive) {
+		t.Errorf("Lock(inactive) error = %v, want ErrInactive", err)
+	}
+}
@@ -0,0 +1,139 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	inventorypb "github.com/jany/my-coffee/gen/proto/inventory"
+	"github.com/jany/my-coffee/gen/proto/inventory/inventoryconnect"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ inventoryconnect.InventoryServiceHandler = (*Server)(nil)
+
+type Server struct {
+	inventoryRepo *repository.InventoryRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		inventoryRepo: repository.NewInventoryRepository(db),
+	}
+}
+
+func (s *Server) ListIngredients(ctx context.Context, req *connect.Request[inventorypb.ListIngredientsRequest]) (*connect.Response[inventorypb.ListIngredientsResponse], error) {
+	ingredients, err := s.inventoryRepo.FindAll()
+	if err != nil {
+		log.Printf("Failed to list ingredients: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list ingredients: %w", err))
+	}
+
+	var ingredientpbs []*inventorypb.Ingredient
+	for _, ingredient := range ingredients {
+		ingredientpbs = append(ingredientpbs, toProto(&ingredient))
+	}
+
+	return connect.NewResponse(&inventorypb.ListIngredientsResponse{
+		Ingredients: ingredientpbs,
+	}), nil
+}
+
+func (s *Server) RestockIngredient(ctx context.Context, req *connect.Request[inventorypb.RestockIngredientRequest]) (*connect.Response[inventorypb.RestockIngredientResponse], error) {
+	ingredient, err := s.inventoryRepo.Restock(req.Msg.Code, req.Msg.Quantity)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown ingredient %q", req.Msg.Code))
+	}
+	if err != nil {
+		log.Printf("Failed to restock ingredient: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to restock ingredient: %w", err))
+	}
+
+	return connect.NewResponse(&inventorypb.RestockIngredientResponse{
+		Ingredient: toProto(ingredient),
+	}), nil
+}
+
+func (s *Server) SetReorderSettings(ctx context.Context, req *connect.Request[inventorypb.SetReorderSettingsRequest]) (*connect.Response[inventorypb.SetReorderSettingsResponse], error) {
+	ingredient, err := s.inventoryRepo.SetReorderSettings(req.Msg.Code, req.Msg.ReorderThreshold, req.Msg.PackSize)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown ingredient %q", req.Msg.Code))
+	}
+	if err != nil {
+		log.Printf("Failed to update reorder settings: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update reorder settings: %w", err))
+	}
+
+	return connect.NewResponse(&inventorypb.SetReorderSettingsResponse{
+		Ingredient: toProto(ingredient),
+	}), nil
+}
+
+func (s *Server) ListLowStock(ctx context.Context, req *connect.Request[inventorypb.ListLowStockRequest]) (*connect.Response[inventorypb.ListLowStockResponse], error) {
+	ingredients, err := s.inventoryRepo.FindLow()
+	if err != nil {
+		log.Printf("Failed to list low stock: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list low stock: %w", err))
+	}
+
+	var ingredientpbs []*inventorypb.Ingredient
+	for _, ingredient := range ingredients {
+		ingredientpbs = append(ingredientpbs, toProto(&ingredient))
+	}
+
+	return connect.NewResponse(&inventorypb.ListLowStockResponse{
+		Ingredients: ingredientpbs,
+	}), nil
+}
+
+func (s *Server) GetPurchaseList(ctx context.Context, req *connect.Request[inventorypb.GetPurchaseListRequest]) (*connect.Response[inventorypb.GetPurchaseListResponse], error) {
+	lookbackDays := int(req.Msg.LookbackDays)
+	if lookbackDays == 0 {
+		lookbackDays = defaultLookbackDays
+	}
+	coverDays := int(req.Msg.CoverDays)
+	if coverDays == 0 {
+		coverDays = defaultCoverDays
+	}
+
+	ingredients, err := s.inventoryRepo.FindAll()
+	if err != nil {
+		log.Printf("Failed to list ingredients: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list ingredients: %w", err))
+	}
+
+	since := time.Now().AddDate(0, 0, -lookbackDays)
+	consumed, err := s.inventoryRepo.ConsumptionSince(since)
+	if err != nil {
+		log.Printf("Failed to read consumption: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to read consumption: %w", err))
+	}
+
+	return connect.NewResponse(&inventorypb.GetPurchaseListResponse{
+		Suppliers: purchaseList(ingredients, consumed, lookbackDays, coverDays),
+	}), nil
+}
+
+func toProto(ingredient *models.Ingredient) *inventorypb.Ingredient {
+	var supplier string
+	if ingredient.Supplier != nil {
+		supplier = ingredient.Supplier.Name
+	}
+
+	return &inventorypb.Ingredient{
+		Code:             ingredient.Code,
+		Name:             ingredient.Name,
+		Unit:             ingredient.Unit,
+		Stock:            ingredient.Stock,
+		ReorderThreshold: ingredient.ReorderThreshold,
+		PackSize:         ingredient.PackSize,
+		Supplier:         supplier,
+		Low:              ingredient.IsLow(),
+	}
+}
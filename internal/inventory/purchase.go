@@ -0,0 +1,77 @@
+package inventory
+
+import (
+	"math"
+	"sort"
+
+	inventorypb "github.com/jany/my-coffee/gen/proto/inventory"
+	"github.com/jany/my-coffee/internal/models"
+)
+
+const (
+	defaultLookbackDays = 7
+	defaultCoverDays    = 7
+
+	// unassignedSupplier groups ingredients nobody has set a supplier for.
+	unassignedSupplier = "No supplier"
+)
+
+// suggestPurchase decides how much of an ingredient to buy so that after
+// coverDays of typical use the stock is still above the reorder threshold.
+// consumed is what orders used over the last lookbackDays. Ingredients that
+// will last are skipped.
+func suggestPurchase(ingredient *models.Ingredient, consumed int64, lookbackDays, coverDays int) (*inventorypb.PurchaseLine, bool) {
+	daily := float64(consumed) / float64(lookbackDays)
+	target := float64(ingredient.ReorderThreshold) + daily*float64(coverDays)
+	need := target - float64(ingredient.Stock)
+	if need <= 0 && !ingredient.IsLow() {
+		return nil, false
+	}
+
+	packSize := max(ingredient.PackSize, 1)
+	packs := max(int64(math.Ceil(need/float64(packSize))), 1)
+
+	return &inventorypb.PurchaseLine{
+		Ingredient:       toProto(ingredient),
+		DailyConsumption: math.Round(daily*100) / 100,
+		Quantity:         packs * packSize,
+		Packs:            packs,
+	}, true
+}
+
+// purchaseList groups the suggested lines by supplier, suppliers sorted by
+// name and lines by ingredient code.
+func purchaseList(ingredients []models.Ingredient, consumed map[uint]int64, lookbackDays, coverDays int) []*inventorypb.SupplierOrder {
+	bySupplier := make(map[string]*inventorypb.SupplierOrder)
+	for i := range ingredients {
+		ingredient := &ingredients[i]
+		line, ok := suggestPurchase(ingredient, consumed[ingredient.ID], lookbackDays, coverDays)
+		if !ok {
+			continue
+		}
+
+		name := unassignedSupplier
+		if ingredient.Supplier != nil {
+			name = ingredient.Supplier.Name
+		}
+		order, ok := bySupplier[name]
+		if !ok {
+			order = &inventorypb.SupplierOrder{Supplier: name}
+			if ingredient.Supplier != nil {
+				order.Email = ingredient.Supplier.Email
+				order.Phone = ingredient.Supplier.Phone
+			}
+			bySupplier[name] = order
+		}
+		order.Lines = append(order.Lines, line)
+	}
+
+	orders := make([]*inventorypb.SupplierOrder, 0, len(bySupplier))
+	for _, order := range bySupplier {
+		orders = append(orders, order)
+	}
+	sort.Slice(orders, func(i, j int) bool {
+		return orders[i].Supplier < orders[j].Supplier
+	})
+	return orders
+}
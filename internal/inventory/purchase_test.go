@@ -0,0 +1,93 @@
+package inventory
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestSuggestPurchase(t *testing.T) {
+	tests := []struct {
+		name       string
+		ingredient models.Ingredient
+		consumed   int64
+		wantOK     bool
+		wantPacks  int64
+		wantQty    int64
+		wantDaily  float64
+	}{
+		{
+			name:       "covers a week of use",
+			ingredient: models.Ingredient{Stock: 500, ReorderThreshold: 1000, PackSize: 1000},
+			consumed:   7000,
+			wantOK:     true, wantPacks: 8, wantQty: 8000, wantDaily: 1000,
+		},
+		{
+			name:       "will last",
+			ingredient: models.Ingredient{Stock: 50000, ReorderThreshold: 2000, PackSize: 1000},
+			consumed:   7000,
+		},
+		{
+			name:       "low but unused buys one pack",
+			ingredient: models.Ingredient{Stock: 10, ReorderThreshold: 10, PackSize: 100},
+			wantOK:     true, wantPacks: 1, wantQty: 100,
+		},
+		{
+			name:       "no pack size",
+			ingredient: models.Ingredient{Stock: 0, ReorderThreshold: 5, PackSize: 0},
+			consumed:   10,
+			wantOK:     true, wantPacks: 15, wantQty: 15, wantDaily: 1.43,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, ok := suggestPurchase(&tt.ingredient, tt.consumed, 7, 7)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if line.Packs != tt.wantPacks || line.Quantity != tt.wantQty {
+				t.Errorf("got %d packs, %d total; want %d packs, %d total", line.Packs, line.Quantity, tt.wantPacks, tt.wantQty)
+			}
+			if line.DailyConsumption != tt.wantDaily {
+				t.Errorf("DailyConsumption = %v, want %v", line.DailyConsumption, tt.wantDaily)
+			}
+		})
+	}
+}
+
+func TestPurchaseList(t *testing.T) {
+	dairy := &models.Supplier{Name: "Dairy Co", Email: "orders@dairy.example"}
+	roaster := &models.Supplier{Name: "Beans Ltd"}
+	ingredients := []models.Ingredient{
+		{ID: 1, Code: "whole_milk", Stock: 0, ReorderThreshold: 1000, PackSize: 1000, Supplier: dairy},
+		{ID: 2, Code: "coffee_beans", Stock: 0, ReorderThreshold: 500, PackSize: 1000, Supplier: roaster},
+		{ID: 3, Code: "oat_milk", Stock: 0, ReorderThreshold: 1000, PackSize: 1000, Supplier: dairy},
+		{ID: 4, Code: "cup", Stock: 0, ReorderThreshold: 50, PackSize: 100},
+		{ID: 5, Code: "vanilla_syrup", Stock: 5000, ReorderThreshold: 100, PackSize: 1000, Supplier: roaster},
+	}
+
+	orders := purchaseList(ingredients, map[uint]int64{1: 7000}, 7, 7)
+
+	var suppliers []string
+	for _, order := range orders {
+		suppliers = append(suppliers, order.Supplier)
+	}
+	want := []string{"Beans Ltd", "Dairy Co", unassignedSupplier}
+	if !slices.Equal(suppliers, want) {
+		t.Fatalf("suppliers = %v, want %v", suppliers, want)
+	}
+
+	if got := len(orders[0].Lines); got != 1 {
+		t.Errorf("Beans Ltd has %d lines, want 1: vanilla syrup will last", got)
+	}
+	if got := orders[1].Email; got != dairy.Email {
+		t.Errorf("Dairy Co email = %q, want %q", got, dairy.Email)
+	}
+	if got := orders[1].Lines[0].Quantity; got != 8000 {
+		t.Errorf("whole milk quantity = %d, want 8000", got)
+	}
+}
@@ -0,0 +1,125 @@
+package loyalty
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+)
+
+// ErrInsufficientStamps is returned when a customer redeems a reward they
+// have not collected yet.
+var ErrInsufficientStamps = errors.New("not enough loyalty stamps")
+
+// Reserve checks that customerID can redeem a reward and locks their ledger
+// until the transaction ends. Call Redeem in the same transaction once the
+// order exists.
+func Reserve(repo *repository.LoyaltyRepository, customerID string) error {
+	if err := repo.LockCustomer(customerID); err != nil {
+		return err
+	}
+	balance, err := repo.Balance(customerID)
+	if err != nil {
+		return err
+	}
+	if need := int64(config.AppConfig.LoyaltyStampsPerReward); balance < need {
+		return fmt.Errorf("%w: %d of %d collected", ErrInsufficientStamps, balance, need)
+	}
+	return nil
+}
+
+// Redeem spends one reward's worth of stamps on order.
+func Redeem(repo *repository.LoyaltyRepository, order *models.Order) error {
+	return repo.Append(&models.LoyaltyEntry{
+		CustomerID: order.CustomerID,
+		OrderID:    &order.ID,
+		Delta:      -config.AppConfig.LoyaltyStampsPerReward,
+		Reason:     models.LoyaltyRedeem,
+	})
+}
+
+// Earn adds a stamp for every drink of order the customer paid for, and
+// returns how many. An order earns once, and not at all after it has been
+// reversed, cancelled or refunded in full. Call it in the transaction that
+// makes the order READY, with the order locked, so a refund cannot slip in
+// between.
+func Earn(repo *repository.LoyaltyRepository, order *models.Order) (int, error) {
+	if order.CustomerID == "" || order.Status == models.StatusCancelled {
+		return 0, nil
+	}
+	// Reverse writes nothing for an order that had not earned yet, so a
+	// full refund is checked here.
+	refunded, err := repo.RefundedCents(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	if order.TotalCents > 0 && refunded >= order.TotalCents {
+		return 0, nil
+	}
+
+	entries, err := repo.FindByOrder(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	stamps := order.Quantity
+	for _, entry := range entries {
+		switch entry.Reason {
+		case models.LoyaltyEarn, models.LoyaltyReversal:
+			return 0, nil
+		case models.LoyaltyRedeem:
+			// The free drink does not earn a stamp.
+			stamps--
+		}
+	}
+	if stamps <= 0 {
+		return 0, nil
+	}
+
+	err = repo.Append(&models.LoyaltyEntry{
+		CustomerID: order.CustomerID,
+		OrderID:    &order.ID,
+		Delta:      stamps,
+		Reason:     models.LoyaltyEarn,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return stamps, nil
+}
+
+// Reverse undoes everything order earned and redeemed: earned stamps are
+// taken back and spent ones returned. It returns the change to the
+// customer's balance; reversing twice is a no-op.
+func Reverse(repo *repository.LoyaltyRepository, order *models.Order) (int, error) {
+	if order.CustomerID == "" {
+		return 0, nil
+	}
+	if err := repo.LockCustomer(order.CustomerID); err != nil {
+		return 0, err
+	}
+
+	entries, err := repo.FindByOrder(order.ID)
+	if err != nil {
+		return 0, err
+	}
+	net := 0
+	for _, entry := range entries {
+		net += entry.Delta
+	}
+	if net == 0 {
+		return 0, nil
+	}
+
+	err = repo.Append(&models.LoyaltyEntry{
+		CustomerID: order.CustomerID,
+		OrderID:    &order.ID,
+		Delta:      -net,
+		Reason:     models.LoyaltyReversal,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return -net, nil
+}
@@ -0,0 +1,196 @@
+package loyalty
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jany/my-coffee/config"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+	"gorm.io/gorm"
+)
+
+const stampsPerReward = 3
+
+func setup(t *testing.T) (*gorm.DB, *repository.LoyaltyRepository) {
+	t.Helper()
+	previous := config.AppConfig
+	config.AppConfig = &config.Config{LoyaltyStampsPerReward: stampsPerReward}
+	t.Cleanup(func() { config.AppConfig = previous })
+
+	db := testdb.Open(t)
+	return db, repository.NewLoyaltyRepository(db)
+}
+
+func createOrder(t *testing.T, db *gorm.DB, customerID string, quantity int) *models.Order {
+	t.Helper()
+	order := &models.Order{
+		MenuItemName: "Latte",
+		Status:       models.StatusReady,
+		CustomerID:   customerID,
+		Quantity:     quantity,
+		TotalCents:   int64(quantity) * 350,
+	}
+	if err := db.Create(order).Error; err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	return order
+}
+
+func refund(t *testing.T, db *gorm.DB, order *models.Order, cents int64) {
+	t.Helper()
+	payment := &models.Payment{
+		OrderID:       order.ID,
+		Provider:      "fake",
+		Method:        models.PaymentMethodCard,
+		Status:        models.PaymentCaptured,
+		AmountCents:   order.TotalCents,
+		CapturedCents: order.TotalCents,
+	}
+	if err := db.Create(payment).Error; err != nil {
+		t.Fatalf("Failed to create payment: %v", err)
+	}
+	err := db.Create(&models.Refund{
+		PaymentID:   payment.ID,
+		OrderID:     order.ID,
+		AmountCents: cents,
+		Reason:      "test",
+		Operator:    "tester",
+	}).Error
+	if err != nil {
+		t.Fatalf("Failed to create refund: %v", err)
+	}
+}
+
+func balance(t *testing.T, repo *repository.LoyaltyRepository, customerID string) int64 {
+	t.Helper()
+	balance, err := repo.Balance(customerID)
+	if err != nil {
+		t.Fatalf("Balance() error = %v", err)
+	}
+	return balance
+}
+
+func TestEarnOnce(t *testing.T) {
+	db, repo := setup(t)
+	order := createOrder(t, db, "alice", 2)
+
+	for range 2 {
+		if _, err := Earn(repo, order); err != nil {
+			t.Fatalf("Earn() error = %v", err)
+		}
+	}
+	if got := balance(t, repo, "alice"); got != 2 {
+		t.Errorf("balance = %d, want 2", got)
+	}
+}
+
+func TestEarnSkips(t *testing.T) {
+	db, repo := setup(t)
+
+	anonymous := createOrder(t, db, "", 1)
+	cancelled := createOrder(t, db, "alice", 1)
+	cancelled.Status = models.StatusCancelled
+	refunded := createOrder(t, db, "alice", 2)
+	refund(t, db, refunded, refunded.TotalCents)
+
+	for name, order := range map[string]*models.Order{"anonymous": anonymous, "cancelled": cancelled, "refunded": refunded} {
+		stamps, err := Earn(repo, order)
+		if err != nil || stamps != 0 {
+			t.Errorf("Earn(%s) = %d, %v; want 0, nil", name, stamps, err)
+		}
+	}
+	if got := balance(t, repo, "alice"); got != 0 {
+		t.Errorf("balance = %d, want 0", got)
+	}
+}
+
+func TestEarnAfterPartialRefund(t *testing.T) {
+	db, repo := setup(t)
+	order := createOrder(t, db, "alice", 2)
+	refund(t, db, order, 350)
+
+	if stamps, err := Earn(repo, order); err != nil || stamps != 2 {
+		t.Errorf("Earn() = %d, %v; want 2, nil", stamps, err)
+	}
+}
+
+func TestRedeemAndReverse(t *testing.T) {
+	db, repo := setup(t)
+	for range stampsPerReward {
+		if _, err := Earn(repo, createOrder(t, db, "alice", 1)); err != nil {
+			t.Fatalf("Earn() error = %v", err)
+		}
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		txRepo := repository.NewLoyaltyRepository(tx)
+		if err := Reserve(txRepo, "alice"); err != nil {
+			return err
+		}
+		return Redeem(txRepo, createOrder(t, tx, "alice", 2))
+	})
+	if err != nil {
+		t.Fatalf("redeeming: %v", err)
+	}
+	if got := balance(t, repo, "alice"); got != 0 {
+		t.Fatalf("balance after redeeming = %d, want 0", got)
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return Reserve(repository.NewLoyaltyRepository(tx), "alice")
+	})
+	if !errors.Is(err, ErrInsufficientStamps) {
+		t.Errorf("Reserve() with no stamps error = %v, want ErrInsufficientStamps", err)
+	}
+}
+
+func TestRedeemedDrinkEarnsNothing(t *testing.T) {
+	db, repo := setup(t)
+	order := createOrder(t, db, "alice", 2)
+	if err := Redeem(repo, order); err != nil {
+		t.Fatalf("Redeem() error = %v", err)
+	}
+
+	if stamps, err := Earn(repo, order); err != nil || stamps != 1 {
+		t.Errorf("Earn() = %d, %v; want 1 for the paid drink", stamps, err)
+	}
+}
+
+func TestReverse(t *testing.T) {
+	db, repo := setup(t)
+	order := createOrder(t, db, "alice", 2)
+	if err := Redeem(repo, order); err != nil {
+		t.Fatalf("Redeem() error = %v", err)
+	}
+	if _, err := Earn(repo, order); err != nil {
+		t.Fatalf("Earn() error = %v", err)
+	}
+
+	// Redeemed 3 and earned 1, so reversing gives 2 back.
+	err := db.Transaction(func(tx *gorm.DB) error {
+		change, err := Reverse(repository.NewLoyaltyRepository(tx), order)
+		if change != stampsPerReward-1 {
+			t.Errorf("Reverse() = %d, want %d", change, stampsPerReward-1)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Reverse() error = %v", err)
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		change, err := Reverse(repository.NewLoyaltyRepository(tx), order)
+		if change != 0 {
+			t.Errorf("second Reverse() = %d, want 0", change)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("second Reverse() error = %v", err)
+	}
+	if stamps, err := Earn(repo, order); err != nil || stamps != 0 {
+		t.Errorf("Earn() after Reverse = %d, %v; want 0, nil", stamps, err)
+	}
+}
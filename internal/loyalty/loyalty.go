@@ -0,0 +1,87 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	loyaltypb "github.com/jany/my-coffee/gen/proto/loyalty"
+	"github.com/jany/my-coffee/gen/proto/loyalty/loyaltyconnect"
+	"github.com/jany/my-coffee/internal/auth"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ loyaltyconnect.LoyaltyServiceHandler = (*Server)(nil)
+
+// recentEntries is how much history GetLoyalty returns.
+const recentEntries = 20
+
+type Server struct {
+	loyaltyRepo *repository.LoyaltyRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		loyaltyRepo: repository.NewLoyaltyRepository(db),
+	}
+}
+
+func (s *Server) GetLoyalty(ctx context.Context, req *connect.Request[loyaltypb.GetLoyaltyRequest]) (*connect.Response[loyaltypb.GetLoyaltyResponse], error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrMissingToken)
+	}
+	// Only staff look up other people's stamps.
+	customerID := claims.Subject
+	if claims.IsStaff() {
+		customerID = req.Msg.CustomerId
+	}
+	if customerID == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("customer_id is required"))
+	}
+
+	balance, err := s.loyaltyRepo.Balance(customerID)
+	if err != nil {
+		log.Printf("Failed to get loyalty balance: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get loyalty balance: %w", err))
+	}
+
+	entries, err := s.loyaltyRepo.FindByCustomer(customerID, recentEntries)
+	if err != nil {
+		log.Printf("Failed to list loyalty entries: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list loyalty entries: %w", err))
+	}
+
+	var entrypbs []*loyaltypb.LoyaltyEntry
+	for _, entry := range entries {
+		entrypbs = append(entrypbs, toEntryProto(&entry))
+	}
+
+	perReward := config.AppConfig.LoyaltyStampsPerReward
+	return connect.NewResponse(&loyaltypb.GetLoyaltyResponse{
+		CustomerId:       customerID,
+		Stamps:           balance,
+		StampsPerReward:  int32(perReward),
+		RewardsAvailable: max(balance, 0) / int64(perReward),
+		Entries:          entrypbs,
+	}), nil
+}
+
+func toEntryProto(entry *models.LoyaltyEntry) *loyaltypb.LoyaltyEntry {
+	entrypb := &loyaltypb.LoyaltyEntry{
+		Delta:     int32(entry.Delta),
+		Reason:    string(entry.Reason),
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+	if entry.OrderID != nil {
+		entrypb.OrderId = fmt.Sprintf("order-%d", *entry.OrderID)
+	}
+	return entrypb
+}
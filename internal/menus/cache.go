@@ -0,0 +1,98 @@
+package menus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+
+	menupb "github.com/jany/my-coffee/gen/proto/menu"
+)
+
+// cacheControl lets browsers and CDNs reuse a menu for a minute and serve a
+// stale copy while they revalidate it with If-None-Match.
+const cacheControl = "public, max-age=60, stale-while-revalidate=300"
+
+// catalogVersion changes whenever the catalog, modifiers or translations do.
+var catalogVersion = func() string {
+	h := sha256.New()
+	// fmt prints maps sorted by key, so the hash is stable between runs.
+	fmt.Fprintf(h, "%v|%v|%v", catalog, modifiers, translations)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}()
+
+// menuETag identifies one rendering of the catalog: the same catalog version
+// looks different per locale and allergen filter, and items change
+// availability as ingredients sell out.
+func menuETag(locale string, exclude []menupb.Allergen, soldOut []string) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%s|%v|%v", catalogVersion, locale, exclude, soldOut)
+	return `"` + hex.EncodeToString(h.Sum(nil))[:16] + `"`
+}
+
+// setCacheHeaders marks a response as cacheable. The body depends on the
+// Accept-Language header as well as the URL.
+func setCacheHeaders(h http.Header, etag string) {
+	h.Set("ETag", etag)
+	h.Set("Cache-Control", cacheControl)
+	h.Add("Vary", "Accept-Language")
+}
+
+// ConditionalGET answers GET requests whose If-None-Match header matches the
+// ETag of the response with 304 Not Modified and no body. Connect handlers
+// cannot choose their HTTP status, so the response is buffered and compared
+// here. Other requests pass straight through.
+func ConditionalGET(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if r.Method != http.MethodGet || ifNoneMatch == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rec := &bufferedResponse{header: make(http.Header), status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		for k, v := range rec.header {
+			w.Header()[k] = v
+		}
+
+		etag := rec.header.Get("ETag")
+		if rec.status == http.StatusOK && etag != "" && etagMatches(ifNoneMatch, etag) {
+			w.Header().Del("Content-Type")
+			w.Header().Del("Content-Length")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.WriteHeader(rec.status)
+		w.Write(rec.body.Bytes())
+	})
+}
+
+// etagMatches implements the weak comparison If-None-Match asks for.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(candidate), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header { return b.header }
+
+func (b *bufferedResponse) WriteHeader(status int) { b.status = status }
+
+func (b *bufferedResponse) Write(p []byte) (int, error) { return b.body.Write(p) }
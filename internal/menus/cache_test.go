@@ -0,0 +1,89 @@
+package menus
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	menupb "github.com/jany/my-coffee/gen/proto/menu"
+)
+
+func TestEtagMatches(t *testing.T) {
+	tests := []struct {
+		ifNoneMatch string
+		want        bool
+	}{
+		{`"abc"`, true},
+		{`W/"abc"`, true},
+		{`"xyz", "abc"`, true},
+		{` * `, true},
+		{`"xyz"`, false},
+		{`abc`, false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.ifNoneMatch, `"abc"`); got != tt.want {
+			t.Errorf("etagMatches(%q) = %v, want %v", tt.ifNoneMatch, got, tt.want)
+		}
+	}
+}
+
+func TestMenuETag(t *testing.T) {
+	etag := menuETag("en", nil, nil)
+	if etag != menuETag("en", nil, nil) {
+		t.Error("ETag changes between calls")
+	}
+	for name, other := range map[string]string{
+		"locale":   menuETag("vi", nil, nil),
+		"exclude":  menuETag("en", []menupb.Allergen{menupb.Allergen_DAIRY}, nil),
+		"sold out": menuETag("en", nil, []string{"oat_milk"}),
+	} {
+		if other == etag {
+			t.Errorf("ETag ignores %s", name)
+		}
+	}
+}
+
+func TestConditionalGET(t *testing.T) {
+	const etag = `"v1"`
+	handler := ConditionalGET(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setCacheHeaders(w.Header(), etag)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items":[]}`))
+	}))
+
+	tests := []struct {
+		name        string
+		method      string
+		ifNoneMatch string
+		wantStatus  int
+		wantBody    string
+	}{
+		{name: "no validator", method: http.MethodGet, wantStatus: http.StatusOK, wantBody: `{"items":[]}`},
+		{name: "matching", method: http.MethodGet, ifNoneMatch: etag, wantStatus: http.StatusNotModified},
+		{name: "stale", method: http.MethodGet, ifNoneMatch: `"v0"`, wantStatus: http.StatusOK, wantBody: `{"items":[]}`},
+		{name: "POST", method: http.MethodPost, ifNoneMatch: etag, wantStatus: http.StatusOK, wantBody: `{"items":[]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/menu.v1.MenuService/GetMenu", nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Body.String(); got != tt.wantBody {
+				t.Errorf("body = %q, want %q", got, tt.wantBody)
+			}
+			if got := rec.Header().Get("ETag"); got != etag {
+				t.Errorf("ETag = %q, want %q", got, etag)
+			}
+			if tt.wantStatus == http.StatusNotModified && rec.Header().Get("Content-Type") != "" {
+				t.Error("304 response has a Content-Type")
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package menus
+
+import (
+	"fmt"
+	"slices"
+
+	menupb "github.com/jany/my-coffee/gen/proto/menu"
+)
+
+// catalogItem is the source of truth for a menu item. Name and Description
+// are written in the default locale; other languages live in translations.
+type catalogItem struct {
+	ID          string
+	Name        string
+	Description string
+	Price       float64
+	Allergens   []menupb.Allergen
+	Nutrition   nutrition
+	// IDs of the modifiers that can be applied to this item.
+	Modifiers []string
+	Tags      []string
+	Recipe    []RecipeStage
+	// Ingredients consumed by one drink, keyed by ingredient code, in the
+	// ingredient's unit (grams, millilitres, pieces).
+	Ingredients map[string]int64
+}
+
+// RecipeStage is one brew stage of a drink. Stage is the name of a
+// brew.DrinkStatus; Parameters are instructions shown to the barista.
+type RecipeStage struct {
+	Stage         string
+	TargetSeconds int32
+	Parameters    map[string]string
+}
+
+// catalogModifier describes how a modifier changes the drink it is applied
+// to. Milk swaps remove dairy and add the allergens of the replacement.
+type catalogModifier struct {
+	ID               string
+	Name             string
+	Price            float64
+	RemovesAllergens []menupb.Allergen
+	AddsAllergens    []menupb.Allergen
+	// Change to the drink's nutrition, not the modifier on its own.
+	NutritionDelta nutrition
+	// Milk swaps use SwapTo instead of the SwapFrom ingredient, in the
+	// same quantity. Ingredients are consumed on top of the drink's.
+	SwapFrom    string
+	SwapTo      string
+	Ingredients map[string]int64
+}
+
+type nutrition struct {
+	Calories   int32
+	CaffeineMg int32
+}
+
+func (n nutrition) add(delta nutrition) nutrition {
+	return nutrition{
+		Calories:   max(n.Calories+delta.Calories, 0),
+		CaffeineMg: max(n.CaffeineMg+delta.CaffeineMg, 0),
+	}
+}
+
+var catalog = []catalogItem{
+	{
+		ID:          "espresso",
+		Name:        "Espresso",
+		Description: "Strong and rich Italian-style coffee",
+		Price:       2.50,
+		Nutrition:   nutrition{Calories: 3, CaffeineMg: 64},
+		Modifiers:   []string{"extra-shot"},
+		Tags:        []string{"hot", "coffee", "strong", "black"},
+		Recipe: []RecipeStage{
+			{Stage: "GRINDING", TargetSeconds: 20, Parameters: map[string]string{"grind_size": "fine", "dose_g": "18"}},
+			{Stage: "BREWING", TargetSeconds: 28, Parameters: map[string]string{"shot_yield_g": "36"}},
+		},
+		Ingredients: map[string]int64{"coffee_beans": 18, "cup": 1},
+	},
+	{
+		ID:          "latte",
+		Name:        "Latte",
+		Description: "Espresso with steamed milk and a light layer of foam",
+		Price:       3.50,
+		Allergens:   []menupb.Allergen{menupb.Allergen_DAIRY},
+		Nutrition:   nutrition{Calories: 190, CaffeineMg: 128},
+		Modifiers:   []string{"oat-milk", "almond-milk", "soy-milk", "extra-shot", "vanilla-syrup"},
+		Tags:        []string{"hot", "coffee", "milk", "foam"},
+		Recipe: []RecipeStage{
+			{Stage: "GRINDING", TargetSeconds: 20, Parameters: map[string]string{"grind_size": "fine", "dose_g": "18"}},
+			{Stage: "BREWING", TargetSeconds: 28, Parameters: map[string]string{"shot_yield_g": "36"}},
+			{Stage: "FROTHING", TargetSeconds: 45, Parameters: map[string]string{"milk_ml": "200", "milk_temp_c": "65", "foam": "light"}},
+		},
+		Ingredients: map[string]int64{"coffee_beans": 18, "whole_milk": 200, "cup": 1},
+	},
+	{
+		ID:          "cortado",
+		Name:        "Cortado",
+		Description: "Equal parts espresso and steamed milk",
+		Price:       3.25,
+		Allergens:   []menupb.Allergen{menupb.Allergen_DAIRY},
+		Nutrition:   nutrition{Calories: 90, CaffeineMg: 128},
+		Modifiers:   []string{"oat-milk", "almond-milk", "soy-milk", "extra-shot"},
+		Tags:        []string{"hot", "coffee", "milk", "small"},
+		Recipe: []RecipeStage{
+			{Stage: "GRINDING", TargetSeconds: 20, Parameters: map[string]string{"grind_size": "fine", "dose_g": "18"}},
+			{Stage: "BREWING", TargetSeconds: 28, Parameters: map[string]string{"shot_yield_g": "36"}},
+			{Stage: "FROTHING", TargetSeconds: 30, Parameters: map[string]string{"milk_ml": "60", "milk_temp_c": "60", "foam": "none"}},
+		},
+		Ingredients: map[string]int64{"coffee_beans": 18, "whole_milk": 60, "cup": 1},
+	},
+	{
+		ID:          "ice-latte",
+		Name:        "Ice Latte",
+		Description: "Espresso with cold milk and ice",
+		Price:       3.75,
+		Allergens:   []menupb.Allergen{menupb.Allergen_DAIRY},
+		Nutrition:   nutrition{Calories: 130, CaffeineMg: 128},
+		Modifiers:   []string{"oat-milk", "almond-milk", "soy-milk", "extra-shot", "vanilla-syrup"},
+		Tags:        []string{"cold", "iced", "coffee", "milk"},
+		Recipe: []RecipeStage{
+			{Stage: "GRINDING", TargetSeconds: 20, Parameters: map[string]string{"grind_size": "fine", "dose_g": "18"}},
+			{Stage: "BREWING", TargetSeconds: 28, Parameters: map[string]string{"shot_yield_g": "36", "serve": "over ice with 180 ml cold milk"}},
+		},
+		Ingredients: map[string]int64{"coffee_beans": 18, "whole_milk": 180, "cup": 1},
+	},
+}
+
+var modifiers = map[string]catalogModifier{
+	"oat-milk": {
+		ID:               "oat-milk",
+		Name:             "Oat milk",
+		Price:            0.60,
+		RemovesAllergens: []menupb.Allergen{menupb.Allergen_DAIRY},
+		AddsAllergens:    []menupb.Allergen{menupb.Allergen_GLUTEN},
+		SwapFrom:         "whole_milk",
+		SwapTo:           "oat_milk",
+		NutritionDelta:   nutrition{Calories: -20},
+	},
+	"almond-milk": {
+		ID:               "almond-milk",
+		Name:             "Almond milk",
+		Price:            0.60,
+		RemovesAllergens: []menupb.Allergen{menupb.Allergen_DAIRY},
+		AddsAllergens:    []menupb.Allergen{menupb.Allergen_NUTS},
+		SwapFrom:         "whole_milk",
+		SwapTo:           "almond_milk",
+		NutritionDelta:   nutrition{Calories: -60},
+	},
+	"soy-milk": {
+		ID:               "soy-milk",
+		Name:             "Soy milk",
+		Price:            0.60,
+		RemovesAllergens: []menupb.Allergen{menupb.Allergen_DAIRY},
+		AddsAllergens:    []menupb.Allergen{menupb.Allergen_SOY},
+		SwapFrom:         "whole_milk",
+		SwapTo:           "soy_milk",
+		NutritionDelta:   nutrition{Calories: -30},
+	},
+	"extra-shot": {
+		ID:             "extra-shot",
+		Name:           "Extra shot",
+		Price:          0.80,
+		NutritionDelta: nutrition{Calories: 3, CaffeineMg: 64},
+		Ingredients:    map[string]int64{"coffee_beans": 18},
+	},
+	"vanilla-syrup": {
+		ID:             "vanilla-syrup",
+		Name:           "Vanilla syrup",
+		Price:          0.50,
+		NutritionDelta: nutrition{Calories: 35},
+		Ingredients:    map[string]int64{"vanilla_syrup": 15},
+	},
+}
+
+// LookupName resolves name to the default-locale name of a catalog item.
+// It accepts the item ID or its name in any supported locale, ignoring case
+// and diacritics.
+func LookupName(name string) (string, bool) {
+	want := normalize(name)
+	for _, item := range catalog {
+		if want == normalize(item.ID) || want == normalize(item.Name) {
+			return item.Name, true
+		}
+		for locale := range translations {
+			translated, _ := localize(item.ID, item.Name, "", locale)
+			if want == normalize(translated) {
+				return item.Name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Recipe returns the brew stages of the item called name, which must be a
+// name returned by LookupName.
+func Recipe(name string) ([]RecipeStage, bool) {
+	item, ok := findItem(name)
+	if !ok {
+		return nil, false
+	}
+	return item.Recipe, true
+}
+
+// ValidateModifiers checks that every modifier ID exists, applies to the item
+// called name and is not repeated, and that at most one of them swaps the
+// same ingredient.
+func ValidateModifiers(name string, ids []string) error {
+	item, ok := findItem(name)
+	if !ok {
+		return fmt.Errorf("unknown menu item %q", name)
+	}
+
+	swapped := make(map[string]string)
+	for i, id := range ids {
+		mod, ok := modifiers[id]
+		if !ok || !slices.Contains(item.Modifiers, id) {
+			return fmt.Errorf("modifier %q is not available for %s", id, item.Name)
+		}
+		if slices.Contains(ids[:i], id) {
+			return fmt.Errorf("modifier %q is repeated", id)
+		}
+		if mod.SwapFrom == "" {
+			continue
+		}
+		if other, ok := swapped[mod.SwapFrom]; ok {
+			return fmt.Errorf("modifiers %q and %q cannot be combined", other, id)
+		}
+		swapped[mod.SwapFrom] = id
+	}
+	return nil
+}
+
+// Price returns the price of one item called name.
+func Price(name string) (float64, bool) {
+	item, ok := findItem(name)
+	return item.Price, ok
+}
+
+// Tags returns the search tags of the item called name, such as "cold".
+func Tags(name string) []string {
+	item, _ := findItem(name)
+	return item.Tags
+}
+
+// ModifierName returns the default-locale name of a modifier.
+func ModifierName(id string) string {
+	return modifiers[id].Name
+}
+
+// ModifierPrice returns the surcharge a modifier adds to one drink.
+func ModifierPrice(id string) float64 {
+	return modifiers[id].Price
+}
+
+// Consumption returns the ingredients used by quantity items called name with
+// the given (already validated) modifiers, keyed by ingredient code.
+func Consumption(name string, modifierIDs []string, quantity int) map[string]int64 {
+	item, _ := findItem(name)
+
+	usage := make(map[string]int64)
+	for code, qty := range item.Ingredients {
+		usage[code] += qty
+	}
+	for _, id := range modifierIDs {
+		mod := modifiers[id]
+		if qty, ok := usage[mod.SwapFrom]; ok && mod.SwapTo != "" {
+			delete(usage, mod.SwapFrom)
+			usage[mod.SwapTo] += qty
+		}
+		for code, qty := range mod.Ingredients {
+			usage[code] += qty
+		}
+	}
+	for code := range usage {
+		usage[code] *= int64(quantity)
+	}
+	return usage
+}
+
+func findItem(name string) (catalogItem, bool) {
+	for _, item := range catalog {
+		if item.Name == name {
+			return item, true
+		}
+	}
+	return catalogItem{}, false
+}
+
+// Suggest returns up to limit item names that look like what the caller
+// meant to type, best match first.
+func Suggest(name string, limit int) []string {
+	var names []string
+	for _, m := range search(name) {
+		if len(names) == limit {
+			break
+		}
+		names = append(names, m.item.Name)
+	}
+	return names
+}
+
+// applyModifier returns the allergens and nutrition of item with mod applied.
+func applyModifier(item catalogItem, mod catalogModifier) ([]menupb.Allergen, nutrition) {
+	var allergens []menupb.Allergen
+	for _, a := range item.Allergens {
+		if !slices.Contains(mod.RemovesAllergens, a) {
+			allergens = append(allergens, a)
+		}
+	}
+	for _, a := range mod.AddsAllergens {
+		if !slices.Contains(allergens, a) {
+			allergens = append(allergens, a)
+		}
+	}
+	return allergens, item.Nutrition.add(mod.NutritionDelta)
+}
+
+// hasAnyAllergen reports whether list contains one of excluded.
+func hasAnyAllergen(list, excluded []menupb.Allergen) bool {
+	return slices.ContainsFunc(excluded, func(a menupb.Allergen) bool {
+		return slices.Contains(list, a)
+	})
+}
@@ -0,0 +1,135 @@
+package menus
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	menupb "github.com/jany/my-coffee/gen/proto/menu"
+)
+
+func TestApplyModifier(t *testing.T) {
+	latte, _ := findItem("Latte")
+	tests := []struct {
+		name          string
+		modifier      string
+		wantAllergens []menupb.Allergen
+		wantNutrition nutrition
+	}{
+		{name: "oat milk swaps dairy for gluten", modifier: "oat-milk", wantAllergens: []menupb.Allergen{menupb.Allergen_GLUTEN}, wantNutrition: nutrition{Calories: 170, CaffeineMg: 128}},
+		{name: "almond milk", modifier: "almond-milk", wantAllergens: []menupb.Allergen{menupb.Allergen_NUTS}, wantNutrition: nutrition{Calories: 130, CaffeineMg: 128}},
+		{name: "extra shot keeps dairy", modifier: "extra-shot", wantAllergens: []menupb.Allergen{menupb.Allergen_DAIRY}, wantNutrition: nutrition{Calories: 193, CaffeineMg: 192}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allergens, n := applyModifier(latte, modifiers[tt.modifier])
+			if !slices.Equal(allergens, tt.wantAllergens) {
+				t.Errorf("allergens = %v, want %v", allergens, tt.wantAllergens)
+			}
+			if n != tt.wantNutrition {
+				t.Errorf("nutrition = %+v, want %+v", n, tt.wantNutrition)
+			}
+		})
+	}
+}
+
+func TestNutritionAddNeverNegative(t *testing.T) {
+	espresso, _ := findItem("Espresso")
+	if got := espresso.Nutrition.add(nutrition{Calories: -20}); got.Calories != 0 {
+		t.Errorf("Calories = %d, want 0", got.Calories)
+	}
+}
+
+func TestHasAnyAllergen(t *testing.T) {
+	dairy := []menupb.Allergen{menupb.Allergen_DAIRY}
+	if !hasAnyAllergen(dairy, []menupb.Allergen{menupb.Allergen_NUTS, menupb.Allergen_DAIRY}) {
+		t.Error("dairy drink not excluded by dairy")
+	}
+	if hasAnyAllergen(dairy, []menupb.Allergen{menupb.Allergen_NUTS}) {
+		t.Error("dairy drink excluded by nuts")
+	}
+	if hasAnyAllergen(dairy, nil) {
+		t.Error("dairy drink excluded by nothing")
+	}
+}
+
+func TestToMenuItemExclude(t *testing.T) {
+	latte, _ := findItem("Latte")
+	espresso, _ := findItem("Espresso")
+
+	t.Run("no exclusions", func(t *testing.T) {
+		item, ok := toMenuItem(latte, "en", nil, nil)
+		if !ok {
+			t.Fatal("latte dropped")
+		}
+		if len(item.Modifiers) != len(latte.Modifiers) {
+			t.Errorf("got %d modifiers, want %d", len(item.Modifiers), len(latte.Modifiers))
+		}
+	})
+
+	t.Run("dairy keeps the milk swaps", func(t *testing.T) {
+		item, ok := toMenuItem(latte, "en", []menupb.Allergen{menupb.Allergen_DAIRY}, nil)
+		if !ok {
+			t.Fatal("latte dropped although it can be made with plant milk")
+		}
+		var ids []string
+		for _, mod := range item.Modifiers {
+			ids = append(ids, mod.Id)
+		}
+		if want := []string{"oat-milk", "almond-milk", "soy-milk"}; !slices.Equal(ids, want) {
+			t.Errorf("modifiers = %v, want %v", ids, want)
+		}
+	})
+
+	t.Run("every milk excluded", func(t *testing.T) {
+		exclude := []menupb.Allergen{menupb.Allergen_DAIRY, menupb.Allergen_GLUTEN, menupb.Allergen_NUTS, menupb.Allergen_SOY}
+		if _, ok := toMenuItem(latte, "en", exclude, nil); ok {
+			t.Error("latte kept although no milk is safe")
+		}
+		if _, ok := toMenuItem(espresso, "en", exclude, nil); !ok {
+			t.Error("espresso dropped although it has no allergens")
+		}
+	})
+}
+
+func TestConsumption(t *testing.T) {
+	tests := []struct {
+		name      string
+		item      string
+		modifiers []string
+		quantity  int
+		want      map[string]int64
+	}{
+		{name: "plain", item: "Latte", quantity: 1, want: map[string]int64{"coffee_beans": 18, "whole_milk": 200, "cup": 1}},
+		{name: "milk swap", item: "Latte", modifiers: []string{"oat-milk"}, quantity: 1, want: map[string]int64{"coffee_beans": 18, "oat_milk": 200, "cup": 1}},
+		{name: "extras", item: "Latte", modifiers: []string{"extra-shot", "vanilla-syrup"}, quantity: 1, want: map[string]int64{"coffee_beans": 36, "whole_milk": 200, "vanilla_syrup": 15, "cup": 1}},
+		{name: "quantity", item: "Espresso", modifiers: []string{"extra-shot"}, quantity: 3, want: map[string]int64{"coffee_beans": 108, "cup": 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Consumption(tt.item, tt.modifiers, tt.quantity); !maps.Equal(got, tt.want) {
+				t.Errorf("Consumption() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToMenuItemSoldOut(t *testing.T) {
+	latte, _ := findItem("Latte")
+
+	item, _ := toMenuItem(latte, "en", nil, []string{"oat_milk", "vanilla_syrup"})
+	if !item.Available {
+		t.Error("latte unavailable although its own ingredients are in stock")
+	}
+	for _, mod := range item.Modifiers {
+		want := mod.Id != "oat-milk" && mod.Id != "vanilla-syrup"
+		if mod.Available != want {
+			t.Errorf("modifier %s Available = %v, want %v", mod.Id, mod.Available, want)
+		}
+	}
+
+	item, _ = toMenuItem(latte, "en", nil, []string{"whole_milk"})
+	if item.Available {
+		t.Error("latte available although whole milk is sold out")
+	}
+}
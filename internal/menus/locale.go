@@ -0,0 +1,73 @@
+package menus
+
+import "golang.org/x/text/language"
+
+// DefaultLocale is used when the client asks for nothing we support.
+const DefaultLocale = "en"
+
+type translation struct {
+	Name        string
+	Description string
+}
+
+// translations maps a locale to the translated strings of each catalog item
+// and modifier, keyed by ID. Missing entries fall back to the default locale.
+var translations = map[string]map[string]translation{
+	"vi": {
+		"espresso": {
+			Name:        "Cà phê Espresso",
+			Description: "Cà phê kiểu Ý đậm đặc và thơm nồng",
+		},
+		"latte": {
+			Name:        "Latte",
+			Description: "Espresso với sữa nóng và một lớp bọt sữa mỏng",
+		},
+		"cortado": {
+			Name:        "Cortado",
+			Description: "Espresso và sữa nóng với tỉ lệ bằng nhau",
+		},
+		"ice-latte": {
+			Name:        "Latte đá",
+			Description: "Espresso với sữa tươi lạnh và đá",
+		},
+		"oat-milk":      {Name: "Sữa yến mạch"},
+		"almond-milk":   {Name: "Sữa hạnh nhân"},
+		"soy-milk":      {Name: "Sữa đậu nành"},
+		"extra-shot":    {Name: "Thêm một shot espresso"},
+		"vanilla-syrup": {Name: "Siro vani"},
+	},
+}
+
+// The first tag is the fallback returned by the matcher.
+var matcher = language.NewMatcher([]language.Tag{
+	language.English,
+	language.Vietnamese,
+})
+
+// resolveLocale picks the best supported locale. The explicit request field
+// wins over the Accept-Language header.
+func resolveLocale(requested, acceptLanguage string) string {
+	tag, _ := language.MatchStrings(matcher, requested, acceptLanguage)
+	base, _ := tag.Base()
+	if _, ok := translations[base.String()]; ok {
+		return base.String()
+	}
+	return DefaultLocale
+}
+
+// localize returns the translated name and description for id, falling back
+// to the default-locale strings passed in.
+func localize(id, name, description, locale string) (string, string) {
+	t, ok := translations[locale][id]
+	if !ok {
+		return name, description
+	}
+
+	if t.Name != "" {
+		name = t.Name
+	}
+	if t.Description != "" {
+		description = t.Description
+	}
+	return name, description
+}
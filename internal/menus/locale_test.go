@@ -0,0 +1,73 @@
+package menus
+
+import "testing"
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		requested      string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "nothing asked", want: "en"},
+		{name: "requested", requested: "vi", want: "vi"},
+		{name: "requested with region", requested: "vi-VN", want: "vi"},
+		{name: "header", acceptLanguage: "vi-VN,vi;q=0.9,en;q=0.8", want: "vi"},
+		{name: "request beats header", requested: "en", acceptLanguage: "vi", want: "en"},
+		{name: "unsupported", requested: "fr", acceptLanguage: "de-DE", want: "en"},
+		{name: "malformed header", acceptLanguage: ";;;", want: "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveLocale(tt.requested, tt.acceptLanguage); got != tt.want {
+				t.Errorf("resolveLocale(%q, %q) = %q, want %q", tt.requested, tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	tests := []struct {
+		name            string
+		id              string
+		locale          string
+		wantName        string
+		wantDescription string
+	}{
+		{name: "default locale", id: "espresso", locale: "en", wantName: "Espresso", wantDescription: "default"},
+		{name: "translated", id: "espresso", locale: "vi", wantName: "Cà phê Espresso", wantDescription: "Cà phê kiểu Ý đậm đặc và thơm nồng"},
+		{name: "name only", id: "oat-milk", locale: "vi", wantName: "Sữa yến mạch", wantDescription: "default"},
+		{name: "missing id", id: "matcha", locale: "vi", wantName: "Espresso", wantDescription: "default"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, description := localize(tt.id, "Espresso", "default", tt.locale)
+			if name != tt.wantName || description != tt.wantDescription {
+				t.Errorf("localize() = %q, %q; want %q, %q", name, description, tt.wantName, tt.wantDescription)
+			}
+		})
+	}
+}
+
+func TestLookupName(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{name: "Latte", want: "Latte", wantOK: true},
+		{name: "ice-latte", want: "Ice Latte", wantOK: true},
+		{name: "  ICE LATTE ", want: "Ice Latte", wantOK: true},
+		{name: "Latte đá", want: "Ice Latte", wantOK: true},
+		{name: "ca phe espresso", want: "Espresso", wantOK: true},
+		{name: "Mocha", wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupName(tt.name)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("LookupName(%q) = %q, %v; want %q, %v", tt.name, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -2,46 +2,168 @@ package menus
 
 import (
 	"context"
+	"log"
+	"net/http"
+	"slices"
 
 	"connectrpc.com/connect"
 	menupb "github.com/jany/my-coffee/gen/proto/menu"
 	"github.com/jany/my-coffee/gen/proto/menu/menuconnect"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
 )
 
 // Compile-time check that Server implements the Connect RPC handler interface.
 var _ menuconnect.MenuServiceHandler = (*Server)(nil)
 
-type Server struct{}
+const defaultSearchLimit = 5
 
-func New() *Server {
-	return &Server{}
+type Server struct {
+	inventoryRepo *repository.InventoryRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		inventoryRepo: repository.NewInventoryRepository(db),
+	}
 }
 
 func (s *Server) GetMenu(ctx context.Context, req *connect.Request[menupb.GetMenuRequest]) (*connect.Response[menupb.GetMenuResponse], error) {
-	items := []*menupb.MenuItem{
-		{
-			Name:        "Espresso",
-			Description: "Strong and rich Italian-style coffee",
-			Price:       2.50,
-		},
-		{
-			Name:        "Latte",
-			Description: "Espresso with steamed milk and a light layer of foam",
-			Price:       3.50,
-		},
-		{
-			Name:        "Cortado",
-			Description: "Equal parts espresso and steamed milk",
-			Price:       3.25,
-		},
-		{
-			Name:        "Ice Latte",
-			Description: "Espresso with cold milk and ice",
-			Price:       3.75,
-		},
-	}
-
-	return connect.NewResponse(&menupb.GetMenuResponse{
-		Items: items,
-	}), nil
-}
\ No newline at end of file
+	locale := resolveLocale(req.Msg.Language, req.Header().Get("Accept-Language"))
+	soldOut := s.soldOut()
+
+	var items []*menupb.MenuItem
+	for _, item := range catalog {
+		if pb, ok := toMenuItem(item, locale, req.Msg.ExcludeAllergens, soldOut); ok {
+			items = append(items, pb)
+		}
+	}
+
+	resp := connect.NewResponse(&menupb.GetMenuResponse{
+		Items:    items,
+		Language: locale,
+	})
+	resp.Header().Set("Content-Language", locale)
+	if req.HTTPMethod() == http.MethodGet {
+		setCacheHeaders(resp.Header(), menuETag(locale, req.Msg.ExcludeAllergens, soldOut))
+	}
+	return resp, nil
+}
+
+func (s *Server) SearchMenu(ctx context.Context, req *connect.Request[menupb.SearchMenuRequest]) (*connect.Response[menupb.SearchMenuResponse], error) {
+	locale := resolveLocale(req.Msg.Language, req.Header().Get("Accept-Language"))
+
+	soldOut := s.soldOut()
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	var results []*menupb.SearchResult
+	for _, m := range search(req.Msg.Query) {
+		if len(results) == limit {
+			break
+		}
+		item, _ := toMenuItem(m.item, locale, nil, soldOut)
+		results = append(results, &menupb.SearchResult{
+			Item:  item,
+			Score: m.score,
+		})
+	}
+
+	resp := connect.NewResponse(&menupb.SearchMenuResponse{
+		Results: results,
+	})
+	resp.Header().Set("Content-Language", locale)
+	return resp, nil
+}
+
+// soldOut returns the codes of ingredients that have run out. The menu is
+// still served when the stock cannot be read; ordering checks it again.
+func (s *Server) soldOut() []string {
+	codes, err := s.inventoryRepo.OutOfStockCodes()
+	if err != nil {
+		log.Printf("Failed to read stock levels: %v", err)
+		return nil
+	}
+	return codes
+}
+
+// toMenuItem converts a catalog item to its proto form. Modifiers that would
+// leave one of the excluded allergens in the drink are dropped, and the item
+// itself is dropped unless its base recipe or a remaining modifier is safe.
+// Items and modifiers that use a sold-out ingredient are marked unavailable.
+func toMenuItem(item catalogItem, locale string, exclude []menupb.Allergen, soldOut []string) (*menupb.MenuItem, bool) {
+	safe := !hasAnyAllergen(item.Allergens, exclude)
+
+	var mods []*menupb.Modifier
+	for _, id := range item.Modifiers {
+		mod := modifiers[id]
+		allergens, n := applyModifier(item, mod)
+		if hasAnyAllergen(allergens, exclude) {
+			continue
+		}
+		safe = true
+
+		name, _ := localize(mod.ID, mod.Name, "", locale)
+		mods = append(mods, &menupb.Modifier{
+			Id:        mod.ID,
+			Name:      name,
+			Price:     mod.Price,
+			Allergens: allergens,
+			Nutrition: toNutrition(n),
+			Available: inStock(soldOut, mod.SwapTo, mod.Ingredients),
+		})
+	}
+
+	if !safe {
+		return nil, false
+	}
+
+	name, description := localize(item.ID, item.Name, item.Description, locale)
+	return &menupb.MenuItem{
+		Id:          item.ID,
+		Name:        name,
+		Description: description,
+		Price:       item.Price,
+		Allergens:   item.Allergens,
+		Nutrition:   toNutrition(item.Nutrition),
+		Modifiers:   mods,
+		Tags:        item.Tags,
+		Recipe:      toRecipe(item.Recipe),
+		Available:   inStock(soldOut, "", item.Ingredients),
+	}, true
+}
+
+func toRecipe(stages []RecipeStage) []*menupb.RecipeStage {
+	var recipe []*menupb.RecipeStage
+	for _, stage := range stages {
+		recipe = append(recipe, &menupb.RecipeStage{
+			Stage:         stage.Stage,
+			TargetSeconds: stage.TargetSeconds,
+			Parameters:    stage.Parameters,
+		})
+	}
+	return recipe
+}
+
+// inStock reports whether code and every ingredient in usage are in stock.
+func inStock(soldOut []string, code string, usage map[string]int64) bool {
+	if code != "" && slices.Contains(soldOut, code) {
+		return false
+	}
+	for c := range usage {
+		if slices.Contains(soldOut, c) {
+			return false
+		}
+	}
+	return true
+}
+
+func toNutrition(n nutrition) *menupb.Nutrition {
+	return &menupb.Nutrition{
+		Calories:   n.Calories,
+		CaffeineMg: n.CaffeineMg,
+	}
+}
@@ -0,0 +1,153 @@
+package menus
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Field weights: a hit on the name counts more than one on a tag, which
+// counts more than one buried in the description.
+const (
+	nameWeight        = 1.0
+	tagWeight         = 0.8
+	descriptionWeight = 0.5
+
+	// minScore filters out matches that are mostly noise.
+	minScore = 0.5
+)
+
+type match struct {
+	item  catalogItem
+	score float64
+}
+
+// search ranks catalog items against query. Names and descriptions are
+// matched in every locale so a customer can type in either language.
+func search(query string) []match {
+	terms := tokenize(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var matches []match
+	for _, item := range catalog {
+		if score := scoreItem(item, normalize(query), terms); score >= minScore {
+			matches = append(matches, match{item: item, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	return matches
+}
+
+func scoreItem(item catalogItem, query string, terms []string) float64 {
+	var names, descriptions []string
+	names = append(names, item.Name)
+	descriptions = append(descriptions, item.Description)
+	for locale := range translations {
+		name, description := localize(item.ID, item.Name, item.Description, locale)
+		names = append(names, name)
+		descriptions = append(descriptions, description)
+	}
+
+	// A close match on the whole name beats matching word by word, so
+	// "icelatte" still finds "Ice Latte".
+	best := 0.0
+	for _, name := range names {
+		best = max(best, similarity(query, strings.Join(tokenize(name), " ")))
+	}
+
+	fields := []struct {
+		words  []string
+		weight float64
+	}{
+		{tokenize(strings.Join(names, " ")), nameWeight},
+		{item.Tags, tagWeight},
+		{tokenize(strings.Join(descriptions, " ")), descriptionWeight},
+	}
+
+	total := 0.0
+	for _, term := range terms {
+		termBest := 0.0
+		for _, field := range fields {
+			for _, word := range field.words {
+				termBest = max(termBest, similarity(term, word)*field.weight)
+			}
+		}
+		total += termBest
+	}
+
+	return max(best, total/float64(len(terms)))
+}
+
+// similarity turns the edit distance between a and b into a score between 0
+// and 1. A query that is a prefix of the word scores high so that partial
+// input like "cort" already finds "Cortado".
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if len(a) >= 3 && strings.HasPrefix(b, a) {
+		return 0.9
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 0
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(longest)
+}
+
+// editDistance is the optimal string alignment distance: insertions,
+// deletions, substitutions and swaps of adjacent letters each cost one,
+// which covers the usual typos ("latet", "expresso").
+func editDistance(a, b []rune) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// normalize lowercases s and strips diacritics so "Cà phê" matches "ca phe".
+func normalize(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	out, _, err := transform.String(t, s)
+	if err != nil {
+		out = s
+	}
+	// đ has no combining form to strip.
+	out = strings.NewReplacer("đ", "d", "Đ", "D").Replace(out)
+	return strings.ToLower(strings.TrimSpace(out))
+}
+
+func tokenize(s string) []string {
+	return strings.FieldsFunc(normalize(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
@@ -0,0 +1,78 @@
+package menus
+
+import "testing"
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"latte", "latte", 0},
+		{"latet", "latte", 1},
+		{"expresso", "espresso", 1},
+		{"", "mocha", 5},
+		{"cortado", "cortad", 1},
+		{"latte", "mocha", 5},
+	}
+	for _, tt := range tests {
+		if got := editDistance([]rune(tt.a), []rune(tt.b)); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"latte", "latte", 1},
+		{"cort", "cortado", 0.9},
+		{"latet", "latte", 0.8},
+		{"", "", 1},
+	}
+	for _, tt := range tests {
+		if got := similarity(tt.a, tt.b); got != tt.want {
+			t.Errorf("similarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{query: "latet", want: "Latte"},
+		{query: "expresso", want: "Espresso"},
+		{query: "icelatte", want: "Ice Latte"},
+		{query: "cort", want: "Cortado"},
+		{query: "iced", want: "Ice Latte"},
+		{query: "latte da", want: "Ice Latte"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			matches := search(tt.query)
+			if len(matches) == 0 {
+				t.Fatalf("search(%q) found nothing", tt.query)
+			}
+			if got := matches[0].item.Name; got != tt.want {
+				t.Errorf("search(%q) best match = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchNoMatch(t *testing.T) {
+	for _, query := range []string{"", "  ", "pizza"} {
+		if matches := search(query); len(matches) != 0 {
+			t.Errorf("search(%q) = %d matches, want none", query, len(matches))
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	if got := Suggest("latte", 2); len(got) != 2 || got[0] != "Latte" {
+		t.Errorf("Suggest(latte, 2) = %v, want Latte first of 2", got)
+	}
+}
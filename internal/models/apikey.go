@@ -0,0 +1,32 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// APIKey is a long-lived credential for a device or service. Only the
+// hash of the key is stored.
+type APIKey struct {
+	ID      uint   `gorm:"primaryKey"`
+	Name    string `gorm:"not null"`
+	Prefix  string `gorm:"not null"`
+	KeyHash string `gorm:"uniqueIndex;not null"`
+	// Scopes are the roles the key acts with, comma separated.
+	Scopes     string `gorm:"not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+func (k *APIKey) ScopeList() []string {
+	if k.Scopes == "" {
+		return nil
+	}
+	return strings.Split(k.Scopes, ",")
+}
@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+type BusinessDayStatus string
+
+const (
+	BusinessDayOpen   BusinessDayStatus = "OPEN"
+	BusinessDayClosed BusinessDayStatus = "CLOSED"
+)
+
+type BusinessDayAction string
+
+const (
+	BusinessDayClose  BusinessDayAction = "CLOSE"
+	BusinessDayReopen BusinessDayAction = "REOPEN"
+)
+
+// BusinessDay is a day that has been closed at least once. While it is
+// CLOSED, orders created between StartsAt and EndsAt cannot change.
+type BusinessDay struct {
+	BusinessDate time.Time         `gorm:"primaryKey;type:date"`
+	Status       BusinessDayStatus `gorm:"not null"`
+	StartsAt     time.Time         `gorm:"not null"`
+	EndsAt       time.Time         `gorm:"not null"`
+	// ZReportID is the report of the latest close.
+	ZReportID *uint
+	UpdatedAt time.Time
+}
+
+func (BusinessDay) TableName() string {
+	return "business_days"
+}
+
+// ZReport totals a closed business day. Reports are never changed.
+type ZReport struct {
+	ID              uint            `gorm:"primaryKey"`
+	BusinessDate    time.Time       `gorm:"type:date;not null"`
+	Orders          int64           `gorm:"not null"`
+	Drinks          int64           `gorm:"not null"`
+	SubtotalCents   int64           `gorm:"not null"`
+	DiscountCents   int64           `gorm:"not null"`
+	TaxCents        int64           `gorm:"not null"`
+	TotalCents      int64           `gorm:"not null"`
+	CancelledOrders int64           `gorm:"not null"`
+	CancelledCents  int64           `gorm:"not null"`
+	Refunds         int64           `gorm:"not null"`
+	RefundedCents   int64           `gorm:"not null"`
+	Currency        string          `gorm:"not null"`
+	ClosedBy        string          `gorm:"not null"`
+	Tenders         []ZReportTender `gorm:"foreignKey:ZReportID"`
+	CreatedAt       time.Time
+}
+
+func (ZReport) TableName() string {
+	return "z_reports"
+}
+
+// ZReportTender is the money taken and given back with one payment method.
+type ZReportTender struct {
+	ID            uint   `gorm:"primaryKey"`
+	ZReportID     uint   `gorm:"not null"`
+	Method        string `gorm:"not null"`
+	Payments      int64  `gorm:"not null"`
+	AmountCents   int64  `gorm:"not null"`
+	RefundedCents int64  `gorm:"not null"`
+}
+
+func (ZReportTender) TableName() string {
+	return "z_report_tenders"
+}
+
+// BusinessDayEvent is the audit trail of closing and reopening days.
+type BusinessDayEvent struct {
+	ID           uint              `gorm:"primaryKey"`
+	BusinessDate time.Time         `gorm:"type:date;not null"`
+	Action       BusinessDayAction `gorm:"not null"`
+	ZReportID    *uint
+	Operator     string `gorm:"not null"`
+	Reason       string
+	CreatedAt    time.Time
+}
+
+func (BusinessDayEvent) TableName() string {
+	return "business_day_events"
+}
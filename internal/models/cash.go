@@ -0,0 +1,72 @@
+package models
+
+import "time"
+
+type ShiftStatus string
+
+const (
+	ShiftOpen   ShiftStatus = "OPEN"
+	ShiftClosed ShiftStatus = "CLOSED"
+)
+
+type CashMovementKind string
+
+const (
+	CashSale   CashMovementKind = "SALE"
+	CashRefund CashMovementKind = "REFUND"
+	// CashPaidIn and CashPaidOut are cash added to or taken from the
+	// drawer outside of sales, such as change from the bank or paying a
+	// delivery.
+	CashPaidIn  CashMovementKind = "PAID_IN"
+	CashPaidOut CashMovementKind = "PAID_OUT"
+)
+
+// CashDrawer is the till of one register.
+type CashDrawer struct {
+	ID        uint   `gorm:"primaryKey"`
+	Register  string `gorm:"uniqueIndex;not null"`
+	Name      string `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (CashDrawer) TableName() string {
+	return "cash_drawers"
+}
+
+// CashShift is the time between counting a drawer in and counting it out.
+// ExpectedCents and CountedCents are set when the shift is closed.
+type CashShift struct {
+	ID                uint       `gorm:"primaryKey"`
+	DrawerID          uint       `gorm:"not null"`
+	Drawer            CashDrawer `gorm:"foreignKey:DrawerID"`
+	Status            ShiftStatus
+	OpenedBy          string `gorm:"not null"`
+	OpeningFloatCents int64  `gorm:"not null"`
+	OpenedAt          time.Time
+	ClosedBy          string
+	ClosedAt          *time.Time
+	ExpectedCents     *int64
+	CountedCents      *int64
+	Note              string
+}
+
+func (CashShift) TableName() string {
+	return "cash_shifts"
+}
+
+// CashMovement is cash going into (positive AmountCents) or out of a drawer.
+type CashMovement struct {
+	ID          uint             `gorm:"primaryKey"`
+	ShiftID     uint             `gorm:"not null"`
+	Kind        CashMovementKind `gorm:"not null"`
+	AmountCents int64            `gorm:"not null"`
+	OrderID     *uint
+	PaymentID   *uint
+	Reason      string
+	Operator    string
+	CreatedAt   time.Time
+}
+
+func (CashMovement) TableName() string {
+	return "cash_movements"
+}
@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// Customer is an account that signs in with an email and password. Its
+// orders carry the customer's token subject as CustomerID.
+type Customer struct {
+	ID           uint   `gorm:"primaryKey"`
+	Email        string `gorm:"uniqueIndex;not null"`
+	Name         string `gorm:"not null"`
+	PasswordHash string `gorm:"not null"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (Customer) TableName() string {
+	return "customers"
+}
+
+// RefreshToken lets a customer get a new access token without their
+// password. Only the hash of the token is stored.
+type RefreshToken struct {
+	ID         uint      `gorm:"primaryKey"`
+	CustomerID uint      `gorm:"not null;index"`
+	TokenHash  string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+type GiftCardTransactionKind string
+
+const (
+	GiftCardIssue  GiftCardTransactionKind = "ISSUE"
+	GiftCardRedeem GiftCardTransactionKind = "REDEEM"
+	// GiftCardRefund puts money from a refunded order back on the card.
+	GiftCardRefund GiftCardTransactionKind = "REFUND"
+)
+
+// GiftCard is a stored-value card. BalanceCents is a cache of the card's
+// ledger account, kept in step by every transaction.
+type GiftCard struct {
+	ID           uint   `gorm:"primaryKey"`
+	Code         string `gorm:"uniqueIndex;not null"`
+	Currency     string `gorm:"not null"`
+	BalanceCents int64  `gorm:"not null"`
+	Active       bool   `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (GiftCard) TableName() string {
+	return "gift_cards"
+}
+
+// GiftCardTransaction moves AmountCents from DebitAccount to CreditAccount.
+// Rows are never updated or deleted.
+type GiftCardTransaction struct {
+	ID            uint                    `gorm:"primaryKey"`
+	GiftCardID    uint                    `gorm:"not null"`
+	Kind          GiftCardTransactionKind `gorm:"not null"`
+	AmountCents   int64                   `gorm:"not null"`
+	DebitAccount  string                  `gorm:"not null"`
+	CreditAccount string                  `gorm:"not null"`
+	OrderID       *uint
+	CreatedAt     time.Time
+}
+
+func (GiftCardTransaction) TableName() string {
+	return "gift_card_transactions"
+}
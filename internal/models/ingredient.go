@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Reasons recorded on inventory movements.
+const (
+	MovementOrder   = "order"
+	MovementRestock = "restock"
+)
+
+// Ingredient is something the shop keeps in stock. Stock is counted in Unit
+// (g, ml or pcs). An ingredient is low once Stock drops to ReorderThreshold;
+// it is bought from its supplier in multiples of PackSize.
+type Ingredient struct {
+	ID               uint   `gorm:"primaryKey"`
+	Code             string `gorm:"uniqueIndex;not null"`
+	Name             string `gorm:"not null"`
+	Unit             string `gorm:"not null"`
+	Stock            int64  `gorm:"not null;default:0"`
+	ReorderThreshold int64  `gorm:"not null;default:0"`
+	PackSize         int64  `gorm:"not null;default:1"`
+	SupplierID       *uint
+	Supplier         *Supplier
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsLow reports whether the ingredient should be reordered.
+func (i *Ingredient) IsLow() bool {
+	return i.Stock <= i.ReorderThreshold
+}
+
+func (Ingredient) TableName() string {
+	return "ingredients"
+}
+
+type Supplier struct {
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"uniqueIndex;not null"`
+	Email     string
+	Phone     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (Supplier) TableName() string {
+	return "suppliers"
+}
+
+// InventoryMovement records every change to an ingredient's stock, negative
+// when consumed by an order.
+type InventoryMovement struct {
+	ID           uint `gorm:"primaryKey"`
+	IngredientID uint `gorm:"not null"`
+	OrderID      *uint
+	Change       int64  `gorm:"not null"`
+	Reason       string `gorm:"not null"`
+	CreatedAt    time.Time
+}
+
+func (InventoryMovement) TableName() string {
+	return "inventory_movements"
+}
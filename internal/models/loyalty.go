@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+type LoyaltyReason string
+
+const (
+	// LoyaltyEarn adds a stamp for every paid drink of a READY order.
+	LoyaltyEarn LoyaltyReason = "EARN"
+	// LoyaltyRedeem spends stamps on a free drink.
+	LoyaltyRedeem LoyaltyReason = "REDEEM"
+	// LoyaltyReversal undoes what an order earned or redeemed when it is
+	// refunded or cancelled.
+	LoyaltyReversal LoyaltyReason = "REVERSAL"
+)
+
+// LoyaltyEntry is one line of a customer's stamp ledger. The balance is the
+// sum of Delta; entries are never updated or deleted.
+type LoyaltyEntry struct {
+	ID         uint   `gorm:"primaryKey"`
+	CustomerID string `gorm:"not null"`
+	OrderID    *uint
+	Delta      int           `gorm:"not null"`
+	Reason     LoyaltyReason `gorm:"not null"`
+	CreatedAt  time.Time
+}
+
+func (LoyaltyEntry) TableName() string {
+	return "loyalty_ledger"
+}
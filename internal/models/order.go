@@ -10,15 +10,73 @@ const (
 	StatusBrewing  OrderStatus = "BREWING"
 	StatusFrothing OrderStatus = "FROTHING"
 	StatusReady    OrderStatus = "READY"
+	// StatusPendingPayment holds an order until it is paid, when the shop
+	// requires payment before brewing.
+	StatusPendingPayment OrderStatus = "PENDING_PAYMENT"
+	StatusCancelled      OrderStatus = "CANCELLED"
 )
+
 type Order struct {
-	ID uint `gorm:"primaryKey"`
-	MenuItemName string `gorm:"not null"`
-	Status OrderStatus `gorm:"default:QUEUED"`
+	ID           uint            `gorm:"primaryKey"`
+	MenuItemName string          `gorm:"not null"`
+	Status       OrderStatus     `gorm:"default:QUEUED"`
+	Modifiers    []OrderModifier `gorm:"foreignKey:OrderID"`
+	Refunds      []Refund        `gorm:"foreignKey:OrderID"`
+	Discounts    []OrderDiscount `gorm:"foreignKey:OrderID"`
+	// StatusChanges is the order's history, oldest first. It is only
+	// loaded where needed.
+	StatusChanges []OrderStatusChange `gorm:"foreignKey:OrderID"`
+	CustomerID    string
+	CustomerName  string
+	PromoCode     string
+	PickupCode    string
+	// ReadyAt is set when the order becomes READY, PickedUpAt when the
+	// drink is handed over.
+	ReadyAt    *time.Time
+	PickedUpAt *time.Time
+	Quantity   int `gorm:"not null;default:1"`
+
+	// Price breakdown, fixed when the order is placed. TaxRate and
+	// TaxInclusive record the tax settings that were used.
+	UnitPriceCents int64
+	SubtotalCents  int64
+	DiscountCents  int64
+	TaxCents       int64
+	TotalCents     int64
+	TaxRate        float64
+	TaxInclusive   bool
+	Currency       string `gorm:"default:USD"`
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
 func (Order) TableName() string {
 	return "orders"
-}
\ No newline at end of file
+}
+
+// OrderModifier is a modifier applied to an order's drink. The name and the
+// per-drink surcharge are copied from the menu so they survive menu changes.
+type OrderModifier struct {
+	ID         uint   `gorm:"primaryKey"`
+	OrderID    uint   `gorm:"not null"`
+	ModifierID string `gorm:"not null"`
+	Name       string `gorm:"not null"`
+	PriceCents int64
+}
+
+func (OrderModifier) TableName() string {
+	return "order_modifiers"
+}
+
+// OrderStatusChange records that an order entered a status.
+type OrderStatusChange struct {
+	ID        uint        `gorm:"primaryKey"`
+	OrderID   uint        `gorm:"not null"`
+	Status    OrderStatus `gorm:"not null"`
+	CreatedAt time.Time
+}
+
+func (OrderStatusChange) TableName() string {
+	return "order_status_changes"
+}
@@ -0,0 +1,74 @@
+package models
+
+import "time"
+
+type PaymentStatus string
+
+const (
+	PaymentAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentCaptured   PaymentStatus = "CAPTURED"
+	PaymentDeclined   PaymentStatus = "DECLINED"
+	// PaymentRefunded means the whole amount has been given back.
+	PaymentRefunded PaymentStatus = "REFUNDED"
+)
+
+const (
+	PaymentMethodCard = "CARD"
+	// Gift card payments are captured when they are made; ProviderRef is
+	// the card code.
+	PaymentMethodGiftCard = "GIFT_CARD"
+	// Cash payments are captured when they are made; ProviderRef is the
+	// register that took the money.
+	PaymentMethodCash = "CASH"
+)
+
+// Payment is one attempt to pay for an order. Declined attempts are kept so
+// there is a record of every charge the processor saw.
+type Payment struct {
+	ID            uint   `gorm:"primaryKey"`
+	OrderID       uint   `gorm:"not null"`
+	Provider      string `gorm:"not null"`
+	ProviderRef   string
+	Method        string        `gorm:"not null;default:CARD"`
+	Status        PaymentStatus `gorm:"not null"`
+	AmountCents   int64         `gorm:"not null"`
+	CapturedCents int64
+	RefundedCents int64
+	Currency      string `gorm:"not null;default:USD"`
+	FailureReason string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// IsSettled reports whether the payment covers its order.
+func (p *Payment) IsSettled() bool {
+	return p.Status == PaymentAuthorized || p.Status == PaymentCaptured
+}
+
+// RefundableCents is what can still be given back.
+func (p *Payment) RefundableCents() int64 {
+	if !p.IsSettled() {
+		return 0
+	}
+	return p.AmountCents - p.RefundedCents
+}
+
+// Refund records money given back to a customer and who decided it.
+type Refund struct {
+	ID          uint   `gorm:"primaryKey"`
+	PaymentID   uint   `gorm:"not null"`
+	OrderID     uint   `gorm:"not null"`
+	AmountCents int64  `gorm:"not null"`
+	Reason      string `gorm:"not null"`
+	Operator    string `gorm:"not null"`
+	ProviderRef string
+	CreatedAt   time.Time
+}
+
+func (Refund) TableName() string {
+	return "refunds"
+}
@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+type PromoKind string
+
+const (
+	// PromoPercent takes Percent off the order.
+	PromoPercent PromoKind = "PERCENT"
+	// PromoFixed takes AmountCents off the order.
+	PromoFixed PromoKind = "FIXED"
+	// PromoBOGO makes every second drink free.
+	PromoBOGO PromoKind = "BOGO"
+)
+
+// PromoCode is a discount customers unlock by entering Code. When
+// MenuItemName is set it only applies to that item. Zero limits and nil
+// dates mean unlimited.
+type PromoCode struct {
+	ID                 uint      `gorm:"primaryKey"`
+	Code               string    `gorm:"uniqueIndex;not null"`
+	Description        string    `gorm:"not null"`
+	Kind               PromoKind `gorm:"not null"`
+	Percent            float64
+	AmountCents        int64
+	MenuItemName       string
+	StartsAt           *time.Time
+	EndsAt             *time.Time
+	MaxUses            int
+	MaxUsesPerCustomer int
+	Active             bool `gorm:"not null;default:true"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (PromoCode) TableName() string {
+	return "promo_codes"
+}
+
+// DiscountRule applies automatically to matching orders placed between
+// StartMinute and EndMinute (minutes after local midnight), for example a
+// happy hour on cold drinks. An empty MenuItemName and Tag match everything.
+type DiscountRule struct {
+	ID           uint    `gorm:"primaryKey"`
+	Name         string  `gorm:"not null"`
+	Percent      float64 `gorm:"not null"`
+	MenuItemName string
+	Tag          string
+	StartMinute  int
+	EndMinute    int
+	StartsAt     *time.Time
+	EndsAt       *time.Time
+	Active       bool `gorm:"not null;default:true"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (DiscountRule) TableName() string {
+	return "discount_rules"
+}
+
+// OrderDiscount is a discount that was applied to an order, from a promo
+// code or a rule. Redemption limits are counted from these rows.
+type OrderDiscount struct {
+	ID             uint   `gorm:"primaryKey"`
+	OrderID        uint   `gorm:"not null"`
+	Description    string `gorm:"not null"`
+	AmountCents    int64  `gorm:"not null"`
+	PromoCodeID    *uint
+	DiscountRuleID *uint
+}
+
+func (OrderDiscount) TableName() string {
+	return "order_discounts"
+}
@@ -0,0 +1,86 @@
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Compile-time check that FakeProvider implements PaymentProvider.
+var _ PaymentProvider = (*FakeProvider)(nil)
+
+// DeclineToken makes FakeProvider decline the authorization, so the failure
+// path can be exercised without a real card.
+const DeclineToken = "tok_decline"
+
+// FakeProvider keeps payments in memory. It is meant for development and
+// tests and approves everything except DeclineToken.
+type FakeProvider struct {
+	mu       sync.Mutex
+	next     int
+	payments map[string]*fakePayment
+}
+
+type fakePayment struct {
+	authorized int64
+	captured   int64
+	refunded   int64
+}
+
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{payments: make(map[string]*fakePayment)}
+}
+
+func (f *FakeProvider) Name() string {
+	return "fake"
+}
+
+func (f *FakeProvider) Authorize(ctx context.Context, req AuthorizeRequest) (Authorization, error) {
+	if req.Token == DeclineToken {
+		return Authorization{}, ErrDeclined
+	}
+	if req.AmountCents <= 0 {
+		return Authorization{}, fmt.Errorf("%w: %d", ErrInvalidAmount, req.AmountCents)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.next++
+	reference := fmt.Sprintf("fake_auth_%d", f.next)
+	f.payments[reference] = &fakePayment{authorized: req.AmountCents}
+	return Authorization{Reference: reference}, nil
+}
+
+func (f *FakeProvider) Capture(ctx context.Context, reference string, amountCents int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[reference]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, reference)
+	}
+	if amountCents <= 0 || p.captured+amountCents > p.authorized-p.refunded {
+		return fmt.Errorf("%w: cannot capture %d", ErrInvalidAmount, amountCents)
+	}
+
+	p.captured += amountCents
+	return nil
+}
+
+func (f *FakeProvider) Refund(ctx context.Context, reference string, amountCents int64) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	p, ok := f.payments[reference]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrNotFound, reference)
+	}
+	if amountCents <= 0 || p.refunded+amountCents > p.authorized {
+		return "", fmt.Errorf("%w: cannot refund %d", ErrInvalidAmount, amountCents)
+	}
+
+	p.refunded += amountCents
+	f.next++
+	return fmt.Sprintf("fake_refund_%d", f.next), nil
+}
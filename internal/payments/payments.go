@@ -0,0 +1,57 @@
+// Package payments talks to card processors. brewsvc only depends on the
+// PaymentProvider interface so the processor can be swapped by config.
+package payments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrDeclined is returned when the processor refuses a payment.
+	ErrDeclined = errors.New("payment declined")
+	// ErrNotFound is returned for an unknown authorization or capture.
+	ErrNotFound = errors.New("payment not found")
+	// ErrInvalidAmount is returned when capturing or refunding more than
+	// is available.
+	ErrInvalidAmount = errors.New("invalid amount")
+)
+
+// AuthorizeRequest reserves AmountCents on the customer's card. Token is the
+// card token produced by the processor's client-side SDK.
+type AuthorizeRequest struct {
+	OrderID     uint
+	AmountCents int64
+	Currency    string
+	Token       string
+}
+
+// Authorization identifies a successful hold on the processor side.
+type Authorization struct {
+	Reference string
+}
+
+// PaymentProvider is implemented by each card processor.
+type PaymentProvider interface {
+	// Name identifies the provider in the payments table.
+	Name() string
+	// Authorize places a hold for the amount without moving money.
+	Authorize(ctx context.Context, req AuthorizeRequest) (Authorization, error)
+	// Capture collects up to the authorized amount.
+	Capture(ctx context.Context, reference string, amountCents int64) error
+	// Refund returns money from a captured payment, or releases part of an
+	// authorization that has not been captured yet. It returns the
+	// processor's reference for the refund.
+	Refund(ctx context.Context, reference string, amountCents int64) (string, error)
+}
+
+// NewProvider returns the provider configured by name.
+func NewProvider(name string) (PaymentProvider, error) {
+	switch name {
+	case "fake":
+		return NewFakeProvider(), nil
+	default:
+		return nil, fmt.Errorf("unknown payment provider %q", name)
+	}
+}
@@ -0,0 +1,99 @@
+// Package pricing computes what an order costs. All amounts are integer
+// cents so totals never drift from floating point rounding.
+package pricing
+
+import "math"
+
+// Tax describes how sales tax is applied. With Inclusive set, menu prices
+// already contain the tax and it is only broken out on the bill.
+type Tax struct {
+	Rate      float64
+	Inclusive bool
+}
+
+// Item is one order line: a drink, how many, and its modifiers. Unit prices
+// are per drink.
+type Item struct {
+	Name      string
+	UnitCents int64
+	Quantity  int64
+	Modifiers []Modifier
+}
+
+type Modifier struct {
+	Name      string
+	UnitCents int64
+}
+
+// Line is one row of the bill.
+type Line struct {
+	Description string
+	Quantity    int64
+	UnitCents   int64
+	TotalCents  int64
+}
+
+// Discount is taken off the subtotal before tax.
+type Discount struct {
+	Description string
+	AmountCents int64
+	// Ref identifies where the discount came from. Calculate passes it
+	// through untouched.
+	Ref any
+}
+
+type Quote struct {
+	Lines         []Line
+	Discounts     []Discount
+	SubtotalCents int64
+	DiscountCents int64
+	TaxCents      int64
+	TotalCents    int64
+	Tax           Tax
+}
+
+// Cents converts a menu price in currency units to cents.
+func Cents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// Calculate prices item. Discounts never take the total below zero.
+func Calculate(item Item, discounts []Discount, tax Tax) Quote {
+	quote := Quote{Tax: tax}
+
+	quote.addLine(item.Name, item.Quantity, item.UnitCents)
+	for _, modifier := range item.Modifiers {
+		quote.addLine("+ "+modifier.Name, item.Quantity, modifier.UnitCents)
+	}
+
+	for _, discount := range discounts {
+		amount := min(discount.AmountCents, quote.SubtotalCents-quote.DiscountCents)
+		if amount <= 0 {
+			continue
+		}
+		discount.AmountCents = amount
+		quote.Discounts = append(quote.Discounts, discount)
+		quote.DiscountCents += amount
+	}
+
+	taxable := quote.SubtotalCents - quote.DiscountCents
+	if tax.Inclusive {
+		quote.TotalCents = taxable
+		quote.TaxCents = taxable - int64(math.Round(float64(taxable)/(1+tax.Rate)))
+	} else {
+		quote.TaxCents = int64(math.Round(float64(taxable) * tax.Rate))
+		quote.TotalCents = taxable + quote.TaxCents
+	}
+	return quote
+}
+
+func (q *Quote) addLine(description string, quantity, unitCents int64) {
+	line := Line{
+		Description: description,
+		Quantity:    quantity,
+		UnitCents:   unitCents,
+		TotalCents:  quantity * unitCents,
+	}
+	q.Lines = append(q.Lines, line)
+	q.SubtotalCents += line.TotalCents
+}
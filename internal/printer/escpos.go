@@ -0,0 +1,126 @@
+// Package printer renders order tickets as ESC/POS byte streams and sends
+// them to receipt and label printers. Rendering is a pure function of the
+// ticket, so the same order always produces the same bytes.
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ESC/POS control bytes.
+const (
+	esc = 0x1B
+	gs  = 0x1D
+	lf  = 0x0A
+)
+
+type Align byte
+
+const (
+	AlignLeft   Align = 0
+	AlignCenter Align = 1
+	AlignRight  Align = 2
+)
+
+// Builder appends ESC/POS commands to a buffer. Methods return the builder
+// so commands can be chained.
+type Builder struct {
+	buf bytes.Buffer
+}
+
+// Init resets the printer to its power-on settings.
+func (b *Builder) Init() *Builder {
+	b.buf.Write([]byte{esc, '@'})
+	return b
+}
+
+func (b *Builder) Align(a Align) *Builder {
+	b.buf.Write([]byte{esc, 'a', byte(a)})
+	return b
+}
+
+func (b *Builder) Bold(on bool) *Builder {
+	var n byte
+	if on {
+		n = 1
+	}
+	b.buf.Write([]byte{esc, 'E', n})
+	return b
+}
+
+// Size scales characters by width and height, each 1 to 8.
+func (b *Builder) Size(width, height int) *Builder {
+	w := byte(min(max(width, 1), 8) - 1)
+	h := byte(min(max(height, 1), 8) - 1)
+	b.buf.Write([]byte{gs, '!', w<<4 | h})
+	return b
+}
+
+// Text writes s in the printer's default code page. Accents are stripped
+// and other characters the printer cannot show become '?'.
+func (b *Builder) Text(s string) *Builder {
+	b.buf.WriteString(ascii(s))
+	return b
+}
+
+// Line writes s followed by a line feed.
+func (b *Builder) Line(s string) *Builder {
+	b.Text(s)
+	b.buf.WriteByte(lf)
+	return b
+}
+
+// Feed advances the paper n lines.
+func (b *Builder) Feed(n int) *Builder {
+	b.buf.Write([]byte{esc, 'd', byte(min(max(n, 0), 255))})
+	return b
+}
+
+// Barcode prints data as a CODE128 barcode with the text underneath. data
+// must be printable ASCII, at most 253 bytes.
+func (b *Builder) Barcode(data string) *Builder {
+	payload := "{B" + ascii(data)
+	if len(payload) > 255 {
+		payload = payload[:255]
+	}
+	b.buf.Write([]byte{gs, 'h', 80}) // height in dots
+	b.buf.Write([]byte{gs, 'w', 2})  // module width
+	b.buf.Write([]byte{gs, 'H', 2})  // human-readable text below
+	b.buf.Write([]byte{gs, 'k', 73, byte(len(payload))})
+	b.buf.WriteString(payload)
+	b.buf.WriteByte(lf)
+	return b
+}
+
+// Cut feeds past the print head and partially cuts the paper.
+func (b *Builder) Cut() *Builder {
+	b.buf.Write([]byte{gs, 'V', 66, 0})
+	return b
+}
+
+func (b *Builder) Bytes() []byte {
+	return b.buf.Bytes()
+}
+
+// ascii folds s into printable ASCII.
+func ascii(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+	folded = strings.NewReplacer("đ", "d", "Đ", "D").Replace(folded)
+
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return '?'
+		}
+		return r
+	}, folded)
+}
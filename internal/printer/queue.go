@@ -0,0 +1,82 @@
+package printer
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Retries for a job whose sink fails, e.g. a printer that is out of paper
+// or briefly off the network.
+const maxAttempts = 3
+
+// retryBackoff grows with every attempt. Tests shorten it.
+var retryBackoff = time.Second
+
+// Job is one ticket to print. Name identifies it in logs.
+type Job struct {
+	Name string
+	Data []byte
+}
+
+// Queue prints jobs in order on a background goroutine so that slow or
+// unreachable printers never hold up an order.
+type Queue struct {
+	sink Sink
+	jobs chan Job
+	done chan struct{}
+}
+
+// NewQueue starts a queue that holds up to size jobs waiting for sink.
+func NewQueue(sink Sink, size int) *Queue {
+	q := &Queue{
+		sink: sink,
+		jobs: make(chan Job, size),
+		done: make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+// Enqueue adds a job without blocking. It reports false, and the job is
+// dropped, when the queue is full.
+func (q *Queue) Enqueue(job Job) bool {
+	select {
+	case q.jobs <- job:
+		return true
+	default:
+		log.Printf("Print queue full, dropped %s", job.Name)
+		return false
+	}
+}
+
+// Close prints what is still queued and stops the queue. Enqueue must not
+// be called afterwards.
+func (q *Queue) Close() {
+	close(q.jobs)
+	<-q.done
+}
+
+func (q *Queue) run() {
+	defer close(q.done)
+	for job := range q.jobs {
+		q.print(job)
+	}
+}
+
+func (q *Queue) print(job Job) {
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		err := q.sink.Send(ctx, job.Data)
+		cancel()
+		if err == nil {
+			return
+		}
+		if attempt == maxAttempts {
+			log.Printf("Failed to print %s: %v", job.Name, err)
+			return
+		}
+		log.Printf("Printing %s failed, retrying: %v", job.Name, err)
+		time.Sleep(retryBackoff * time.Duration(attempt))
+	}
+}
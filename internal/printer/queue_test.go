@@ -0,0 +1,96 @@
+package printer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSink records what it is sent. Its first failures sends fail. When
+// started and release are set, every send is announced on started and
+// then waits for release to be closed.
+type fakeSink struct {
+	mu       sync.Mutex
+	sent     []string
+	attempts int
+	failures int
+	started  chan struct{}
+	release  chan struct{}
+}
+
+func (s *fakeSink) Send(ctx context.Context, data []byte) error {
+	if s.started != nil {
+		s.started <- struct{}{}
+	}
+	if s.release != nil {
+		<-s.release
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attempts++
+	if s.attempts <= s.failures {
+		return errors.New("out of paper")
+	}
+	s.sent = append(s.sent, string(data))
+	return nil
+}
+
+func init() {
+	retryBackoff = time.Millisecond
+}
+
+func TestQueueRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		wantAttempts int
+		wantSent     []string
+	}{
+		{name: "first try", failures: 0, wantAttempts: 1, wantSent: []string{"ticket"}},
+		{name: "after a failure", failures: 1, wantAttempts: 2, wantSent: []string{"ticket"}},
+		{name: "last attempt", failures: maxAttempts - 1, wantAttempts: maxAttempts, wantSent: []string{"ticket"}},
+		{name: "gives up", failures: maxAttempts, wantAttempts: maxAttempts, wantSent: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &fakeSink{failures: tt.failures}
+			q := NewQueue(sink, 1)
+			q.Enqueue(Job{Name: "order-42", Data: []byte("ticket")})
+			q.Close()
+
+			if sink.attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", sink.attempts, tt.wantAttempts)
+			}
+			if !slices.Equal(sink.sent, tt.wantSent) {
+				t.Errorf("sent = %q, want %q", sink.sent, tt.wantSent)
+			}
+		})
+	}
+}
+
+func TestQueueDropsWhenFull(t *testing.T) {
+	sink := &fakeSink{started: make(chan struct{}, 3), release: make(chan struct{})}
+	q := NewQueue(sink, 1)
+
+	// The first job is taken off the queue and held by the sink, the
+	// second waits in the queue and the third does not fit.
+	if !q.Enqueue(Job{Name: "first", Data: []byte("1")}) {
+		t.Fatal("first job was dropped")
+	}
+	<-sink.started
+	if !q.Enqueue(Job{Name: "second", Data: []byte("2")}) {
+		t.Fatal("second job was dropped")
+	}
+	if q.Enqueue(Job{Name: "third", Data: []byte("3")}) {
+		t.Fatal("third job was queued, want it dropped")
+	}
+
+	close(sink.release)
+	q.Close()
+	if want := []string{"1", "2"}; !slices.Equal(sink.sent, want) {
+		t.Errorf("sent = %q, want %q", sink.sent, want)
+	}
+}
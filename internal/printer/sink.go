@@ -0,0 +1,94 @@
+package printer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Sink delivers rendered tickets to a printer.
+type Sink interface {
+	Send(ctx context.Context, data []byte) error
+}
+
+// NewSink parses a sink spec:
+//
+//	stdout                 raw bytes on standard output
+//	file:/path/to/file     appended to a file or device such as /dev/usb/lp0
+//	tcp:192.168.1.50:9100  a network printer's raw port
+func NewSink(spec string) (Sink, error) {
+	kind, target, _ := strings.Cut(spec, ":")
+	switch kind {
+	case "stdout":
+		return &WriterSink{w: os.Stdout}, nil
+	case "file":
+		if target == "" {
+			return nil, fmt.Errorf("printer sink %q needs a path", spec)
+		}
+		return &FileSink{Path: target}, nil
+	case "tcp":
+		if _, _, err := net.SplitHostPort(target); err != nil {
+			return nil, fmt.Errorf("printer sink %q: %w", spec, err)
+		}
+		return &TCPSink{Addr: target, Timeout: 5 * time.Second}, nil
+	}
+	return nil, fmt.Errorf("unknown printer sink %q", spec)
+}
+
+// WriterSink writes tickets to w one at a time.
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *WriterSink) Send(ctx context.Context, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(data)
+	return err
+}
+
+// FileSink appends tickets to Path, opening it for every ticket so that a
+// printer device can be unplugged and plugged back in.
+type FileSink struct {
+	Path string
+}
+
+func (s *FileSink) Send(ctx context.Context, data []byte) error {
+	f, err := os.OpenFile(s.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// TCPSink sends each ticket over a new connection to a printer listening
+// for raw jobs, usually on port 9100.
+type TCPSink struct {
+	Addr    string
+	Timeout time.Duration
+}
+
+func (s *TCPSink) Send(ctx context.Context, data []byte) error {
+	dialer := net.Dialer{Timeout: s.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(s.Timeout)); err != nil {
+		return err
+	}
+	_, err = conn.Write(data)
+	return err
+}
@@ -0,0 +1,57 @@
+package printer
+
+import (
+	"fmt"
+	"time"
+)
+
+// Ticket is the sticker for one cup.
+type Ticket struct {
+	OrderID      string
+	PickupCode   string
+	CustomerName string
+	Drink        string
+	Modifiers    []string
+	// Cup is this cup's number out of Cups in the order.
+	Cup       int
+	Cups      int
+	CreatedAt time.Time
+}
+
+// Render returns the ESC/POS bytes of ticket. It depends only on the ticket,
+// so output can be compared byte for byte.
+func Render(ticket Ticket) []byte {
+	var b Builder
+	b.Init()
+
+	b.Align(AlignCenter).Bold(true).Size(2, 2)
+	b.Line(ticket.PickupCode)
+	b.Size(1, 1)
+	if ticket.CustomerName != "" {
+		b.Size(1, 2).Line(ticket.CustomerName).Size(1, 1)
+	}
+	b.Bold(false)
+
+	b.Align(AlignLeft).Bold(true)
+	b.Line(ticket.Drink)
+	b.Bold(false)
+	for _, modifier := range ticket.Modifiers {
+		b.Line("  + " + modifier)
+	}
+	b.Line(fmt.Sprintf("Cup %d/%d  %s  %s", ticket.Cup, ticket.Cups, ticket.OrderID, ticket.CreatedAt.Format("15:04")))
+
+	b.Align(AlignCenter).Barcode(ticket.OrderID)
+	b.Feed(3).Cut()
+	return b.Bytes()
+}
+
+// Tickets returns one ticket per cup of an order.
+func Tickets(order Ticket, cups int) []Ticket {
+	tickets := make([]Ticket, 0, cups)
+	for cup := 1; cup <= cups; cup++ {
+		ticket := order
+		ticket.Cup, ticket.Cups = cup, cups
+		tickets = append(tickets, ticket)
+	}
+	return tickets
+}
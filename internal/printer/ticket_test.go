@@ -0,0 +1,91 @@
+package printer
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestRender(t *testing.T) {
+	createdAt := time.Date(2026, 3, 14, 8, 5, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		ticket Ticket
+	}{
+		{
+			name: "plain",
+			ticket: Ticket{
+				OrderID:    "order-42",
+				PickupCode: "A7K2",
+				Drink:      "Latte",
+				Cup:        1,
+				Cups:       1,
+				CreatedAt:  createdAt,
+			},
+		},
+		{
+			name: "named",
+			ticket: Ticket{
+				OrderID:      "order-43",
+				PickupCode:   "Q9XP",
+				CustomerName: "Zoë Đặng",
+				Drink:        "Flat White",
+				Modifiers:    []string{"Oat milk", "Extra shot"},
+				Cup:          2,
+				Cups:         3,
+				CreatedAt:    createdAt,
+			},
+		},
+		{
+			name: "unprintable",
+			ticket: Ticket{
+				OrderID:      "order-44",
+				PickupCode:   "M3RT",
+				CustomerName: "☕ Kim\x1b@",
+				Drink:        "Matcha Latte",
+				Modifiers:    []string{"Crème brûlée syrup"},
+				Cup:          1,
+				Cups:         1,
+				CreatedAt:    createdAt,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.ticket)
+			golden := filepath.Join("testdata", tt.name+".bin")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v, run go test -update to create it", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Render() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestTickets(t *testing.T) {
+	tickets := Tickets(Ticket{OrderID: "order-42", Drink: "Latte"}, 3)
+	if len(tickets) != 3 {
+		t.Fatalf("got %d tickets, want 3", len(tickets))
+	}
+	for i, ticket := range tickets {
+		if ticket.Cup != i+1 || ticket.Cups != 3 {
+			t.Errorf("ticket %d is cup %d/%d, want %d/3", i, ticket.Cup, ticket.Cups, i+1)
+		}
+		if ticket.OrderID != "order-42" || ticket.Drink != "Latte" {
+			t.Errorf("ticket %d = %+v, want the order's fields", i, ticket)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package promos
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/pricing"
+)
+
+var (
+	// ErrUnknownCode is returned for a promo code that does not exist.
+	ErrUnknownCode = errors.New("unknown promo code")
+	// ErrNotApplicable wraps the reason a promo code cannot be used on an
+	// order: expired, used up, or for a different item.
+	ErrNotApplicable = errors.New("promo code cannot be applied")
+)
+
+// Cart is what the discounts are computed on. DrinkCents is the price of one
+// drink including its modifiers.
+type Cart struct {
+	MenuItemName  string
+	Tags          []string
+	Quantity      int64
+	DrinkCents    int64
+	SubtotalCents int64
+	CustomerID    string
+}
+
+// Usage is how often a promo code has been redeemed so far.
+type Usage struct {
+	Total      int64
+	ByCustomer int64
+}
+
+// NormalizeCode makes codes case-insensitive: they are stored upper case.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CodeDiscount works out what promo takes off cart at now. The returned
+// discount's Ref is the promo itself.
+func CodeDiscount(promo *models.PromoCode, cart Cart, usage Usage, now time.Time) (pricing.Discount, error) {
+	notApplicable := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrNotApplicable, fmt.Sprintf(format, args...))
+	}
+
+	switch {
+	case !promo.Active:
+		return pricing.Discount{}, notApplicable("%s is no longer active", promo.Code)
+	case promo.StartsAt != nil && now.Before(*promo.StartsAt):
+		return pricing.Discount{}, notApplicable("%s is valid from %s", promo.Code, promo.StartsAt.Format(time.DateOnly))
+	case promo.EndsAt != nil && !now.Before(*promo.EndsAt):
+		return pricing.Discount{}, notApplicable("%s expired on %s", promo.Code, promo.EndsAt.Format(time.DateOnly))
+	case promo.MaxUses > 0 && usage.Total >= int64(promo.MaxUses):
+		return pricing.Discount{}, notApplicable("%s has been used up", promo.Code)
+	case promo.MaxUsesPerCustomer > 0 && cart.CustomerID == "":
+		return pricing.Discount{}, notApplicable("%s needs a customer ID", promo.Code)
+	case promo.MaxUsesPerCustomer > 0 && usage.ByCustomer >= int64(promo.MaxUsesPerCustomer):
+		return pricing.Discount{}, notApplicable("you have already used %s", promo.Code)
+	case promo.MenuItemName != "" && promo.MenuItemName != cart.MenuItemName:
+		return pricing.Discount{}, notApplicable("%s is only valid on %s", promo.Code, promo.MenuItemName)
+	}
+
+	discount := pricing.Discount{
+		Description: fmt.Sprintf("%s (%s)", promo.Description, promo.Code),
+		Ref:         promo,
+	}
+	switch promo.Kind {
+	case models.PromoPercent:
+		discount.AmountCents = percentOf(cart.SubtotalCents, promo.Percent)
+	case models.PromoFixed:
+		discount.AmountCents = promo.AmountCents
+	case models.PromoBOGO:
+		if cart.Quantity < 2 {
+			return pricing.Discount{}, notApplicable("%s needs at least 2 drinks", promo.Code)
+		}
+		discount.AmountCents = cart.Quantity / 2 * cart.DrinkCents
+	default:
+		return pricing.Discount{}, fmt.Errorf("promo code %s has unknown kind %q", promo.Code, promo.Kind)
+	}
+	return discount, nil
+}
+
+// RuleDiscounts returns the discounts of the rules that match cart at now.
+// Each discount's Ref is the rule it came from.
+func RuleDiscounts(rules []models.DiscountRule, cart Cart, now time.Time) []pricing.Discount {
+	minute := now.Hour()*60 + now.Minute()
+
+	var discounts []pricing.Discount
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.Active || !inWindow(minute, rule.StartMinute, rule.EndMinute) {
+			continue
+		}
+		if rule.MenuItemName != "" && rule.MenuItemName != cart.MenuItemName {
+			continue
+		}
+		if rule.Tag != "" && !slices.Contains(cart.Tags, rule.Tag) {
+			continue
+		}
+		discounts = append(discounts, pricing.Discount{
+			Description: rule.Name,
+			AmountCents: percentOf(cart.SubtotalCents, rule.Percent),
+			Ref:         rule,
+		})
+	}
+	return discounts
+}
+
+// inWindow reports whether minute falls in [start, end). Windows that end
+// before they start run past midnight.
+func inWindow(minute, start, end int) bool {
+	if start <= end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+func percentOf(cents int64, percent float64) int64 {
+	return int64(math.Round(float64(cents) * percent / 100))
+}
@@ -0,0 +1,139 @@
+package promos
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+)
+
+func TestNormalizeCode(t *testing.T) {
+	if got := NormalizeCode("  summer10 "); got != "SUMMER10" {
+		t.Errorf("NormalizeCode() = %q, want SUMMER10", got)
+	}
+}
+
+func TestCodeDiscount(t *testing.T) {
+	now := time.Date(2026, 6, 15, 9, 30, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	tomorrow := now.AddDate(0, 0, 1)
+	cart := Cart{MenuItemName: "Latte", Quantity: 3, DrinkCents: 410, SubtotalCents: 1230, CustomerID: "alice"}
+
+	tests := []struct {
+		name    string
+		promo   models.PromoCode
+		cart    Cart
+		usage   Usage
+		want    int64
+		wantErr error
+	}{
+		{name: "percent", promo: models.PromoCode{Active: true, Kind: models.PromoPercent, Percent: 10}, cart: cart, want: 123},
+		{name: "fixed", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, AmountCents: 200}, cart: cart, want: 200},
+		{name: "buy one get one", promo: models.PromoCode{Active: true, Kind: models.PromoBOGO}, cart: cart, want: 410},
+		{name: "buy one get one on one drink", promo: models.PromoCode{Active: true, Kind: models.PromoBOGO}, cart: Cart{Quantity: 1, DrinkCents: 410}, wantErr: ErrNotApplicable},
+		{name: "inactive", promo: models.PromoCode{Kind: models.PromoFixed}, cart: cart, wantErr: ErrNotApplicable},
+		{name: "not started", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, StartsAt: &tomorrow}, cart: cart, wantErr: ErrNotApplicable},
+		{name: "expired", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, EndsAt: &yesterday}, cart: cart, wantErr: ErrNotApplicable},
+		{name: "within dates", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, AmountCents: 50, StartsAt: &yesterday, EndsAt: &tomorrow}, cart: cart, want: 50},
+		{name: "used up", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, MaxUses: 100}, cart: cart, usage: Usage{Total: 100}, wantErr: ErrNotApplicable},
+		{name: "used by customer", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, MaxUsesPerCustomer: 1}, cart: cart, usage: Usage{Total: 5, ByCustomer: 1}, wantErr: ErrNotApplicable},
+		{name: "per customer without customer", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, MaxUsesPerCustomer: 1}, cart: Cart{MenuItemName: "Latte", Quantity: 1}, wantErr: ErrNotApplicable},
+		{name: "other item", promo: models.PromoCode{Active: true, Kind: models.PromoFixed, MenuItemName: "Espresso"}, cart: cart, wantErr: ErrNotApplicable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promo := tt.promo
+			promo.Code = "TEST"
+			discount, err := CodeDiscount(&promo, tt.cart, tt.usage, now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CodeDiscount() error = %v, want %v", err, tt.wantErr)
+			}
+			if discount.AmountCents != tt.want {
+				t.Errorf("AmountCents = %d, want %d", discount.AmountCents, tt.want)
+			}
+			if err == nil && discount.Ref != &promo {
+				t.Error("Ref is not the promo code")
+			}
+		})
+	}
+}
+
+func TestCodeDiscountUnknownKind(t *testing.T) {
+	promo := &models.PromoCode{Code: "ODD", Kind: "FREE_CAKE", Active: true}
+	_, err := CodeDiscount(promo, Cart{Quantity: 1}, Usage{}, time.Now())
+	if err == nil || errors.Is(err, ErrNotApplicable) {
+		t.Errorf("CodeDiscount() error = %v, want an internal error", err)
+	}
+}
+
+func TestRuleDiscounts(t *testing.T) {
+	rules := []models.DiscountRule{
+		{ID: 1, Name: "Happy hour", Percent: 20, Tag: "cold", StartMinute: 14 * 60, EndMinute: 16 * 60, Active: true},
+		{ID: 2, Name: "Latte Monday", Percent: 10, MenuItemName: "Latte", StartMinute: 0, EndMinute: 24 * 60, Active: true},
+		{ID: 3, Name: "Late night", Percent: 50, StartMinute: 22 * 60, EndMinute: 2 * 60, Active: true},
+		{ID: 4, Name: "Retired", Percent: 90, StartMinute: 0, EndMinute: 24 * 60},
+	}
+	iceLatte := Cart{MenuItemName: "Ice Latte", Tags: []string{"cold", "coffee"}, SubtotalCents: 375}
+	latte := Cart{MenuItemName: "Latte", Tags: []string{"hot", "coffee"}, SubtotalCents: 350}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 6, 15, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		cart Cart
+		now  time.Time
+		want map[string]int64
+	}{
+		{name: "happy hour", cart: iceLatte, now: at(15, 0), want: map[string]int64{"Happy hour": 75}},
+		{name: "happy hour ends", cart: iceLatte, now: at(16, 0), want: map[string]int64{}},
+		{name: "hot drink at happy hour", cart: latte, now: at(15, 0), want: map[string]int64{"Latte Monday": 35}},
+		{name: "past midnight", cart: iceLatte, now: at(1, 59), want: map[string]int64{"Late night": 188}},
+		{name: "stacking", cart: latte, now: at(23, 0), want: map[string]int64{"Latte Monday": 35, "Late night": 175}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make(map[string]int64)
+			for _, discount := range RuleDiscounts(rules, tt.cart, tt.now) {
+				got[discount.Description] = discount.AmountCents
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("RuleDiscounts() = %v, want %v", got, tt.want)
+			}
+			for name, cents := range tt.want {
+				if got[name] != cents {
+					t.Errorf("%s = %d, want %d", name, got[name], cents)
+				}
+			}
+		})
+	}
+}
+
+func TestInWindow(t *testing.T) {
+	tests := []struct {
+		minute, start, end int
+		want               bool
+	}{
+		{minute: 600, start: 540, end: 660, want: true},
+		{minute: 540, start: 540, end: 660, want: true},
+		{minute: 660, start: 540, end: 660, want: false},
+		{minute: 1380, start: 1320, end: 120, want: true},
+		{minute: 60, start: 1320, end: 120, want: true},
+		{minute: 600, start: 1320, end: 120, want: false},
+	}
+	for _, tt := range tests {
+		if got := inWindow(tt.minute, tt.start, tt.end); got != tt.want {
+			t.Errorf("inWindow(%d, %d, %d) = %v, want %v", tt.minute, tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	if got := percentOf(375, 50); got != 188 {
+		t.Errorf("percentOf(375, 50) = %d, want 188", got)
+	}
+	if got := percentOf(333, 12.5); got != 42 {
+		t.Errorf("percentOf(333, 12.5) = %d, want 42", got)
+	}
+}
@@ -0,0 +1,239 @@
+package promos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	promopb "github.com/jany/my-coffee/gen/proto/promo"
+	"github.com/jany/my-coffee/gen/proto/promo/promoconnect"
+	"github.com/jany/my-coffee/internal/menus"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ promoconnect.PromoServiceHandler = (*Server)(nil)
+
+type Server struct {
+	promoRepo *repository.PromoRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		promoRepo: repository.NewPromoRepository(db),
+	}
+}
+
+func (s *Server) CreatePromoCode(ctx context.Context, req *connect.Request[promopb.CreatePromoCodeRequest]) (*connect.Response[promopb.CreatePromoCodeResponse], error) {
+	promo := &models.PromoCode{
+		Code:               NormalizeCode(req.Msg.Code),
+		Description:        req.Msg.Description,
+		Kind:               models.PromoKind(req.Msg.Kind.String()),
+		Percent:            req.Msg.Percent,
+		AmountCents:        req.Msg.AmountCents,
+		StartsAt:           toTime(req.Msg.StartsAt),
+		EndsAt:             toTime(req.Msg.EndsAt),
+		MaxUses:            int(req.Msg.MaxUses),
+		MaxUsesPerCustomer: int(req.Msg.MaxUsesPerCustomer),
+		Active:             true,
+	}
+
+	switch {
+	case promo.Kind == models.PromoPercent && promo.Percent == 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a PERCENT promo needs a percent"))
+	case promo.Kind == models.PromoFixed && promo.AmountCents == 0:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("a FIXED promo needs amount_cents"))
+	case promo.StartsAt != nil && promo.EndsAt != nil && !promo.EndsAt.After(*promo.StartsAt):
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("ends_at must be after starts_at"))
+	}
+	if req.Msg.MenuItemName != "" {
+		name, ok := menus.LookupName(req.Msg.MenuItemName)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown menu item %q", req.Msg.MenuItemName))
+		}
+		promo.MenuItemName = name
+	}
+
+	if err := s.promoRepo.CreateCode(promo); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("promo code %s already exists", promo.Code))
+		}
+		log.Printf("Failed to create promo code: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create promo code: %w", err))
+	}
+
+	return connect.NewResponse(&promopb.CreatePromoCodeResponse{
+		PromoCode: toPromoProto(promo, 0),
+	}), nil
+}
+
+func (s *Server) ListPromoCodes(ctx context.Context, req *connect.Request[promopb.ListPromoCodesRequest]) (*connect.Response[promopb.ListPromoCodesResponse], error) {
+	promos, err := s.promoRepo.FindAllCodes()
+	if err != nil {
+		log.Printf("Failed to list promo codes: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list promo codes: %w", err))
+	}
+
+	var promopbs []*promopb.PromoCode
+	for _, promo := range promos {
+		uses, _, err := s.promoRepo.CountUses(promo.ID, "")
+		if err != nil {
+			log.Printf("Failed to count promo code uses: %v", err)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count promo code uses: %w", err))
+		}
+		promopbs = append(promopbs, toPromoProto(&promo, uses))
+	}
+
+	return connect.NewResponse(&promopb.ListPromoCodesResponse{
+		PromoCodes: promopbs,
+	}), nil
+}
+
+func (s *Server) SetPromoCodeActive(ctx context.Context, req *connect.Request[promopb.SetPromoCodeActiveRequest]) (*connect.Response[promopb.SetPromoCodeActiveResponse], error) {
+	promo, err := s.promoRepo.SetCodeActive(NormalizeCode(req.Msg.Code), req.Msg.Active)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("%w %q", ErrUnknownCode, req.Msg.Code))
+	}
+	if err != nil {
+		log.Printf("Failed to update promo code: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update promo code: %w", err))
+	}
+
+	uses, _, err := s.promoRepo.CountUses(promo.ID, "")
+	if err != nil {
+		log.Printf("Failed to count promo code uses: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to count promo code uses: %w", err))
+	}
+
+	return connect.NewResponse(&promopb.SetPromoCodeActiveResponse{
+		PromoCode: toPromoProto(promo, uses),
+	}), nil
+}
+
+func (s *Server) CreateDiscountRule(ctx context.Context, req *connect.Request[promopb.CreateDiscountRuleRequest]) (*connect.Response[promopb.CreateDiscountRuleResponse], error) {
+	rule := &models.DiscountRule{
+		Name:        req.Msg.Name,
+		Percent:     req.Msg.Percent,
+		Tag:         req.Msg.Tag,
+		StartMinute: parseClock(req.Msg.StartTime, 0),
+		EndMinute:   parseClock(req.Msg.EndTime, 24*60),
+		StartsAt:    toTime(req.Msg.StartsAt),
+		EndsAt:      toTime(req.Msg.EndsAt),
+		Active:      true,
+	}
+	if req.Msg.MenuItemName != "" {
+		name, ok := menus.LookupName(req.Msg.MenuItemName)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unknown menu item %q", req.Msg.MenuItemName))
+		}
+		rule.MenuItemName = name
+	}
+
+	if err := s.promoRepo.CreateRule(rule); err != nil {
+		log.Printf("Failed to create discount rule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create discount rule: %w", err))
+	}
+
+	return connect.NewResponse(&promopb.CreateDiscountRuleResponse{
+		Rule: toRuleProto(rule),
+	}), nil
+}
+
+func (s *Server) ListDiscountRules(ctx context.Context, req *connect.Request[promopb.ListDiscountRulesRequest]) (*connect.Response[promopb.ListDiscountRulesResponse], error) {
+	rules, err := s.promoRepo.FindAllRules()
+	if err != nil {
+		log.Printf("Failed to list discount rules: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list discount rules: %w", err))
+	}
+
+	var rulepbs []*promopb.DiscountRule
+	for _, rule := range rules {
+		rulepbs = append(rulepbs, toRuleProto(&rule))
+	}
+
+	return connect.NewResponse(&promopb.ListDiscountRulesResponse{
+		Rules: rulepbs,
+	}), nil
+}
+
+func (s *Server) SetDiscountRuleActive(ctx context.Context, req *connect.Request[promopb.SetDiscountRuleActiveRequest]) (*connect.Response[promopb.SetDiscountRuleActiveResponse], error) {
+	rule, err := s.promoRepo.SetRuleActive(uint(req.Msg.Id), req.Msg.Active)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown discount rule %d", req.Msg.Id))
+	}
+	if err != nil {
+		log.Printf("Failed to update discount rule: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update discount rule: %w", err))
+	}
+
+	return connect.NewResponse(&promopb.SetDiscountRuleActiveResponse{
+		Rule: toRuleProto(rule),
+	}), nil
+}
+
+// parseClock turns "14:30" into minutes after midnight. The request was
+// validated, so only the empty string needs a fallback.
+func parseClock(clock string, fallback int) int {
+	var hour, minute int
+	if _, err := fmt.Sscanf(clock, "%d:%d", &hour, &minute); err != nil {
+		return fallback
+	}
+	return hour*60 + minute
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func toTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toPromoProto(promo *models.PromoCode, uses int64) *promopb.PromoCode {
+	return &promopb.PromoCode{
+		Code:               promo.Code,
+		Description:        promo.Description,
+		Kind:               promopb.PromoKind(promopb.PromoKind_value[string(promo.Kind)]),
+		Percent:            promo.Percent,
+		AmountCents:        promo.AmountCents,
+		MenuItemName:       promo.MenuItemName,
+		StartsAt:           toTimestamp(promo.StartsAt),
+		EndsAt:             toTimestamp(promo.EndsAt),
+		MaxUses:            int32(promo.MaxUses),
+		MaxUsesPerCustomer: int32(promo.MaxUsesPerCustomer),
+		Active:             promo.Active,
+		Uses:               uses,
+	}
+}
+
+func toRuleProto(rule *models.DiscountRule) *promopb.DiscountRule {
+	return &promopb.DiscountRule{
+		Id:           uint32(rule.ID),
+		Name:         rule.Name,
+		Percent:      rule.Percent,
+		MenuItemName: rule.MenuItemName,
+		Tag:          rule.Tag,
+		StartTime:    formatClock(rule.StartMinute),
+		EndTime:      formatClock(rule.EndMinute),
+		StartsAt:     toTimestamp(rule.StartsAt),
+		EndsAt:       toTimestamp(rule.EndsAt),
+		Active:       rule.Active,
+	}
+}
@@ -0,0 +1,172 @@
+// Package receipts renders order receipts from Go templates. The built-in
+// templates can be replaced per shop; they receive a *Receipt and the
+// functions in funcs.
+package receipts
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jany/my-coffee/internal/qrcodes"
+)
+
+// Width is the number of characters on a line of the text receipt, which
+// fits an 80 mm receipt printer.
+const Width = 42
+
+//go:embed templates
+var defaults embed.FS
+
+type Store struct {
+	Name    string
+	Address string
+	Phone   string
+	Footer  string
+}
+
+// Line is a drink or a modifier surcharge.
+type Line struct {
+	Description string
+	Quantity    int64
+	UnitCents   int64
+	TotalCents  int64
+}
+
+type Discount struct {
+	Description string
+	AmountCents int64
+}
+
+// Payment is one tender used on the order, e.g. "Card" or "Gift card".
+type Payment struct {
+	Method      string
+	AmountCents int64
+}
+
+// Receipt is the data the templates render.
+type Receipt struct {
+	Store      Store
+	OrderID    string
+	PickupCode string
+	// TrackingURL is drawn as a QR code on the HTML receipt.
+	TrackingURL    string
+	CreatedAt      time.Time
+	Lines          []Line
+	Discounts      []Discount
+	SubtotalCents  int64
+	DiscountCents  int64
+	TaxCents       int64
+	TotalCents     int64
+	TaxRate        float64
+	TaxInclusive   bool
+	Currency       string
+	Payments       []Payment
+	RefundedCents  int64
+	AmountDueCents int64
+}
+
+// Renderer holds the parsed text and HTML templates.
+type Renderer struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// New parses the receipt templates. Empty paths use the built-in ones.
+func New(textPath, htmlPath string) (*Renderer, error) {
+	textSource, err := load(textPath, "templates/receipt.txt.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	htmlSource, err := load(htmlPath, "templates/receipt.html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := texttemplate.New("receipt.txt").Funcs(funcs).Parse(textSource)
+	if err != nil {
+		return nil, fmt.Errorf("parse text receipt template: %w", err)
+	}
+	html, err := htmltemplate.New("receipt.html").Funcs(funcs).Funcs(htmlFuncs).Parse(htmlSource)
+	if err != nil {
+		return nil, fmt.Errorf("parse HTML receipt template: %w", err)
+	}
+	return &Renderer{text: text, html: html}, nil
+}
+
+// Text renders the plain text receipt.
+func (r *Renderer) Text(receipt *Receipt) (string, error) {
+	var buf bytes.Buffer
+	if err := r.text.Execute(&buf, receipt); err != nil {
+		return "", fmt.Errorf("render text receipt: %w", err)
+	}
+	return strings.TrimLeft(buf.String(), "\n"), nil
+}
+
+// HTML renders the HTML receipt.
+func (r *Renderer) HTML(receipt *Receipt) (string, error) {
+	var buf bytes.Buffer
+	if err := r.html.Execute(&buf, receipt); err != nil {
+		return "", fmt.Errorf("render HTML receipt: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func load(path, fallback string) (string, error) {
+	if path == "" {
+		b, err := defaults.ReadFile(fallback)
+		return string(b), err
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read receipt template: %w", err)
+	}
+	return string(b), nil
+}
+
+// funcs are available to both templates.
+var funcs = map[string]any{
+	// money formats cents as "3.50", "-0.70".
+	"money": Money,
+	"neg":   func(cents int64) int64 { return -cents },
+	// percent formats a rate such as 0.08 as "8%".
+	"percent": func(rate float64) string {
+		return strconv.FormatFloat(rate*100, 'f', -1, 64) + "%"
+	},
+	// center pads s to sit in the middle of a text receipt line.
+	"center": func(s string) string {
+		pad := max(Width-utf8.RuneCountInString(s), 0) / 2
+		return strings.Repeat(" ", pad) + s
+	},
+	// row puts left and right at the edges of a text receipt line.
+	"row": func(left, right string) string {
+		pad := Width - utf8.RuneCountInString(left) - utf8.RuneCountInString(right)
+		return left + strings.Repeat(" ", max(pad, 1)) + right
+	},
+	"rule": func() string { return strings.Repeat("-", Width) },
+}
+
+// htmlFuncs are only available to the HTML template.
+var htmlFuncs = map[string]any{
+	// qrcode draws content as an inline SVG image.
+	"qrcode": func(content string) (htmltemplate.HTML, error) {
+		svg, err := qrcodes.SVG(content)
+		return htmltemplate.HTML(svg), err
+	},
+}
+
+// Money formats cents as a decimal amount without currency.
+func Money(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,126 @@
+package receipts
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func testReceipt() *Receipt {
+	return &Receipt{
+		Store: Store{
+			Name:    "My Coffee Shop",
+			Address: "1 Bean Street",
+			Footer:  "Thank you!",
+		},
+		OrderID:    "order-42",
+		PickupCode: "A7K3",
+		CreatedAt:  time.Date(2026, 3, 14, 8, 5, 0, 0, time.UTC),
+		Lines: []Line{
+			{Description: "Latte", Quantity: 2, UnitCents: 350, TotalCents: 700},
+			{Description: "Oat milk", Quantity: 2, UnitCents: 60, TotalCents: 120},
+		},
+		Discounts:      []Discount{{Description: "Happy hour", AmountCents: 164}},
+		SubtotalCents:  820,
+		DiscountCents:  164,
+		TaxCents:       52,
+		TotalCents:     708,
+		TaxRate:        0.08,
+		Currency:       "USD",
+		Payments:       []Payment{{Method: "Gift card", AmountCents: 500}},
+		AmountDueCents: 208,
+	}
+}
+
+func TestText(t *testing.T) {
+	renderer, err := New("", "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got, err := renderer.Text(testReceipt())
+	if err != nil {
+		t.Fatalf("Text() error = %v", err)
+	}
+
+	golden := filepath.Join("testdata", "receipt.txt")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v, run go test -update to create it", err)
+	}
+	if got != string(want) {
+		t.Errorf("Text() =\n%s\nwant\n%s", got, want)
+	}
+
+	for _, line := range strings.Split(got, "\n") {
+		if n := utf8.RuneCountInString(line); n > Width {
+			t.Errorf("line %q is %d characters, wider than %d", line, n, Width)
+		}
+	}
+}
+
+func TestHTML(t *testing.T) {
+	renderer, err := New("", "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	receipt := testReceipt()
+	receipt.Store.Name = "Bean & <Leaf>"
+	got, err := renderer.HTML(receipt)
+	if err != nil {
+		t.Fatalf("HTML() error = %v", err)
+	}
+
+	for _, want := range []string{"Bean &amp; &lt;Leaf&gt;", "A7K3", "7.08", "Tax 8%"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("HTML() does not contain %q", want)
+		}
+	}
+}
+
+func TestCustomTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "receipt.txt.tmpl")
+	if err := os.WriteFile(path, []byte("{{.OrderID}} {{money .TotalCents}}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := New(path, "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	got, err := renderer.Text(testReceipt())
+	if err != nil {
+		t.Fatalf("Text() error = %v", err)
+	}
+	if got != "order-42 7.08" {
+		t.Errorf("Text() = %q, want %q", got, "order-42 7.08")
+	}
+
+	if _, err := New(filepath.Join(t.TempDir(), "missing.tmpl"), ""); err == nil {
+		t.Error("New() with a missing template succeeded")
+	}
+}
+
+func TestMoney(t *testing.T) {
+	tests := map[int64]string{
+		0:      "0.00",
+		5:      "0.05",
+		350:    "3.50",
+		-70:    "-0.70",
+		123456: "1234.56",
+	}
+	for cents, want := range tests {
+		if got := Money(cents); got != want {
+			t.Errorf("Money(%d) = %q, want %q", cents, got, want)
+		}
+	}
+}
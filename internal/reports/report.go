@@ -0,0 +1,203 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jany/my-coffee/config"
+	reportpb "github.com/jany/my-coffee/gen/proto/report"
+	"github.com/jany/my-coffee/gen/proto/report/reportconnect"
+	"github.com/jany/my-coffee/internal/repository"
+	"gorm.io/gorm"
+)
+
+// defaultTopItems is how many items GetTopItems returns without a limit.
+const defaultTopItems = 5
+
+// Compile-time check that Server implements the Connect RPC handler interface.
+var _ reportconnect.ReportServiceHandler = (*Server)(nil)
+
+type Server struct {
+	reportRepo *repository.ReportRepository
+}
+
+func New(db *gorm.DB) *Server {
+	return &Server{
+		reportRepo: repository.NewReportRepository(db),
+	}
+}
+
+func (s *Server) GetSalesByItem(ctx context.Context, req *connect.Request[reportpb.GetSalesByItemRequest]) (*connect.Response[reportpb.GetSalesByItemResponse], error) {
+	start, end, err := dateRange(req.Msg.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := s.reportRepo.SalesByItem(start, end, 0)
+	if err != nil {
+		log.Printf("Failed to report sales by item: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to report sales by item: %w", err))
+	}
+
+	return connect.NewResponse(&reportpb.GetSalesByItemResponse{
+		Items:    toItemProtos(sales),
+		Currency: config.AppConfig.Currency,
+	}), nil
+}
+
+func (s *Server) GetSalesByHour(ctx context.Context, req *connect.Request[reportpb.GetSalesByHourRequest]) (*connect.Response[reportpb.GetSalesByHourResponse], error) {
+	start, end, err := dateRange(req.Msg.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := s.reportRepo.SalesByHour(start, end, sqlZone(time.Local), sqlZone(config.AppConfig.Location))
+	if err != nil {
+		log.Printf("Failed to report sales by hour: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to report sales by hour: %w", err))
+	}
+
+	hours := make([]*reportpb.HourSales, 24)
+	for hour := range hours {
+		hours[hour] = &reportpb.HourSales{Hour: int32(hour)}
+	}
+	for _, sale := range sales {
+		hours[sale.Hour] = &reportpb.HourSales{
+			Hour:       int32(sale.Hour),
+			Orders:     sale.Orders,
+			Drinks:     sale.Drinks,
+			TotalCents: sale.TotalCents,
+		}
+	}
+
+	return connect.NewResponse(&reportpb.GetSalesByHourResponse{
+		Hours:    hours,
+		Currency: config.AppConfig.Currency,
+		TimeZone: config.AppConfig.Location.String(),
+	}), nil
+}
+
+func (s *Server) GetStatusOutcomes(ctx context.Context, req *connect.Request[reportpb.GetStatusOutcomesRequest]) (*connect.Response[reportpb.GetStatusOutcomesResponse], error) {
+	start, end, err := dateRange(req.Msg.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.reportRepo.OrderOutcomes(start, end)
+	if err != nil {
+		log.Printf("Failed to report order outcomes: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to report order outcomes: %w", err))
+	}
+
+	resp := &reportpb.GetStatusOutcomesResponse{
+		Completed: &reportpb.OutcomeCount{},
+		Cancelled: &reportpb.OutcomeCount{},
+		Open:      &reportpb.OutcomeCount{},
+		Currency:  config.AppConfig.Currency,
+	}
+	for _, count := range counts {
+		countpb := &reportpb.OutcomeCount{
+			Orders:     count.Orders,
+			Drinks:     count.Drinks,
+			TotalCents: count.TotalCents,
+		}
+		switch count.Outcome {
+		case repository.OutcomeCompleted:
+			resp.Completed = countpb
+		case repository.OutcomeCancelled:
+			resp.Cancelled = countpb
+		case repository.OutcomeOpen:
+			resp.Open = countpb
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+func (s *Server) GetPrepTime(ctx context.Context, req *connect.Request[reportpb.GetPrepTimeRequest]) (*connect.Response[reportpb.GetPrepTimeResponse], error) {
+	start, end, err := dateRange(req.Msg.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	prep, err := s.reportRepo.PrepTime(start, end)
+	if err != nil {
+		log.Printf("Failed to report prep time: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to report prep time: %w", err))
+	}
+
+	return connect.NewResponse(&reportpb.GetPrepTimeResponse{
+		Orders:         prep.Orders,
+		AverageSeconds: prep.AverageSeconds,
+		MedianSeconds:  prep.MedianSeconds,
+		MaxSeconds:     prep.MaxSeconds,
+	}), nil
+}
+
+func (s *Server) GetTopItems(ctx context.Context, req *connect.Request[reportpb.GetTopItemsRequest]) (*connect.Response[reportpb.GetTopItemsResponse], error) {
+	start, end, err := dateRange(req.Msg.Range)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit == 0 {
+		limit = defaultTopItems
+	}
+
+	sales, err := s.reportRepo.TopItems(start, end, limit)
+	if err != nil {
+		log.Printf("Failed to report top items: %v", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to report top items: %w", err))
+	}
+
+	return connect.NewResponse(&reportpb.GetTopItemsResponse{
+		Items:    toItemProtos(sales),
+		Currency: config.AppConfig.Currency,
+	}), nil
+}
+
+// dateRange checks a report's range. Order times are stored as local wall
+// clock time, so the bounds are converted to match.
+func dateRange(r *reportpb.DateRange) (start, end time.Time, err error) {
+	start, end = r.Start.AsTime().Local(), r.End.AsTime().Local()
+	if !end.After(start) {
+		return start, end, connect.NewError(connect.CodeInvalidArgument, errors.New("end must be after start"))
+	}
+	return start, end, nil
+}
+
+// sqlZone names loc for Postgres' AT TIME ZONE. "Local" means nothing to
+// the database, so it becomes the current UTC offset in POSIX notation,
+// which counts hours west of UTC.
+func sqlZone(loc *time.Location) string {
+	if loc.String() != "Local" {
+		return loc.String()
+	}
+	_, offset := time.Now().In(loc).Zone()
+	sign := "-"
+	if offset < 0 {
+		sign, offset = "+", -offset
+	}
+	return fmt.Sprintf("UTC%s%02d:%02d", sign, offset/3600, offset%3600/60)
+}
+
+func toItemProtos(sales []repository.ItemSales) []*reportpb.ItemSales {
+	var itempbs []*reportpb.ItemSales
+	for _, sale := range sales {
+		itempbs = append(itempbs, &reportpb.ItemSales{
+			MenuItemName:  sale.MenuItemName,
+			Orders:        sale.Orders,
+			Drinks:        sale.Drinks,
+			SubtotalCents: sale.SubtotalCents,
+			DiscountCents: sale.DiscountCents,
+			TotalCents:    sale.TotalCents,
+			RefundedCents: sale.RefundedCents,
+		})
+	}
+	return itempbs
+}
@@ -0,0 +1,127 @@
+package reports
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	reportpb "github.com/jany/my-coffee/gen/proto/report"
+	"github.com/jany/my-coffee/internal/models"
+	"github.com/jany/my-coffee/internal/repository"
+	"github.com/jany/my-coffee/internal/testdb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDateRange(t *testing.T) {
+	start := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	gotStart, gotEnd, err := dateRange(&reportpb.DateRange{Start: timestamppb.New(start), End: timestamppb.New(end)})
+	if err != nil {
+		t.Fatalf("dateRange() error = %v", err)
+	}
+	if !gotStart.Equal(start) || !gotEnd.Equal(end) || gotStart.Location() != time.Local {
+		t.Errorf("dateRange() = %v, %v; want %v, %v in local time", gotStart, gotEnd, start, end)
+	}
+
+	for name, r := range map[string]*reportpb.DateRange{
+		"empty":    {Start: timestamppb.New(start), End: timestamppb.New(start)},
+		"backward": {Start: timestamppb.New(end), End: timestamppb.New(start)},
+	} {
+		_, _, err := dateRange(r)
+		var connectErr *connect.Error
+		if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+			t.Errorf("dateRange(%s) error = %v, want InvalidArgument", name, err)
+		}
+	}
+}
+
+func TestSQLZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone database: %v", err)
+	}
+	if got := sqlZone(berlin); got != "Europe/Berlin" {
+		t.Errorf("sqlZone(Europe/Berlin) = %q", got)
+	}
+
+	previous := time.Local
+	t.Cleanup(func() { time.Local = previous })
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{offset: 0, want: "UTC-00:00"},
+		{offset: 7 * 3600, want: "UTC-07:00"},
+		{offset: -5 * 3600, want: "UTC+05:00"},
+		{offset: 5*3600 + 1800, want: "UTC-05:30"},
+	}
+	for _, tt := range tests {
+		time.Local = time.FixedZone("Local", tt.offset)
+		if got := sqlZone(time.Local); got != tt.want {
+			t.Errorf("sqlZone(Local at %+d) = %q, want %q", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestSales(t *testing.T) {
+	db := testdb.Open(t)
+	repo := repository.NewReportRepository(db)
+	day := time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time { return day.Add(time.Duration(hour) * time.Hour) }
+
+	orders := []models.Order{
+		{MenuItemName: "Latte", Status: models.StatusReady, Quantity: 2, SubtotalCents: 700, TotalCents: 700, CreatedAt: at(8)},
+		{MenuItemName: "Latte", Status: models.StatusQueued, Quantity: 1, SubtotalCents: 350, TotalCents: 350, CreatedAt: at(8)},
+		{MenuItemName: "Espresso", Status: models.StatusReady, Quantity: 1, SubtotalCents: 250, TotalCents: 250, CreatedAt: at(14)},
+		{MenuItemName: "Latte", Status: models.StatusCancelled, Quantity: 5, SubtotalCents: 1750, TotalCents: 1750, CreatedAt: at(9)},
+		{MenuItemName: "Latte", Status: models.StatusPendingPayment, Quantity: 1, SubtotalCents: 350, TotalCents: 350, CreatedAt: at(9)},
+		{MenuItemName: "Latte", Status: models.StatusReady, Quantity: 1, SubtotalCents: 350, TotalCents: 350, CreatedAt: day.AddDate(0, 0, 1)},
+	}
+	if err := db.Create(&orders).Error; err != nil {
+		t.Fatalf("Failed to create orders: %v", err)
+	}
+	payment := &models.Payment{OrderID: orders[0].ID, Provider: "fake", Status: models.PaymentCaptured, AmountCents: 700}
+	if err := db.Create(payment).Error; err != nil {
+		t.Fatalf("Failed to create payment: %v", err)
+	}
+	refund := &models.Refund{PaymentID: payment.ID, OrderID: orders[0].ID, AmountCents: 350, Reason: "remade", Operator: "sam"}
+	if err := db.Create(refund).Error; err != nil {
+		t.Fatalf("Failed to create refund: %v", err)
+	}
+
+	items, err := repo.SalesByItem(day, day.AddDate(0, 0, 1), 0)
+	if err != nil {
+		t.Fatalf("SalesByItem() error = %v", err)
+	}
+	want := []repository.ItemSales{
+		{MenuItemName: "Latte", Orders: 2, Drinks: 3, SubtotalCents: 1050, TotalCents: 1050, RefundedCents: 350},
+		{MenuItemName: "Espresso", Orders: 1, Drinks: 1, SubtotalCents: 250, TotalCents: 250},
+	}
+	if len(items) != len(want) {
+		t.Fatalf("SalesByItem() = %+v, want %+v", items, want)
+	}
+	for i := range want {
+		if items[i] != want[i] {
+			t.Errorf("SalesByItem()[%d] = %+v, want %+v", i, items[i], want[i])
+		}
+	}
+
+	hours, err := repo.SalesByHour(day, day.AddDate(0, 0, 1), "UTC", "UTC-02:00")
+	if err != nil {
+		t.Fatalf("SalesByHour() error = %v", err)
+	}
+	wantHours := []repository.HourSales{
+		{Hour: 10, Orders: 2, Drinks: 3, TotalCents: 1050},
+		{Hour: 16, Orders: 1, Drinks: 1, TotalCents: 250},
+	}
+	if len(hours) != len(wantHours) {
+		t.Fatalf("SalesByHour() = %+v, want %+v", hours, wantHours)
+	}
+	for i := range wantHours {
+		if hours[i] != wantHours[i] {
+			t.Errorf("SalesByHour()[%d] = %+v, want %+v", i, hours[i], wantHours[i])
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+)
+
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (r *APIKeyRepository) Create(key *models.APIKey) error {
+	return r.db.Create(key).Error
+}
+
+// FindByHash returns the key with hash, or nil if there is none.
+func (r *APIKeyRepository) FindByHash(hash string) (*models.APIKey, error) {
+	var key models.APIKey
+	err := r.db.Where("key_hash = ?", hash).First(&key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *APIKeyRepository) FindAll() ([]models.APIKey, error) {
+	var keys []models.APIKey
+	err := r.db.Order("id").Find(&keys).Error
+	return keys, err
+}
+
+// Revoke reports false when the key does not exist or was already
+// revoked.
+func (r *APIKeyRepository) Revoke(id uint, at time.Time) (bool, error) {
+	result := r.db.Model(&models.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	return result.RowsAffected == 1, result.Error
+}
+
+// TouchLastUsed records that the key was used at, unless that was already
+// recorded after since, so busy keys do not write on every call.
+func (r *APIKeyRepository) TouchLastUsed(id uint, at, since time.Time) error {
+	return r.db.Model(&models.APIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, since).
+		Update("last_used_at", at).Error
+}
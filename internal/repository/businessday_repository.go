@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+)
+
+type BusinessDayRepository struct {
+	db *gorm.DB
+}
+
+func NewBusinessDayRepository(db *gorm.DB) *BusinessDayRepository {
+	return &BusinessDayRepository{db: db}
+}
+
+// LockDays serialises closing and reopening days until the transaction
+// ends.
+func (r *BusinessDayRepository) LockDays() error {
+	return r.db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "business_days").Error
+}
+
+// ShareDays holds off closing and reopening days until the transaction
+// ends. Any number of transactions can hold it at once.
+func (r *BusinessDayRepository) ShareDays() error {
+	return r.db.Exec("SELECT pg_advisory_xact_lock_shared(hashtext(?))", "business_days").Error
+}
+
+// FindDay returns the business day of date, or nil if it was never closed.
+func (r *BusinessDayRepository) FindDay(date time.Time) (*models.BusinessDay, error) {
+	var day models.BusinessDay
+	err := r.db.Where("business_date = ?", date).First(&day).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &day, nil
+}
+
+func (r *BusinessDayRepository) SaveDay(day *models.BusinessDay) error {
+	return r.db.Save(day).Error
+}
+
+// ClosedDayAt returns the closed day that t falls in, or nil.
+func (r *BusinessDayRepository) ClosedDayAt(t time.Time) (*models.BusinessDay, error) {
+	return r.closedDay(r.db.Where("starts_at <= ? AND ends_at > ?", t, t))
+}
+
+// ClosedDayOfOrder returns the closed day the order was created in, or nil.
+func (r *BusinessDayRepository) ClosedDayOfOrder(orderID uint) (*models.BusinessDay, error) {
+	return r.closedDay(r.db.
+		Joins("JOIN orders ON orders.created_at >= business_days.starts_at AND orders.created_at < business_days.ends_at").
+		Where("orders.id = ?", orderID))
+}
+
+func (r *BusinessDayRepository) closedDay(query *gorm.DB) (*models.BusinessDay, error) {
+	var days []models.BusinessDay
+	err := query.Where("business_days.status = ?", models.BusinessDayClosed).Limit(1).Find(&days).Error
+	if err != nil || len(days) == 0 {
+		return nil, err
+	}
+	return &days[0], nil
+}
+
+// CountOpenOrders counts the orders created in [start, end) that are
+// neither READY nor cancelled.
+func (r *BusinessDayRepository) CountOpenOrders(start, end time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.Order{}).
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Where("status NOT IN ?", []models.OrderStatus{models.StatusReady, models.StatusCancelled}).
+		Count(&count).Error
+	return count, err
+}
+
+// OrderTotals returns a Z-report with the order figures of the orders
+// created in [start, end) filled in.
+func (r *BusinessDayRepository) OrderTotals(start, end time.Time) (*models.ZReport, error) {
+	var report models.ZReport
+	err := r.db.Model(&models.Order{}).
+		Select(`COUNT(*) FILTER (WHERE status <> @cancelled) AS orders,
+			COALESCE(SUM(quantity) FILTER (WHERE status <> @cancelled), 0) AS drinks,
+			COALESCE(SUM(subtotal_cents) FILTER (WHERE status <> @cancelled), 0) AS subtotal_cents,
+			COALESCE(SUM(discount_cents) FILTER (WHERE status <> @cancelled), 0) AS discount_cents,
+			COALESCE(SUM(tax_cents) FILTER (WHERE status <> @cancelled), 0) AS tax_cents,
+			COALESCE(SUM(total_cents) FILTER (WHERE status <> @cancelled), 0) AS total_cents,
+			COUNT(*) FILTER (WHERE status = @cancelled) AS cancelled_orders,
+			COALESCE(SUM(total_cents) FILTER (WHERE status = @cancelled), 0) AS cancelled_cents`,
+			map[string]any{"cancelled": models.StatusCancelled}).
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Scan(&report).Error
+	if err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// RefundTotals counts and adds up the refunds made in [start, end).
+func (r *BusinessDayRepository) RefundTotals(start, end time.Time) (count, cents int64, err error) {
+	var totals struct {
+		Count int64
+		Cents int64
+	}
+	err = r.db.Model(&models.Refund{}).
+		Select("COUNT(*) AS count, COALESCE(SUM(amount_cents), 0) AS cents").
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Scan(&totals).Error
+	return totals.Count, totals.Cents, err
+}
+
+// Tenders totals the payments taken and refunds made in [start, end) per
+// payment method. Declined payments are left out.
+func (r *BusinessDayRepository) Tenders(start, end time.Time) ([]models.ZReportTender, error) {
+	var tenders []models.ZReportTender
+	err := r.db.Raw(`
+		SELECT method, SUM(payments) AS payments, SUM(amount_cents) AS amount_cents, SUM(refunded_cents) AS refunded_cents FROM (
+			SELECT method, COUNT(*) AS payments, SUM(amount_cents) AS amount_cents, 0 AS refunded_cents
+			FROM payments
+			WHERE created_at >= @start AND created_at < @end AND status <> @declined
+			GROUP BY method
+			UNION ALL
+			SELECT payments.method, 0, 0, SUM(refunds.amount_cents)
+			FROM refunds JOIN payments ON payments.id = refunds.payment_id
+			WHERE refunds.created_at >= @start AND refunds.created_at < @end
+			GROUP BY payments.method
+		) tenders
+		GROUP BY method
+		ORDER BY method`,
+		map[string]any{"start": start, "end": end, "declined": models.PaymentDeclined}).
+		Scan(&tenders).Error
+	return tenders, err
+}
+
+// CreateReport writes a Z-report and its tenders.
+func (r *BusinessDayRepository) CreateReport(report *models.ZReport) error {
+	return r.db.Create(report).Error
+}
+
+func (r *BusinessDayRepository) FindReport(id uint) (*models.ZReport, error) {
+	var report models.ZReport
+	if err := r.db.Preload("Tenders").First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// FindReports returns every Z-report of date, oldest first.
+func (r *BusinessDayRepository) FindReports(date time.Time) ([]models.ZReport, error) {
+	var reports []models.ZReport
+	err := r.db.Preload("Tenders").Where("business_date = ?", date).Order("id").Find(&reports).Error
+	return reports, err
+}
+
+func (r *BusinessDayRepository) AddEvent(event *models.BusinessDayEvent) error {
+	return r.db.Create(event).Error
+}
+
+// FindEvents returns the audit trail of date, oldest first.
+func (r *BusinessDayRepository) FindEvents(date time.Time) ([]models.BusinessDayEvent, error) {
+	var events []models.BusinessDayEvent
+	err := r.db.Where("business_date = ?", date).Order("id").Find(&events).Error
+	return events, err
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CashRepository struct {
+	db *gorm.DB
+}
+
+func NewCashRepository(db *gorm.DB) *CashRepository {
+	return &CashRepository{db: db}
+}
+
+func (r *CashRepository) FindDrawers() ([]models.CashDrawer, error) {
+	var drawers []models.CashDrawer
+	err := r.db.Order("register").Find(&drawers).Error
+	return drawers, err
+}
+
+func (r *CashRepository) FindDrawerByRegister(register string) (*models.CashDrawer, error) {
+	var drawer models.CashDrawer
+	if err := r.db.Where("register = ?", register).First(&drawer).Error; err != nil {
+		return nil, err
+	}
+	return &drawer, nil
+}
+
+func (r *CashRepository) CreateShift(shift *models.CashShift) error {
+	return r.db.Create(shift).Error
+}
+
+func (r *CashRepository) UpdateShift(shift *models.CashShift) error {
+	return r.db.Omit(clause.Associations).Save(shift).Error
+}
+
+func (r *CashRepository) FindShift(id uint) (*models.CashShift, error) {
+	var shift models.CashShift
+	if err := r.db.Preload("Drawer").First(&shift, id).Error; err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+// FindOpenShift returns the open shift of a drawer, or nil when the drawer
+// is closed. With forUpdate the shift stays locked until the transaction
+// ends, so movements and closing do not interleave.
+func (r *CashRepository) FindOpenShift(drawerID uint, forUpdate bool) (*models.CashShift, error) {
+	query := r.db.Preload("Drawer")
+	if forUpdate {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: clause.CurrentTable}})
+	}
+
+	var shift models.CashShift
+	err := query.Where("drawer_id = ? AND status = ?", drawerID, models.ShiftOpen).First(&shift).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &shift, nil
+}
+
+func (r *CashRepository) AddMovement(movement *models.CashMovement) error {
+	return r.db.Create(movement).Error
+}
+
+func (r *CashRepository) FindMovements(shiftID uint) ([]models.CashMovement, error) {
+	var movements []models.CashMovement
+	err := r.db.Where("shift_id = ?", shiftID).Order("id").Find(&movements).Error
+	return movements, err
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustomerRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomerRepository(db *gorm.DB) *CustomerRepository {
+	return &CustomerRepository{db: db}
+}
+
+// Create fails with gorm.ErrDuplicatedKey if the email is taken.
+func (r *CustomerRepository) Create(customer *models.Customer) error {
+	return r.db.Create(customer).Error
+}
+
+// FindByEmail returns the customer with email, or nil if there is none.
+func (r *CustomerRepository) FindByEmail(email string) (*models.Customer, error) {
+	var customer models.Customer
+	err := r.db.Where("email = ?", email).First(&customer).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepository) FindByID(id uint) (*models.Customer, error) {
+	var customer models.Customer
+	err := r.db.First(&customer, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
+
+func (r *CustomerRepository) UpdatePassword(customer *models.Customer) error {
+	return r.db.Model(customer).Update("password_hash", customer.PasswordHash).Error
+}
+
+func (r *CustomerRepository) CreateRefreshToken(token *models.RefreshToken) error {
+	return r.db.Create(token).Error
+}
+
+// LockRefreshToken returns the refresh token with hash, or nil if there is
+// none, and locks it until the transaction ends so it is only used once.
+func (r *CustomerRepository) LockRefreshToken(hash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("token_hash = ?", hash).First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *CustomerRepository) RevokeRefreshToken(token *models.RefreshToken, at time.Time) error {
+	token.RevokedAt = &at
+	return r.db.Model(token).Update("revoked_at", at).Error
+}
+
+// RevokeRefreshTokens signs the customer out everywhere once their access
+// tokens expire.
+func (r *CustomerRepository) RevokeRefreshTokens(customerID uint, at time.Time) error {
+	return r.db.Model(&models.RefreshToken{}).
+		Where("customer_id = ? AND revoked_at IS NULL", customerID).
+		Update("revoked_at", at).Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AccountBalance is the net of everything credited to and debited from a
+// gift card ledger account.
+type AccountBalance struct {
+	Account      string
+	BalanceCents int64
+}
+
+type GiftCardRepository struct {
+	db *gorm.DB
+}
+
+func NewGiftCardRepository(db *gorm.DB) *GiftCardRepository {
+	return &GiftCardRepository{db: db}
+}
+
+func (r *GiftCardRepository) Create(card *models.GiftCard) error {
+	return r.db.Create(card).Error
+}
+
+func (r *GiftCardRepository) FindAll() ([]models.GiftCard, error) {
+	var cards []models.GiftCard
+	err := r.db.Order("id").Find(&cards).Error
+	return cards, err
+}
+
+func (r *GiftCardRepository) FindByCode(code string) (*models.GiftCard, error) {
+	var card models.GiftCard
+	if err := r.db.Where("code = ?", code).First(&card).Error; err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// FindByCodeForUpdate returns the card and locks it until the transaction
+// ends, so its balance cannot be spent twice.
+func (r *GiftCardRepository) FindByCodeForUpdate(code string) (*models.GiftCard, error) {
+	var card models.GiftCard
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", code).
+		First(&card).Error
+	if err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+func (r *GiftCardRepository) Update(card *models.GiftCard) error {
+	return r.db.Save(card).Error
+}
+
+func (r *GiftCardRepository) AppendTransaction(txn *models.GiftCardTransaction) error {
+	return r.db.Create(txn).Error
+}
+
+func (r *GiftCardRepository) FindTransactions(giftCardID uint) ([]models.GiftCardTransaction, error) {
+	var txns []models.GiftCardTransaction
+	err := r.db.Where("gift_card_id = ?", giftCardID).Order("id").Find(&txns).Error
+	return txns, err
+}
+
+// AccountBalances nets every account in the ledger: credits minus debits.
+func (r *GiftCardRepository) AccountBalances() ([]AccountBalance, error) {
+	var balances []AccountBalance
+	err := r.db.Raw(`
+		SELECT account, SUM(amount) AS balance_cents FROM (
+			SELECT credit_account AS account, amount_cents AS amount FROM gift_card_transactions
+			UNION ALL
+			SELECT debit_account AS account, -amount_cents AS amount FROM gift_card_transactions
+		) entries
+		GROUP BY account
+		ORDER BY account`).
+		Scan(&balances).Error
+	return balances, err
+}
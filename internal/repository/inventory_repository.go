@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrOutOfStock is returned when an ingredient does not have enough stock.
+var ErrOutOfStock = errors.New("out of stock")
+
+type InventoryRepository struct {
+	db *gorm.DB
+}
+
+func NewInventoryRepository(db *gorm.DB) *InventoryRepository {
+	return &InventoryRepository{db: db}
+}
+
+func (r *InventoryRepository) FindAll() ([]models.Ingredient, error) {
+	var ingredients []models.Ingredient
+	err := r.db.Preload("Supplier").Order("code").Find(&ingredients).Error
+	return ingredients, err
+}
+
+// FindLow returns the ingredients at or below their reorder threshold.
+func (r *InventoryRepository) FindLow() ([]models.Ingredient, error) {
+	var ingredients []models.Ingredient
+	err := r.db.Preload("Supplier").Where("stock <= reorder_threshold").Order("code").Find(&ingredients).Error
+	return ingredients, err
+}
+
+func (r *InventoryRepository) FindByCode(code string) (*models.Ingredient, error) {
+	var ingredient models.Ingredient
+	err := r.db.Preload("Supplier").Where("code = ?", code).First(&ingredient).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ingredient, nil
+}
+
+// SetReorderSettings changes when an ingredient counts as low and the pack
+// size it is bought in.
+func (r *InventoryRepository) SetReorderSettings(code string, threshold, packSize int64) (*models.Ingredient, error) {
+	result := r.db.Model(&models.Ingredient{}).Where("code = ?", code).Updates(map[string]any{
+		"reorder_threshold": threshold,
+		"pack_size":         packSize,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.FindByCode(code)
+}
+
+// ConsumptionSince sums what orders used of each ingredient since the given
+// time, keyed by ingredient ID.
+func (r *InventoryRepository) ConsumptionSince(since time.Time) (map[uint]int64, error) {
+	var rows []struct {
+		IngredientID uint
+		Consumed     int64
+	}
+	err := r.db.Model(&models.InventoryMovement{}).
+		Select("ingredient_id, -SUM(change) AS consumed").
+		Where("reason = ? AND created_at >= ?", models.MovementOrder, since).
+		Group("ingredient_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	consumed := make(map[uint]int64, len(rows))
+	for _, row := range rows {
+		consumed[row.IngredientID] = row.Consumed
+	}
+	return consumed, nil
+}
+
+// OutOfStockCodes returns the codes of every ingredient with no stock left.
+func (r *InventoryRepository) OutOfStockCodes() ([]string, error) {
+	var codes []string
+	err := r.db.Model(&models.Ingredient{}).Where("stock <= 0").Order("code").Pluck("code", &codes).Error
+	return codes, err
+}
+
+// Consume takes usage (ingredient code to quantity) out of stock for an order
+// and records a movement per ingredient. Ingredients that are not tracked are
+// skipped. Run it inside a transaction: the rows are locked so concurrent
+// orders cannot both take the last carton of milk.
+//
+// It returns the ingredients this order pushed down to their reorder
+// threshold.
+func (r *InventoryRepository) Consume(orderID uint, usage map[string]int64) ([]models.Ingredient, error) {
+	codes := make([]string, 0, len(usage))
+	for code := range usage {
+		codes = append(codes, code)
+	}
+	// Lock in a stable order so two orders cannot deadlock each other.
+	sort.Strings(codes)
+
+	var ingredients []models.Ingredient
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code IN ?", codes).
+		Order("code").
+		Find(&ingredients).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var low []models.Ingredient
+	for _, ingredient := range ingredients {
+		need := usage[ingredient.Code]
+		if ingredient.Stock < need {
+			return nil, fmt.Errorf("%w: %s", ErrOutOfStock, ingredient.Name)
+		}
+
+		err := r.db.Model(&models.Ingredient{}).
+			Where("id = ?", ingredient.ID).
+			Update("stock", gorm.Expr("stock - ?", need)).Error
+		if err != nil {
+			return nil, err
+		}
+
+		movement := &models.InventoryMovement{
+			IngredientID: ingredient.ID,
+			OrderID:      &orderID,
+			Change:       -need,
+			Reason:       models.MovementOrder,
+		}
+		if err := r.db.Create(movement).Error; err != nil {
+			return nil, err
+		}
+
+		wasLow := ingredient.IsLow()
+		ingredient.Stock -= need
+		if !wasLow && ingredient.IsLow() {
+			low = append(low, ingredient)
+		}
+	}
+	return low, nil
+}
+
+// Restock adds quantity to an ingredient's stock and records the movement.
+func (r *InventoryRepository) Restock(code string, quantity int64) (*models.Ingredient, error) {
+	var ingredient models.Ingredient
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("code = ?", code).First(&ingredient).Error; err != nil {
+			return err
+		}
+
+		ingredient.Stock += quantity
+		if err := tx.Model(&ingredient).Update("stock", ingredient.Stock).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.InventoryMovement{
+			IngredientID: ingredient.ID,
+			Change:       quantity,
+			Reason:       models.MovementRestock,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindByCode(code)
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+)
+
+type LoyaltyRepository struct {
+	db *gorm.DB
+}
+
+func NewLoyaltyRepository(db *gorm.DB) *LoyaltyRepository {
+	return &LoyaltyRepository{db: db}
+}
+
+// LockCustomer serialises ledger writes for one customer until the
+// transaction ends, so a balance cannot be spent twice.
+func (r *LoyaltyRepository) LockCustomer(customerID string) error {
+	return r.db.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "loyalty:"+customerID).Error
+}
+
+func (r *LoyaltyRepository) Append(entry *models.LoyaltyEntry) error {
+	return r.db.Create(entry).Error
+}
+
+func (r *LoyaltyRepository) Balance(customerID string) (int64, error) {
+	var balance int64
+	err := r.db.Model(&models.LoyaltyEntry{}).
+		Select("COALESCE(SUM(delta), 0)").
+		Where("customer_id = ?", customerID).
+		Scan(&balance).Error
+	return balance, err
+}
+
+// FindByCustomer returns the latest limit entries of a customer, newest
+// first.
+func (r *LoyaltyRepository) FindByCustomer(customerID string, limit int) ([]models.LoyaltyEntry, error) {
+	var entries []models.LoyaltyEntry
+	err := r.db.Where("customer_id = ?", customerID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *LoyaltyRepository) FindByOrder(orderID uint) ([]models.LoyaltyEntry, error) {
+	var entries []models.LoyaltyEntry
+	err := r.db.Where("order_id = ?", orderID).Order("id").Find(&entries).Error
+	return entries, err
+}
+
+// RefundedCents adds up what has been refunded on an order, which Earn
+// does not stamp.
+func (r *LoyaltyRepository) RefundedCents(orderID uint) (int64, error) {
+	var cents int64
+	err := r.db.Model(&models.Refund{}).
+		Select("COALESCE(SUM(amount_cents), 0)").
+		Where("order_id = ?", orderID).
+		Scan(&cents).Error
+	return cents, err
+}
@@ -1,8 +1,11 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/jany/my-coffee/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type OrderRepository struct {
@@ -19,21 +22,93 @@ func (r *OrderRepository) Create(order *models.Order) error {
 
 func (r *OrderRepository) FindAll() ([]models.Order, error) {
 	var orders []models.Order
-	err := r.db.Find(&orders).Error
+	err := r.db.Preload("Modifiers").Preload("Refunds").Preload("Discounts").Find(&orders).Error
 	return orders, err
 }
 
 func (r *OrderRepository) FindByID(id uint) (*models.Order, error) {
 	var order models.Order
-	err := r.db.First(&order, id).Error
+	err := r.db.Preload("Modifiers").Preload("Refunds").Preload("Discounts").First(&order, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// FindByCustomer returns up to limit orders of a customer, newest first.
+func (r *OrderRepository) FindByCustomer(customerID string, limit int) ([]models.Order, error) {
+	var orders []models.Order
+	err := r.db.Preload("Modifiers").Preload("Refunds").Preload("Discounts").
+		Where("customer_id = ?", customerID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+// Lock reloads the order row, without its associations, and locks it
+// until the transaction ends.
+func (r *OrderRepository) Lock(id uint) (*models.Order, error) {
+	var order models.Order
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &order, nil
 }
 
-func (r *OrderRepository) Update(order *models.Order) error {
-	return r.db.Save(order).Error
+// SetStatus moves the order from status from to order.Status, setting
+// ReadyAt with it when it is set. Only those columns are written. It
+// reports false when the order was no longer in from, so a change made
+// meanwhile is never overwritten.
+func (r *OrderRepository) SetStatus(order *models.Order, from models.OrderStatus) (bool, error) {
+	columns := map[string]any{"status": order.Status}
+	if order.ReadyAt != nil {
+		columns["ready_at"] = order.ReadyAt
+	}
+	result := r.db.Model(&models.Order{}).
+		Where("id = ? AND status = ?", order.ID, from).
+		Updates(columns)
+	return result.RowsAffected == 1, result.Error
+}
+
+// MarkPickedUp records that a READY order was handed over at pickedUpAt.
+// It reports false when the order was not READY or was already picked up,
+// so two scans of the same code cannot both succeed.
+func (r *OrderRepository) MarkPickedUp(id uint, pickedUpAt time.Time) (bool, error) {
+	result := r.db.Model(&models.Order{}).
+		Where("id = ? AND status = ? AND picked_up_at IS NULL", id, models.StatusReady).
+		Update("picked_up_at", pickedUpAt)
+	return result.RowsAffected == 1, result.Error
+}
+
+// RecordStatus appends the order's current status to its history. Call it
+// in the same transaction as the SetStatus that changed it.
+func (r *OrderRepository) RecordStatus(order *models.Order) error {
+	return r.db.Create(&models.OrderStatusChange{OrderID: order.ID, Status: order.Status}).Error
+}
+
+// ExportBatchSize is how many orders ForEachCreated loads at a time.
+const ExportBatchSize = 500
+
+// ForEachCreated calls fn for every order created in [start, end), oldest
+// first, with its modifiers, refunds and status history. Orders are loaded
+// ExportBatchSize at a time, so the table never has to fit in memory.
+func (r *OrderRepository) ForEachCreated(start, end time.Time, fn func(*models.Order) error) error {
+	var batch []models.Order
+	return r.db.
+		Preload("Modifiers").
+		Preload("Refunds").
+		Preload("StatusChanges", func(db *gorm.DB) *gorm.DB { return db.Order("created_at, id") }).
+		Where("created_at >= ? AND created_at < ?", start, end).
+		FindInBatches(&batch, ExportBatchSize, func(tx *gorm.DB, _ int) error {
+			for i := range batch {
+				if err := fn(&batch[i]); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).Error
 }
 
 func (r *OrderRepository) Delete(id uint) error {
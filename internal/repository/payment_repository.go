@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentRepository struct {
+	db *gorm.DB
+}
+
+func NewPaymentRepository(db *gorm.DB) *PaymentRepository {
+	return &PaymentRepository{db: db}
+}
+
+func (r *PaymentRepository) Create(payment *models.Payment) error {
+	return r.db.Create(payment).Error
+}
+
+func (r *PaymentRepository) FindByID(id uint) (*models.Payment, error) {
+	var payment models.Payment
+	err := r.db.First(&payment, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// FindByOrderID returns every payment attempt for an order, oldest first.
+func (r *PaymentRepository) FindByOrderID(orderID uint) ([]models.Payment, error) {
+	var payments []models.Payment
+	err := r.db.Where("order_id = ?", orderID).Order("id").Find(&payments).Error
+	return payments, err
+}
+
+// LockByOrderID is FindByOrderID that also locks the payments until the
+// transaction ends.
+func (r *PaymentRepository) LockByOrderID(orderID uint) ([]models.Payment, error) {
+	var payments []models.Payment
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("order_id = ?", orderID).Order("id").Find(&payments).Error
+	return payments, err
+}
+
+func (r *PaymentRepository) Update(payment *models.Payment) error {
+	return r.db.Save(payment).Error
+}
+
+// AddRefunded adds amountCents to what was refunded of a payment, marking
+// it REFUNDED once nothing is left. It reports false, and changes nothing,
+// if that would refund more than was paid.
+func (r *PaymentRepository) AddRefunded(id uint, amountCents int64) (bool, error) {
+	result := r.db.Model(&models.Payment{}).
+		Where("id = ? AND refunded_cents + ? <= amount_cents", id, amountCents).
+		Updates(map[string]any{
+			"refunded_cents": gorm.Expr("refunded_cents + ?", amountCents),
+			"status":         gorm.Expr("CASE WHEN refunded_cents + ? = amount_cents THEN ? ELSE status END", amountCents, string(models.PaymentRefunded)),
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// MarkCaptured records a capture without touching the refunded amount,
+// which a refund may have changed since the payment was read.
+func (r *PaymentRepository) MarkCaptured(payment *models.Payment) error {
+	return r.db.Model(payment).Updates(map[string]any{
+		"status":         payment.Status,
+		"captured_cents": payment.CapturedCents,
+	}).Error
+}
+
+func (r *PaymentRepository) CreateRefund(refund *models.Refund) error {
+	return r.db.Create(refund).Error
+}
+
+func (r *PaymentRepository) UpdateRefund(refund *models.Refund) error {
+	return r.db.Save(refund).Error
+}
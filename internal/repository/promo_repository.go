@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PromoRepository struct {
+	db *gorm.DB
+}
+
+func NewPromoRepository(db *gorm.DB) *PromoRepository {
+	return &PromoRepository{db: db}
+}
+
+func (r *PromoRepository) CreateCode(promo *models.PromoCode) error {
+	return r.db.Create(promo).Error
+}
+
+func (r *PromoRepository) FindAllCodes() ([]models.PromoCode, error) {
+	var promos []models.PromoCode
+	err := r.db.Order("code").Find(&promos).Error
+	return promos, err
+}
+
+// FindCodeForUpdate returns the promo code and locks it until the
+// transaction ends, so two orders cannot both take its last use.
+func (r *PromoRepository) FindCodeForUpdate(code string) (*models.PromoCode, error) {
+	var promo models.PromoCode
+	err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("code = ?", code).
+		First(&promo).Error
+	if err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+func (r *PromoRepository) SetCodeActive(code string, active bool) (*models.PromoCode, error) {
+	var promo models.PromoCode
+	if err := r.db.Where("code = ?", code).First(&promo).Error; err != nil {
+		return nil, err
+	}
+	promo.Active = active
+	if err := r.db.Save(&promo).Error; err != nil {
+		return nil, err
+	}
+	return &promo, nil
+}
+
+// CountUses returns how often a promo code has been redeemed, overall and
+// by customerID. Cancelled orders and orders still waiting to be paid do
+// not count, so an abandoned order does not use up a limit.
+func (r *PromoRepository) CountUses(promoCodeID uint, customerID string) (total, byCustomer int64, err error) {
+	uses := func() *gorm.DB {
+		return r.db.Model(&models.OrderDiscount{}).
+			Joins("JOIN orders ON orders.id = order_discounts.order_id").
+			Where("order_discounts.promo_code_id = ? AND orders.status NOT IN ?", promoCodeID,
+				[]models.OrderStatus{models.StatusCancelled, models.StatusPendingPayment})
+	}
+
+	if err := uses().Count(&total).Error; err != nil {
+		return 0, 0, err
+	}
+	if customerID != "" {
+		if err := uses().Where("orders.customer_id = ?", customerID).Count(&byCustomer).Error; err != nil {
+			return 0, 0, err
+		}
+	}
+	return total, byCustomer, nil
+}
+
+func (r *PromoRepository) CreateRule(rule *models.DiscountRule) error {
+	return r.db.Create(rule).Error
+}
+
+func (r *PromoRepository) FindAllRules() ([]models.DiscountRule, error) {
+	var rules []models.DiscountRule
+	err := r.db.Order("id").Find(&rules).Error
+	return rules, err
+}
+
+// FindActiveRules returns the rules that are switched on and whose date
+// range includes now. The time of day is checked by the caller.
+func (r *PromoRepository) FindActiveRules(now time.Time) ([]models.DiscountRule, error) {
+	var rules []models.DiscountRule
+	err := r.db.Where("active").
+		Where("starts_at IS NULL OR starts_at <= ?", now).
+		Where("ends_at IS NULL OR ends_at > ?", now).
+		Order("id").
+		Find(&rules).Error
+	return rules, err
+}
+
+func (r *PromoRepository) SetRuleActive(id uint, active bool) (*models.DiscountRule, error) {
+	var rule models.DiscountRule
+	if err := r.db.First(&rule, id).Error; err != nil {
+		return nil, err
+	}
+	rule.Active = active
+	if err := r.db.Save(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/jany/my-coffee/internal/models"
+	"gorm.io/gorm"
+)
+
+// ItemSales is one row of the sales by item report.
+type ItemSales struct {
+	MenuItemName  string
+	Orders        int64
+	Drinks        int64
+	SubtotalCents int64
+	DiscountCents int64
+	TotalCents    int64
+	RefundedCents int64
+}
+
+// HourSales is one row of the sales by hour report.
+type HourSales struct {
+	Hour       int
+	Orders     int64
+	Drinks     int64
+	TotalCents int64
+}
+
+// OutcomeCount totals the orders that ended in one outcome.
+type OutcomeCount struct {
+	Outcome    string
+	Orders     int64
+	Drinks     int64
+	TotalCents int64
+}
+
+// Outcomes as returned by OrderOutcomes.
+const (
+	OutcomeCompleted = "COMPLETED"
+	OutcomeCancelled = "CANCELLED"
+	OutcomeOpen      = "OPEN"
+)
+
+// PrepTime summarizes how long orders took from being placed to READY.
+type PrepTime struct {
+	Orders         int64
+	AverageSeconds float64
+	MedianSeconds  float64
+	MaxSeconds     float64
+}
+
+// unsold are the statuses of orders that sales leave out: cancelled ones
+// and those still waiting to be paid.
+var unsold = []models.OrderStatus{models.StatusCancelled, models.StatusPendingPayment}
+
+// ReportRepository runs the aggregate queries behind the sales reports.
+// Every query covers the orders created in [start, end).
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// SalesByItem totals the orders that were sold per menu item,
+// highest total first. limit caps the number of rows when positive.
+func (r *ReportRepository) SalesByItem(start, end time.Time, limit int) ([]ItemSales, error) {
+	return r.salesByItem(start, end, "total_cents DESC", limit)
+}
+
+// TopItems is SalesByItem ordered by drinks sold.
+func (r *ReportRepository) TopItems(start, end time.Time, limit int) ([]ItemSales, error) {
+	return r.salesByItem(start, end, "drinks DESC, total_cents DESC", limit)
+}
+
+func (r *ReportRepository) salesByItem(start, end time.Time, order string, limit int) ([]ItemSales, error) {
+	query := r.db.Table("orders").
+		Select(`orders.menu_item_name,
+			COUNT(*) AS orders,
+			SUM(orders.quantity) AS drinks,
+			SUM(orders.subtotal_cents) AS subtotal_cents,
+			SUM(orders.discount_cents) AS discount_cents,
+			SUM(orders.total_cents) AS total_cents,
+			COALESCE(SUM(refunded.amount_cents), 0) AS refunded_cents`).
+		Joins(`LEFT JOIN (
+			SELECT order_id, SUM(amount_cents) AS amount_cents FROM refunds GROUP BY order_id
+		) refunded ON refunded.order_id = orders.id`).
+		Where("orders.created_at >= ? AND orders.created_at < ? AND orders.status NOT IN ?", start, end, unsold).
+		Group("orders.menu_item_name").
+		Order(order + ", orders.menu_item_name")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var sales []ItemSales
+	err := query.Scan(&sales).Error
+	return sales, err
+}
+
+// SalesByHour totals the orders that were sold per hour of the day. Order
+// times are stored as wall clock time in storedZone and grouped by the
+// hour in shopZone. Hours without orders are left out.
+func (r *ReportRepository) SalesByHour(start, end time.Time, storedZone, shopZone string) ([]HourSales, error) {
+	var sales []HourSales
+	err := r.db.Table("orders").
+		Select(`EXTRACT(HOUR FROM (created_at AT TIME ZONE ?) AT TIME ZONE ?)::int AS hour,
+			COUNT(*) AS orders,
+			SUM(quantity) AS drinks,
+			SUM(total_cents) AS total_cents`, storedZone, shopZone).
+		Where("created_at >= ? AND created_at < ? AND status NOT IN ?", start, end, unsold).
+		Group("1").
+		Order("1").
+		Scan(&sales).Error
+	return sales, err
+}
+
+// OrderOutcomes counts orders by whether they were completed (READY),
+// cancelled or are still open.
+func (r *ReportRepository) OrderOutcomes(start, end time.Time) ([]OutcomeCount, error) {
+	var counts []OutcomeCount
+	err := r.db.Table("orders").
+		Select(`CASE status WHEN ? THEN ? WHEN ? THEN ? ELSE ? END AS outcome,
+			COUNT(*) AS orders,
+			SUM(quantity) AS drinks,
+			SUM(total_cents) AS total_cents`,
+			models.StatusReady, OutcomeCompleted, models.StatusCancelled, OutcomeCancelled, OutcomeOpen).
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Group("1").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// PrepTime measures created_at to ready_at of the orders that became
+// READY.
+func (r *ReportRepository) PrepTime(start, end time.Time) (*PrepTime, error) {
+	var prep PrepTime
+	err := r.db.Table("orders").
+		Select(`COUNT(*) AS orders,
+			COALESCE(AVG(EXTRACT(EPOCH FROM ready_at - created_at)), 0) AS average_seconds,
+			COALESCE(PERCENTILE_CONT(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM ready_at - created_at)), 0) AS median_seconds,
+			COALESCE(MAX(EXTRACT(EPOCH FROM ready_at - created_at)), 0) AS max_seconds`).
+		Where("created_at >= ? AND created_at < ? AND ready_at IS NOT NULL", start, end).
+		Scan(&prep).Error
+	if err != nil {
+		return nil, err
+	}
+	return &prep, nil
+}
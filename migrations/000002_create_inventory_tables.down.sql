@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS order_modifiers;
+DROP TABLE IF EXISTS inventory_movements;
+DROP TABLE IF EXISTS ingredients;
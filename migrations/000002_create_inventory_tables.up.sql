@@ -0,0 +1,40 @@
+CREATE TABLE IF NOT EXISTS ingredients (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(100) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    unit VARCHAR(20) NOT NULL,
+    stock BIGINT NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS inventory_movements (
+    id SERIAL PRIMARY KEY,
+    ingredient_id INTEGER NOT NULL REFERENCES ingredients(id) ON DELETE CASCADE,
+    order_id INTEGER REFERENCES orders(id) ON DELETE SET NULL,
+    change BIGINT NOT NULL,
+    reason VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_ingredient_created
+    ON inventory_movements (ingredient_id, created_at);
+
+CREATE TABLE IF NOT EXISTS order_modifiers (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    modifier_id VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_modifiers_order_id ON order_modifiers (order_id);
+
+INSERT INTO ingredients (code, name, unit, stock) VALUES
+    ('coffee_beans', 'Coffee beans', 'g', 5000),
+    ('whole_milk', 'Whole milk', 'ml', 10000),
+    ('oat_milk', 'Oat milk', 'ml', 4000),
+    ('almond_milk', 'Almond milk', 'ml', 4000),
+    ('soy_milk', 'Soy milk', 'ml', 4000),
+    ('vanilla_syrup', 'Vanilla syrup', 'ml', 1000),
+    ('cup', 'Cup', 'pcs', 500)
+ON CONFLICT (code) DO NOTHING;
@@ -0,0 +1,6 @@
+ALTER TABLE ingredients
+    DROP COLUMN IF EXISTS pack_size,
+    DROP COLUMN IF EXISTS reorder_threshold,
+    DROP COLUMN IF EXISTS supplier_id;
+
+DROP TABLE IF EXISTS suppliers;
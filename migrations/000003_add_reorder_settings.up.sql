@@ -0,0 +1,45 @@
+CREATE TABLE IF NOT EXISTS suppliers (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    email VARCHAR(255),
+    phone VARCHAR(50),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE ingredients
+    ADD COLUMN IF NOT EXISTS supplier_id INTEGER REFERENCES suppliers(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS reorder_threshold BIGINT NOT NULL DEFAULT 0 CHECK (reorder_threshold >= 0),
+    ADD COLUMN IF NOT EXISTS pack_size BIGINT NOT NULL DEFAULT 1 CHECK (pack_size > 0);
+
+INSERT INTO suppliers (name, email) VALUES
+    ('Highland Roasters', 'orders@highlandroasters.example'),
+    ('Fresh Dairy Co', 'sales@freshdairy.example'),
+    ('Plant Milk Supply', 'orders@plantmilk.example'),
+    ('Cafe Essentials', 'hello@cafeessentials.example')
+ON CONFLICT (name) DO NOTHING;
+
+UPDATE ingredients SET
+    supplier_id = (SELECT id FROM suppliers WHERE name = 'Highland Roasters'),
+    reorder_threshold = 1000, pack_size = 1000
+WHERE code = 'coffee_beans';
+
+UPDATE ingredients SET
+    supplier_id = (SELECT id FROM suppliers WHERE name = 'Fresh Dairy Co'),
+    reorder_threshold = 3000, pack_size = 1000
+WHERE code = 'whole_milk';
+
+UPDATE ingredients SET
+    supplier_id = (SELECT id FROM suppliers WHERE name = 'Plant Milk Supply'),
+    reorder_threshold = 1000, pack_size = 1000
+WHERE code IN ('oat_milk', 'almond_milk', 'soy_milk');
+
+UPDATE ingredients SET
+    supplier_id = (SELECT id FROM suppliers WHERE name = 'Cafe Essentials'),
+    reorder_threshold = 250, pack_size = 750
+WHERE code = 'vanilla_syrup';
+
+UPDATE ingredients SET
+    supplier_id = (SELECT id FROM suppliers WHERE name = 'Cafe Essentials'),
+    reorder_threshold = 100, pack_size = 50
+WHERE code = 'cup';
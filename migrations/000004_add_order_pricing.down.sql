@@ -0,0 +1,13 @@
+ALTER TABLE order_modifiers
+    DROP COLUMN IF EXISTS price_cents;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS currency,
+    DROP COLUMN IF EXISTS tax_inclusive,
+    DROP COLUMN IF EXISTS tax_rate,
+    DROP COLUMN IF EXISTS total_cents,
+    DROP COLUMN IF EXISTS tax_cents,
+    DROP COLUMN IF EXISTS discount_cents,
+    DROP COLUMN IF EXISTS subtotal_cents,
+    DROP COLUMN IF EXISTS unit_price_cents,
+    DROP COLUMN IF EXISTS quantity;
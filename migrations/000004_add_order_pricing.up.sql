@@ -0,0 +1,13 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    ADD COLUMN IF NOT EXISTS unit_price_cents BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS subtotal_cents BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS discount_cents BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_cents BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS total_cents BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_rate NUMERIC(6, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE order_modifiers
+    ADD COLUMN IF NOT EXISTS price_cents BIGINT NOT NULL DEFAULT 0;
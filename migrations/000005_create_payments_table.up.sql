@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS payments (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE RESTRICT,
+    provider VARCHAR(50) NOT NULL,
+    provider_ref VARCHAR(255),
+    method VARCHAR(20) NOT NULL DEFAULT 'CARD',
+    status VARCHAR(20) NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents >= 0),
+    captured_cents BIGINT NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    failure_reason VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_payments_order_id ON payments (order_id);
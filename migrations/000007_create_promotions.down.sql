@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS order_discounts;
+DROP TABLE IF EXISTS discount_rules;
+DROP TABLE IF EXISTS promo_codes;
+
+DROP INDEX IF EXISTS idx_orders_customer_id;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS promo_code,
+    DROP COLUMN IF EXISTS customer_id;
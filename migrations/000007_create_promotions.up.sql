@@ -0,0 +1,53 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS customer_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS promo_code VARCHAR(50);
+
+CREATE INDEX IF NOT EXISTS idx_orders_customer_id ON orders (customer_id);
+
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    description VARCHAR(255) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('PERCENT', 'FIXED', 'BOGO')),
+    percent NUMERIC(5, 2) NOT NULL DEFAULT 0 CHECK (percent >= 0 AND percent <= 100),
+    amount_cents BIGINT NOT NULL DEFAULT 0 CHECK (amount_cents >= 0),
+    menu_item_name VARCHAR(255),
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    max_uses INTEGER NOT NULL DEFAULT 0,
+    max_uses_per_customer INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS discount_rules (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    percent NUMERIC(5, 2) NOT NULL CHECK (percent > 0 AND percent <= 100),
+    menu_item_name VARCHAR(255),
+    tag VARCHAR(50),
+    -- Minutes after local midnight; the rule applies from start to end.
+    start_minute INTEGER NOT NULL DEFAULT 0 CHECK (start_minute BETWEEN 0 AND 1440),
+    end_minute INTEGER NOT NULL DEFAULT 1440 CHECK (end_minute BETWEEN 0 AND 1440),
+    starts_at TIMESTAMP,
+    ends_at TIMESTAMP,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_discounts (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    description VARCHAR(255) NOT NULL,
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    promo_code_id INTEGER REFERENCES promo_codes(id) ON DELETE SET NULL,
+    discount_rule_id INTEGER REFERENCES discount_rules(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_discounts_order_id ON order_discounts (order_id);
+CREATE INDEX IF NOT EXISTS idx_order_discounts_promo_code_id ON order_discounts (promo_code_id);
+
+INSERT INTO discount_rules (name, percent, tag, start_minute, end_minute)
+VALUES ('Happy hour: 20% off cold drinks', 20, 'cold', 840, 960);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS loyalty_ledger_append_only ON loyalty_ledger;
+DROP FUNCTION IF EXISTS loyalty_ledger_append_only();
+DROP TABLE IF EXISTS loyalty_ledger;
@@ -0,0 +1,27 @@
+-- Entries are never changed: a refund appends a reversal instead. order_id
+-- has no foreign key so deleting an order keeps its history.
+CREATE TABLE IF NOT EXISTS loyalty_ledger (
+    id SERIAL PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    order_id INTEGER,
+    delta INTEGER NOT NULL CHECK (delta <> 0),
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('EARN', 'REDEEM', 'REVERSAL')),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_customer_id ON loyalty_ledger (customer_id);
+CREATE INDEX IF NOT EXISTS idx_loyalty_ledger_order_id ON loyalty_ledger (order_id);
+
+-- An order earns stamps once, even if it is marked READY twice.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_loyalty_ledger_order_earn
+    ON loyalty_ledger (order_id) WHERE reason = 'EARN';
+
+CREATE OR REPLACE FUNCTION loyalty_ledger_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'loyalty_ledger is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER loyalty_ledger_append_only
+    BEFORE UPDATE OR DELETE ON loyalty_ledger
+    FOR EACH ROW EXECUTE FUNCTION loyalty_ledger_append_only();
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS gift_card_transactions_append_only ON gift_card_transactions;
+DROP FUNCTION IF EXISTS gift_card_transactions_append_only();
+DROP TABLE IF EXISTS gift_card_transactions;
+DROP TABLE IF EXISTS gift_cards;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS gift_cards (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(32) NOT NULL UNIQUE,
+    currency VARCHAR(3) NOT NULL,
+    -- Cached from gift_card_transactions; the ledger is authoritative.
+    balance_cents BIGINT NOT NULL DEFAULT 0 CHECK (balance_cents >= 0),
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Every transaction moves amount_cents from debit_account to
+-- credit_account, so the balances of all accounts always sum to zero. A
+-- card's account is "giftcard:<code>".
+CREATE TABLE IF NOT EXISTS gift_card_transactions (
+    id SERIAL PRIMARY KEY,
+    gift_card_id INTEGER NOT NULL REFERENCES gift_cards(id),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('ISSUE', 'REDEEM', 'REFUND')),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents > 0),
+    debit_account VARCHAR(64) NOT NULL,
+    credit_account VARCHAR(64) NOT NULL CHECK (credit_account <> debit_account),
+    order_id INTEGER,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_gift_card_transactions_gift_card_id ON gift_card_transactions (gift_card_id);
+
+CREATE OR REPLACE FUNCTION gift_card_transactions_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'gift_card_transactions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER gift_card_transactions_append_only
+    BEFORE UPDATE OR DELETE ON gift_card_transactions
+    FOR EACH ROW EXECUTE FUNCTION gift_card_transactions_append_only();
+
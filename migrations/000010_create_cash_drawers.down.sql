@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS cash_movements;
+DROP TABLE IF EXISTS cash_shifts;
+DROP TABLE IF EXISTS cash_drawers;
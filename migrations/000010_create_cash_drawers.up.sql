@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS cash_drawers (
+    id SERIAL PRIMARY KEY,
+    register VARCHAR(50) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS cash_shifts (
+    id SERIAL PRIMARY KEY,
+    drawer_id INTEGER NOT NULL REFERENCES cash_drawers(id),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('OPEN', 'CLOSED')),
+    opened_by VARCHAR(255) NOT NULL,
+    opening_float_cents BIGINT NOT NULL CHECK (opening_float_cents >= 0),
+    opened_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    closed_by VARCHAR(255),
+    closed_at TIMESTAMP,
+    expected_cents BIGINT,
+    counted_cents BIGINT,
+    note TEXT
+);
+
+-- A drawer has at most one open shift.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cash_shifts_open_drawer
+    ON cash_shifts (drawer_id) WHERE status = 'OPEN';
+
+-- Cash in (positive) and out (negative) of a drawer during a shift.
+CREATE TABLE IF NOT EXISTS cash_movements (
+    id SERIAL PRIMARY KEY,
+    shift_id INTEGER NOT NULL REFERENCES cash_shifts(id),
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('SALE', 'REFUND', 'PAID_IN', 'PAID_OUT')),
+    amount_cents BIGINT NOT NULL CHECK (amount_cents <> 0),
+    order_id INTEGER,
+    payment_id INTEGER,
+    reason VARCHAR(255),
+    operator VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cash_movements_shift_id ON cash_movements (shift_id);
+
+INSERT INTO cash_drawers (register, name) VALUES ('front', 'Front counter');
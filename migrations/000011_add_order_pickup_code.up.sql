@@ -0,0 +1,2 @@
+-- Short code called out or shown on screen when the drink is ready.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS pickup_code VARCHAR(8);
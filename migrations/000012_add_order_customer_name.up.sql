@@ -0,0 +1,2 @@
+-- Name written on the cup sticker.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS customer_name VARCHAR(50);
@@ -0,0 +1,3 @@
+-- Set when the drink is handed over at the counter, so a pickup code
+-- can only be used once.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS picked_up_at TIMESTAMP;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_orders_created_at;
+ALTER TABLE orders DROP COLUMN IF EXISTS ready_at;
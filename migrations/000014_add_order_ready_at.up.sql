@@ -0,0 +1,5 @@
+-- When the order became READY, for prep time reports. Orders that were
+-- READY before this column existed keep NULL.
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS ready_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_orders_created_at ON orders(created_at);
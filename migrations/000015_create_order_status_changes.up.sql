@@ -0,0 +1,13 @@
+-- Every status an order has been in, for exports and audits.
+CREATE TABLE IF NOT EXISTS order_status_changes (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_status_changes_order_id ON order_status_changes (order_id);
+
+-- Existing orders start their history with the status they are in now.
+INSERT INTO order_status_changes (order_id, status, created_at)
+SELECT id, status, COALESCE(updated_at, created_at) FROM orders;
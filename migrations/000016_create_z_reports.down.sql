@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS business_day_events;
+DROP TABLE IF EXISTS business_days;
+DROP TABLE IF EXISTS z_report_tenders;
+DROP TABLE IF EXISTS z_reports;
+DROP FUNCTION IF EXISTS business_day_append_only();
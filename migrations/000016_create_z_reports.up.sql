@@ -0,0 +1,78 @@
+-- A Z-report totals a closed business day. Reports are never changed: a
+-- day that is reopened and closed again gets a new report with the next
+-- number (the id).
+CREATE TABLE IF NOT EXISTS z_reports (
+    id SERIAL PRIMARY KEY,
+    business_date DATE NOT NULL,
+    orders INTEGER NOT NULL,
+    drinks INTEGER NOT NULL,
+    subtotal_cents BIGINT NOT NULL,
+    discount_cents BIGINT NOT NULL,
+    tax_cents BIGINT NOT NULL,
+    total_cents BIGINT NOT NULL,
+    cancelled_orders INTEGER NOT NULL,
+    cancelled_cents BIGINT NOT NULL,
+    refunds INTEGER NOT NULL,
+    refunded_cents BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    closed_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_z_reports_business_date ON z_reports (business_date);
+
+-- Money taken and given back per payment method.
+CREATE TABLE IF NOT EXISTS z_report_tenders (
+    id SERIAL PRIMARY KEY,
+    z_report_id INTEGER NOT NULL REFERENCES z_reports(id) ON DELETE RESTRICT,
+    method VARCHAR(50) NOT NULL,
+    payments INTEGER NOT NULL,
+    amount_cents BIGINT NOT NULL,
+    refunded_cents BIGINT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_z_report_tenders_z_report_id ON z_report_tenders (z_report_id);
+
+-- Whether a day is closed. starts_at and ends_at are the day's bounds in
+-- the same wall clock time as orders.created_at.
+CREATE TABLE IF NOT EXISTS business_days (
+    business_date DATE PRIMARY KEY,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('OPEN', 'CLOSED')),
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    z_report_id INTEGER REFERENCES z_reports(id),
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_business_days_closed ON business_days (starts_at, ends_at) WHERE status = 'CLOSED';
+
+-- Who closed and reopened which day, and why.
+CREATE TABLE IF NOT EXISTS business_day_events (
+    id SERIAL PRIMARY KEY,
+    business_date DATE NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('CLOSE', 'REOPEN')),
+    z_report_id INTEGER REFERENCES z_reports(id),
+    operator VARCHAR(255) NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_business_day_events_business_date ON business_day_events (business_date);
+
+CREATE OR REPLACE FUNCTION business_day_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION '% is append-only', TG_TABLE_NAME;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER z_reports_append_only
+    BEFORE UPDATE OR DELETE ON z_reports
+    FOR EACH ROW EXECUTE FUNCTION business_day_append_only();
+
+CREATE TRIGGER z_report_tenders_append_only
+    BEFORE UPDATE OR DELETE ON z_report_tenders
+    FOR EACH ROW EXECUTE FUNCTION business_day_append_only();
+
+CREATE TRIGGER business_day_events_append_only
+    BEFORE UPDATE OR DELETE ON business_day_events
+    FOR EACH ROW EXECUTE FUNCTION business_day_append_only();
@@ -0,0 +1,15 @@
+-- API keys authenticate devices and services rather than people, e.g. the
+-- self-order kiosk. Only a SHA-256 hash of a key is kept; the prefix
+-- identifies it in listings. Scopes are the comma separated roles the key
+-- acts with.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    scopes TEXT NOT NULL,
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
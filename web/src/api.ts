@@ -1,23 +1,77 @@
 const BREW_BASE = "http://localhost:50051";
 const MENU_BASE = "http://localhost:50052";
 
+export interface Nutrition {
+  calories?: number;
+  caffeineMg?: number;
+}
+
+export interface Modifier {
+  id: string;
+  name: string;
+  price: number;
+  allergens?: string[];
+  nutrition?: Nutrition;
+}
+
 export interface MenuItem {
+  id: string;
   name: string;
   description: string;
   price: number;
+  allergens?: string[];
+  nutrition?: Nutrition;
+  modifiers?: Modifier[];
+}
+
+export interface DiscountLine {
+  description: string;
+  amountCents: string;
+}
+
+export interface PriceBreakdown {
+  subtotalCents?: string;
+  discountCents?: string;
+  discounts?: DiscountLine[];
+  taxCents?: string;
+  totalCents?: string;
+  currency?: string;
 }
 
 export interface Order {
   orderId: string;
   menuItemName: string;
   status: string;
+  quantity?: number;
+  price?: PriceBreakdown;
+  modifierIds?: string[];
+  createdAt?: string;
+}
+
+export interface Customer {
+  customerId: string;
+  email: string;
+  name?: string;
+}
+
+interface Tokens {
+  accessToken: string;
+  refreshToken: string;
+}
+
+// Bearer token for brewsvc, kept in localStorage under "token". Customers
+// get it from register or login; refreshToken trades the stored refresh
+// token for a new one when it expires.
+function authHeaders(): Record<string, string> {
+  const token = localStorage.getItem("token");
+  return token ? { Authorization: `Bearer ${token}` } : {};
 }
 
 // Helper to call Connect RPC endpoints with JSON
 async function connectFetch<T>(baseUrl: string, method: string, body: object = {}): Promise<T> {
   const res = await fetch(`${baseUrl}/${method}`, {
-    method: "POST",  // Connect RPC always uses POST
-    headers: { "Content-Type": "application/json" },
+    method: "POST",
+    headers: { "Content-Type": "application/json", ...authHeaders() },
     body: JSON.stringify(body),
   });
   if (!res.ok) {
@@ -27,8 +81,24 @@ async function connectFetch<T>(baseUrl: string, method: string, body: object = {
   return res.json();
 }
 
+// Side-effect free RPCs can be called with GET, which lets the browser (and
+// any CDN in front of menusvc) cache them and revalidate with ETags.
+async function connectGet<T>(baseUrl: string, method: string, body: object = {}): Promise<T> {
+  const params = new URLSearchParams({
+    connect: "v1",
+    encoding: "json",
+    message: JSON.stringify(body),
+  });
+  const res = await fetch(`${baseUrl}/${method}?${params}`);
+  if (!res.ok) {
+    const err = await res.json().catch(() => null);
+    throw new Error(err?.message || `Failed to call ${method}`);
+  }
+  return res.json();
+}
+
 export async function fetchMenu(): Promise<MenuItem[]> {
-  const resp = await connectFetch<{ items: MenuItem[] }>(
+  const resp = await connectGet<{ items: MenuItem[] }>(
     MENU_BASE, "menu.MenuService/GetMenu"
   );
   return resp.items ?? [];
@@ -49,6 +119,20 @@ export async function createOrder(
   );
 }
 
+// Orders of the signed-in customer, newest first.
+export async function fetchMyOrders(limit = 20): Promise<Order[]> {
+  const resp = await connectFetch<{ orders: Order[] }>(
+    BREW_BASE, "brew.BrewService/ListMyOrders", { limit }
+  );
+  return resp.orders ?? [];
+}
+
+export async function reorder(orderId: string): Promise<{ orderId: string }> {
+  return connectFetch<{ orderId: string }>(
+    BREW_BASE, "brew.BrewService/Reorder", { orderId }
+  );
+}
+
 export async function getOrder(orderId: string): Promise<Order> {
   const resp = await connectFetch<{ order: Order }>(
     BREW_BASE, "brew.BrewService/GetOrder", { orderId }
@@ -66,6 +150,8 @@ export async function updateOrderStatus(
     BREWING: 3,
     FROTHING: 4,
     READY: 5,
+    PENDING_PAYMENT: 6,
+    CANCELLED: 7,
   };
   const resp = await connectFetch<{ order: Order }>(
     BREW_BASE, "brew.BrewService/UpdateOrderStatus",
@@ -79,3 +165,39 @@ export async function deleteOrder(orderId: string): Promise<{ success: boolean }
     BREW_BASE, "brew.BrewService/DeleteOrder", { orderId }
   );
 }
+
+function storeTokens(tokens: Tokens) {
+  localStorage.setItem("token", tokens.accessToken);
+  localStorage.setItem("refreshToken", tokens.refreshToken);
+}
+
+export async function register(
+  email: string, password: string, name: string
+): Promise<Customer> {
+  const resp = await connectFetch<{ customer: Customer; tokens: Tokens }>(
+    BREW_BASE, "account.AccountService/Register", { email, password, name }
+  );
+  storeTokens(resp.tokens);
+  return resp.customer;
+}
+
+export async function login(email: string, password: string): Promise<Customer> {
+  const resp = await connectFetch<{ customer: Customer; tokens: Tokens }>(
+    BREW_BASE, "account.AccountService/Login", { email, password }
+  );
+  storeTokens(resp.tokens);
+  return resp.customer;
+}
+
+export async function refreshToken(): Promise<void> {
+  const resp = await connectFetch<{ tokens: Tokens }>(
+    BREW_BASE, "account.AccountService/RefreshToken",
+    { refreshToken: localStorage.getItem("refreshToken") ?? "" }
+  );
+  storeTokens(resp.tokens);
+}
+
+export function logout() {
+  localStorage.removeItem("token");
+  localStorage.removeItem("refreshToken");
+}
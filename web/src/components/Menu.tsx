@@ -24,6 +24,13 @@ export default function Menu() {
               {item.description && (
                 <p className="menu-item-desc">{item.description}</p>
               )}
+              <p className="menu-item-info">
+                {item.nutrition?.calories ?? 0} kcal ·{" "}
+                {item.nutrition?.caffeineMg ?? 0} mg caffeine
+                {item.allergens && item.allergens.length > 0 && (
+                  <> · Contains: {item.allergens.join(", ").toLowerCase()}</>
+                )}
+              </p>
             </div>
           ))}
         </div>
@@ -1,3 +1,4 @@
+import type { PriceBreakdown } from "../api";
 import { useOrders } from "../hooks";
 
 const STATUS_EMOJI: Record<string, string> = {
@@ -6,8 +7,16 @@ const STATUS_EMOJI: Record<string, string> = {
   BREWING: "☕",
   FROTHING: "🥛",
   READY: "✅",
+  PENDING_PAYMENT: "💳",
+  CANCELLED: "🚫",
 };
 
+// int64 fields arrive as strings in Connect's JSON encoding.
+function formatPrice(price?: PriceBreakdown): string {
+  const cents = Number(price?.totalCents ?? 0);
+  return `${(cents / 100).toFixed(2)} ${price?.currency ?? ""}`.trim();
+}
+
 export default function Orders() {
   const { data: orders = [], isLoading, error, refetch, isFetching } = useOrders();
 
@@ -31,6 +40,7 @@ export default function Orders() {
               <th>#</th>
               <th>Drink</th>
               <th>Status</th>
+              <th>Total</th>
             </tr>
           </thead>
           <tbody>
@@ -43,6 +53,7 @@ export default function Orders() {
                     {STATUS_EMOJI[order.status] ?? "❓"} {order.status}
                   </span>
                 </td>
+                <td>{formatPrice(order.price)}</td>
               </tr>
             ))}
           </tbody>